@@ -16,6 +16,11 @@
 
 // @securityDefinitions.basic  BasicAuth
 
+// @securityDefinitions.apikey  ApiKeyAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Chave de API no formato "Bearer <key>" (também aceita o header X-API-Key)
+
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 package main
@@ -84,17 +89,36 @@ func main() {
 		log.WithError(err).Error().Msg("Failed to restore sessions")
 	}
 
+	// Carregar configurações de webhook persistidas e iniciar as entregas do outbox
+	if err := whatsappManager.LoadWebhookConfigs(context.Background()); err != nil {
+		log.WithError(err).Error().Msg("Failed to load webhook configs, webhook delivery workers not started")
+	} else {
+		whatsappManager.StartWebhookDelivery()
+	}
+
 	// Reconectar sessões restauradas (com melhorias de segurança)
 	whatsappManager.ConnectRestoredSessions(context.Background())
 
 	// Inicializar container de dependências
-	container, err := app.NewContainer(db, whatsappManager)
+	container, err := app.NewContainer(db, whatsappManager, whatsappManager.GetWebhookService(), whatsappManager.GetEventBus(), whatsappManager.GetMetrics(), cfg)
 	if err != nil {
 		log.WithError(err).Fatal().Msg("Failed to initialize container")
 	}
 
+	// Iniciar a fila de envio assíncrono; é encerrada antes do manager para concluir os envios em andamento
+	container.OutboundQueue.Start()
+	defer container.OutboundQueue.Stop()
+
+	// Iniciar o scheduler dos envios agendados
+	container.Scheduler.Start()
+	defer container.Scheduler.Stop()
+
+	// Iniciar o runner das campanhas de envio em massa
+	container.CampaignRunner.Start()
+	defer container.CampaignRunner.Stop()
+
 	// Configurar router com handlers
-	handler := router.NewRouter(container.SessionHandler, container.HealthHandler, container.MessageHandler, container.ChatHandler, container.GroupHandler)
+	handler := router.NewRouter(container.SessionHandler, container.HealthHandler, container.MessageHandler, container.ChatHandler, container.GroupHandler, container.ContactHandler, container.AuthHandler, container.WebhookHandler, container.MediaHandler, container.EventsHandler, container.ScheduleHandler, container.CampaignHandler, container.AuthMiddleware, container.IdempotencyMiddleware)
 
 	// Criar servidor
 	srv := server.New(cfg, handler, log)
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -64,6 +65,101 @@ type Config struct {
 	CORS struct {
 		AllowedOrigins string
 	}
+
+	Auth struct {
+		Enabled  bool
+		AdminKey string
+	}
+
+	Webhook struct {
+		Workers      int
+		PollInterval time.Duration
+		BaseBackoff  time.Duration
+		MaxBackoff   time.Duration
+	}
+
+	Events struct {
+		BufferSize        int
+		HeartbeatInterval time.Duration
+		// QueueSize é o tamanho da fila de eventos do whatsmeow de cada sessão no dispatcher
+		QueueSize int
+		// FollowUpWorkers limita os processamentos lentos (download de mídia, histórico) rodando ao mesmo tempo
+		FollowUpWorkers int
+		// AllowedOrigins são as origens de navegador aceitas nos streams além da própria origem do zmeow ("*" libera todas)
+		AllowedOrigins []string
+	}
+
+	MediaStorage struct {
+		// Driver define o backend: disabled, local ou s3
+		Driver    string
+		LocalPath string
+		PublicURL string
+		URLSecret string
+		URLTTL    time.Duration
+
+		S3Endpoint  string
+		S3Region    string
+		S3Bucket    string
+		S3AccessKey string
+		S3SecretKey string
+		S3UseSSL    bool
+	}
+
+	Contacts struct {
+		// CacheTTL é a validade das consultas de contatos ao WhatsApp em cache (0 desabilita o cache)
+		CacheTTL time.Duration
+	}
+
+	Outbound struct {
+		// RatePerMinute é o limite de mensagens por minuto de cada sessão na fila de envio
+		RatePerMinute int
+		// Jitter é o atraso aleatório máximo somado ao intervalo entre envios
+		Jitter time.Duration
+		// TypingSimulation envia "digitando..." antes das mensagens de texto da fila
+		TypingSimulation     bool
+		TypingCharsPerSecond int
+		MaxTypingDelay       time.Duration
+		MaxAttempts          int
+		RetryBackoff         time.Duration
+		PollInterval         time.Duration
+	}
+
+	Schedule struct {
+		PollInterval time.Duration
+		BatchSize    int
+		MaxAttempts  int
+		RetryBackoff time.Duration
+		// MaxDelay é o atraso máximo após o horário agendado (sessão desconectada ou serviço parado) antes de desistir
+		MaxDelay time.Duration
+	}
+
+	Campaign struct {
+		// RatePerMinute é o ritmo padrão das campanhas que não informam o próprio
+		RatePerMinute int
+		// MaxRatePerMinute limita o ritmo que uma campanha pode solicitar
+		MaxRatePerMinute int
+		Jitter           time.Duration
+		// MaxRecipients limita a quantidade de destinatários de uma campanha
+		MaxRecipients int
+		MaxAttempts   int
+		PollInterval  time.Duration
+	}
+
+	Idempotency struct {
+		// TTL é a janela em que as respostas das requisições com Idempotency-Key são repetidas (0 desabilita)
+		TTL time.Duration
+	}
+
+	EventBroker struct {
+		// Driver define o broker que recebe os eventos: disabled, amqp, nats ou redis
+		Driver string
+		URL    string
+		// Topic é a exchange AMQP, o prefixo dos subjects NATS ou a chave da stream Redis
+		Topic       string
+		NATSStream  string
+		RedisMaxLen int
+		Timeout     time.Duration
+	}
 }
 
 func LoadConfig() (*Config, error) {
@@ -126,6 +222,75 @@ func LoadConfig() (*Config, error) {
 	// CORS
 	cfg.CORS.AllowedOrigins = getEnv("CORS_ALLOWED_ORIGINS", "*")
 
+	// Auth
+	cfg.Auth.Enabled = getEnvAsBool("AUTH_ENABLED", true)
+	cfg.Auth.AdminKey = getEnv("AUTH_ADMIN_KEY", "")
+
+	// Webhook outbox
+	cfg.Webhook.Workers = getEnvAsInt("WEBHOOK_WORKERS", 4)
+	cfg.Webhook.PollInterval = getEnvAsDuration("WEBHOOK_POLL_INTERVAL", 1*time.Second)
+	cfg.Webhook.BaseBackoff = getEnvAsDuration("WEBHOOK_BASE_BACKOFF", 2*time.Second)
+	cfg.Webhook.MaxBackoff = getEnvAsDuration("WEBHOOK_MAX_BACKOFF", 10*time.Minute)
+
+	// Streams de eventos ao vivo (WebSocket/SSE)
+	cfg.Events.BufferSize = getEnvAsInt("EVENTS_BUFFER_SIZE", 1000)
+	cfg.Events.HeartbeatInterval = getEnvAsDuration("EVENTS_HEARTBEAT_INTERVAL", 25*time.Second)
+	cfg.Events.QueueSize = getEnvAsInt("EVENTS_QUEUE_SIZE", 256)
+	cfg.Events.FollowUpWorkers = getEnvAsInt("EVENTS_FOLLOWUP_WORKERS", 4)
+	cfg.Events.AllowedOrigins = getEnvAsList("EVENTS_ALLOWED_ORIGINS")
+
+	// Armazenamento de mídias baixadas automaticamente
+	cfg.MediaStorage.Driver = getEnv("MEDIA_STORAGE_DRIVER", "disabled")
+	cfg.MediaStorage.LocalPath = getEnv("MEDIA_STORAGE_PATH", "data/media")
+	cfg.MediaStorage.PublicURL = getEnv("MEDIA_PUBLIC_URL", "http://localhost:"+cfg.App.Port)
+	cfg.MediaStorage.URLSecret = getEnv("MEDIA_URL_SECRET", "")
+	cfg.MediaStorage.URLTTL = getEnvAsDuration("MEDIA_URL_TTL", 0)
+	cfg.MediaStorage.S3Endpoint = getEnv("MEDIA_S3_ENDPOINT", "localhost:9000")
+	cfg.MediaStorage.S3Region = getEnv("MEDIA_S3_REGION", "us-east-1")
+	cfg.MediaStorage.S3Bucket = getEnv("MEDIA_S3_BUCKET", "zmeow-media")
+	cfg.MediaStorage.S3AccessKey = getEnv("MEDIA_S3_ACCESS_KEY", "")
+	cfg.MediaStorage.S3SecretKey = getEnv("MEDIA_S3_SECRET_KEY", "")
+	cfg.MediaStorage.S3UseSSL = getEnvAsBool("MEDIA_S3_USE_SSL", false)
+
+	// Cache das consultas de contatos
+	cfg.Contacts.CacheTTL = getEnvAsDuration("CONTACTS_CACHE_TTL", 10*time.Minute)
+
+	// Fila de envio assíncrono
+	cfg.Outbound.RatePerMinute = getEnvAsInt("OUTBOUND_RATE_PER_MINUTE", 20)
+	cfg.Outbound.Jitter = getEnvAsDuration("OUTBOUND_JITTER", 3*time.Second)
+	cfg.Outbound.TypingSimulation = getEnvAsBool("OUTBOUND_TYPING_SIMULATION", false)
+	cfg.Outbound.TypingCharsPerSecond = getEnvAsInt("OUTBOUND_TYPING_CHARS_PER_SECOND", 15)
+	cfg.Outbound.MaxTypingDelay = getEnvAsDuration("OUTBOUND_MAX_TYPING_DELAY", 8*time.Second)
+	cfg.Outbound.MaxAttempts = getEnvAsInt("OUTBOUND_MAX_ATTEMPTS", 3)
+	cfg.Outbound.RetryBackoff = getEnvAsDuration("OUTBOUND_RETRY_BACKOFF", 30*time.Second)
+	cfg.Outbound.PollInterval = getEnvAsDuration("OUTBOUND_POLL_INTERVAL", 1*time.Second)
+
+	// Envios agendados
+	cfg.Schedule.PollInterval = getEnvAsDuration("SCHEDULE_POLL_INTERVAL", 5*time.Second)
+	cfg.Schedule.BatchSize = getEnvAsInt("SCHEDULE_BATCH_SIZE", 50)
+	cfg.Schedule.MaxAttempts = getEnvAsInt("SCHEDULE_MAX_ATTEMPTS", 3)
+	cfg.Schedule.RetryBackoff = getEnvAsDuration("SCHEDULE_RETRY_BACKOFF", 1*time.Minute)
+	cfg.Schedule.MaxDelay = getEnvAsDuration("SCHEDULE_MAX_DELAY", 24*time.Hour)
+
+	// Campanhas de envio em massa
+	cfg.Campaign.RatePerMinute = getEnvAsInt("CAMPAIGN_RATE_PER_MINUTE", 20)
+	cfg.Campaign.MaxRatePerMinute = getEnvAsInt("CAMPAIGN_MAX_RATE_PER_MINUTE", 60)
+	cfg.Campaign.Jitter = getEnvAsDuration("CAMPAIGN_JITTER", 3*time.Second)
+	cfg.Campaign.MaxRecipients = getEnvAsInt("CAMPAIGN_MAX_RECIPIENTS", 50000)
+	cfg.Campaign.MaxAttempts = getEnvAsInt("CAMPAIGN_MAX_ATTEMPTS", 2)
+	cfg.Campaign.PollInterval = getEnvAsDuration("CAMPAIGN_POLL_INTERVAL", 2*time.Second)
+
+	// Chaves de idempotência dos envios e alterações de grupos
+	cfg.Idempotency.TTL = getEnvAsDuration("IDEMPOTENCY_TTL", 24*time.Hour)
+
+	// Publicação dos eventos em broker de mensagens
+	cfg.EventBroker.Driver = getEnv("EVENT_BROKER_DRIVER", "disabled")
+	cfg.EventBroker.URL = getEnv("EVENT_BROKER_URL", "")
+	cfg.EventBroker.Topic = getEnv("EVENT_BROKER_TOPIC", "zmeow.events")
+	cfg.EventBroker.NATSStream = getEnv("EVENT_BROKER_NATS_STREAM", "ZMEOW_EVENTS")
+	cfg.EventBroker.RedisMaxLen = getEnvAsInt("EVENT_BROKER_REDIS_MAXLEN", 100000)
+	cfg.EventBroker.Timeout = getEnvAsDuration("EVENT_BROKER_TIMEOUT", 5*time.Second)
+
 	return cfg, nil
 }
 
@@ -154,6 +319,26 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsList lê uma lista separada por vírgulas, ignorando itens vazios
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func (c *Config) GetDatabaseDSN() string {
 	return "postgres://" + c.Database.User + ":" + c.Database.Password +
 		"@" + c.Database.Host + ":" + c.Database.Port +
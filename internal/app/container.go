@@ -3,28 +3,72 @@ package app
 import (
 	"github.com/uptrace/bun"
 
+	"zmeow/internal/app/config"
+	"zmeow/internal/domain/auth"
+	"zmeow/internal/domain/campaign"
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/contact"
 	"zmeow/internal/domain/group"
+	"zmeow/internal/domain/idempotency"
+	domainMedia "zmeow/internal/domain/media"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/schedule"
 	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
 	"zmeow/internal/domain/whatsapp"
 	"zmeow/internal/http/handlers"
+	appMiddleware "zmeow/internal/http/middleware"
 	"zmeow/internal/infra/database"
 	"zmeow/internal/infra/media"
+	"zmeow/internal/infra/storage"
+	"zmeow/internal/infra/whatsapp/services"
+	authUseCases "zmeow/internal/usecases/auth"
+	campaignUseCases "zmeow/internal/usecases/campaign"
+	chatUseCases "zmeow/internal/usecases/chat"
+	contactUseCases "zmeow/internal/usecases/contact"
 	groupUseCases "zmeow/internal/usecases/group"
+	idempotencyUseCases "zmeow/internal/usecases/idempotency"
+	mediaUseCases "zmeow/internal/usecases/media"
 	messageUseCases "zmeow/internal/usecases/message"
+	outboundUseCases "zmeow/internal/usecases/outbound"
+	scheduleUseCases "zmeow/internal/usecases/schedule"
 	sessionUseCases "zmeow/internal/usecases/session"
+	webhookUseCases "zmeow/internal/usecases/webhook"
+	"zmeow/internal/usecases/worker"
 	"zmeow/pkg/logger"
 )
 
 // Container gerencia todas as dependências da aplicação
 type Container struct {
+	// Config
+	Config *config.Config
+
 	// Database
 	DB *bun.DB
 
 	// Repositories
-	SessionRepo session.SessionRepository
+	SessionRepo     session.SessionRepository
+	APIKeyRepo      auth.APIKeyRepository
+	WebhookRepo     webhook.WebhookRepository
+	DeliveryRepo    webhook.DeliveryRepository
+	MessageRepo     message.MessageRepository
+	ChatRepo        chat.ChatRepository
+	ContactRepo     contact.ContactRepository
+	OutboundRepo    outbound.JobRepository
+	ScheduleRepo    schedule.Repository
+	CampaignRepo    campaign.Repository
+	IdempotencyRepo idempotency.Repository
+
+	// Armazenamento de mídias (nil quando desabilitado)
+	MediaStorage domainMedia.Storage
+	MediaSigner  *domainMedia.URLSigner
 
 	// WhatsApp
 	WhatsAppManager whatsapp.WhatsAppManager
+	WebhookService  whatsapp.WebhookService
+	EventBus        whatsapp.EventStream
+	Metrics         whatsapp.MetricsService
 
 	// Use Cases
 	CreateSessionUC     *sessionUseCases.CreateSessionUseCase
@@ -37,8 +81,15 @@ type Container struct {
 	PairPhoneUC         *sessionUseCases.PairPhoneUseCase
 	SetProxyUC          *sessionUseCases.SetProxyUseCase
 	GetStatusUC         *sessionUseCases.GetStatusUseCase
+	GetStatsUC          *sessionUseCases.GetStatsUseCase
+	SetCallPolicyUC     *sessionUseCases.SetCallPolicyUseCase
+	GetCallPolicyUC     *sessionUseCases.GetCallPolicyUseCase
+	SetHistorySyncUC    *sessionUseCases.SetHistorySyncUseCase
+	GetHistorySyncUC    *sessionUseCases.GetHistorySyncUseCase
+	SubscribeEventsUC   *sessionUseCases.SubscribeEventsUseCase
 
 	// Message Use Cases
+	RecipientVerifier     *messageUseCases.RecipientVerifier
 	SendTextMessageUC     *messageUseCases.SendTextMessageUseCase
 	SendMediaMessageUC    *messageUseCases.SendMediaMessageUseCase
 	SendLocationMessageUC *messageUseCases.SendLocationMessageUseCase
@@ -50,6 +101,83 @@ type Container struct {
 	EditMessageUC         *messageUseCases.EditMessageUseCase
 	DeleteMessageUC       *messageUseCases.DeleteMessageUseCase
 	ReactMessageUC        *messageUseCases.ReactMessageUseCase
+	GetMessageHistoryUC   *messageUseCases.GetMessageHistoryUseCase
+	GetMessageStatusUC    *messageUseCases.GetMessageStatusUseCase
+	SendChatPresenceUC    *messageUseCases.SendChatPresenceUseCase
+	MarkReadUC            *messageUseCases.MarkReadUseCase
+	DownloadMediaUC       *messageUseCases.DownloadMediaUseCase
+
+	// Outbound Queue Use Cases
+	SendDispatcher   *messageUseCases.SendDispatcher
+	SendPacer        *worker.Pacer
+	OutboundQueue    *outboundUseCases.Queue
+	EnqueueMessageUC *outboundUseCases.EnqueueMessageUseCase
+	ListOutboundUC   *outboundUseCases.ListJobsUseCase
+	GetOutboundUC    *outboundUseCases.GetJobUseCase
+	CancelOutboundUC *outboundUseCases.CancelJobUseCase
+
+	// Schedule Use Cases
+	Scheduler         *scheduleUseCases.Scheduler
+	ScheduleMessageUC *scheduleUseCases.ScheduleMessageUseCase
+	ListSchedulesUC   *scheduleUseCases.ListSchedulesUseCase
+	GetScheduleUC     *scheduleUseCases.GetScheduleUseCase
+	UpdateScheduleUC  *scheduleUseCases.UpdateScheduleUseCase
+	CancelScheduleUC  *scheduleUseCases.CancelScheduleUseCase
+
+	// Campaign Use Cases
+	CampaignRunner       *campaignUseCases.Runner
+	CreateCampaignUC     *campaignUseCases.CreateCampaignUseCase
+	ListCampaignsUC      *campaignUseCases.ListCampaignsUseCase
+	GetCampaignUC        *campaignUseCases.GetCampaignUseCase
+	CampaignReportUC     *campaignUseCases.GetCampaignReportUseCase
+	CampaignRecipientsUC *campaignUseCases.ListRecipientsUseCase
+	ControlCampaignUC    *campaignUseCases.ControlCampaignUseCase
+
+	// Auth Use Cases
+	AuthenticateUC *authUseCases.AuthenticateUseCase
+	CreateAPIKeyUC *authUseCases.CreateAPIKeyUseCase
+	ListAPIKeysUC  *authUseCases.ListAPIKeysUseCase
+	RotateAPIKeyUC *authUseCases.RotateAPIKeyUseCase
+	RevokeAPIKeyUC *authUseCases.RevokeAPIKeyUseCase
+
+	// Idempotency Use Cases
+	IdempotencyUC *idempotencyUseCases.IdempotencyUseCase
+
+	// Webhook Use Cases
+	ListWebhooksUC     *webhookUseCases.ListWebhooksUseCase
+	CreateWebhookUC    *webhookUseCases.CreateWebhookUseCase
+	GetWebhookUC       *webhookUseCases.GetWebhookUseCase
+	SetWebhookUC       *webhookUseCases.SetWebhookUseCase
+	DeleteWebhookUC    *webhookUseCases.DeleteWebhookUseCase
+	EnableWebhookUC    *webhookUseCases.EnableWebhookUseCase
+	DisableWebhookUC   *webhookUseCases.DisableWebhookUseCase
+	TestWebhookUC      *webhookUseCases.TestWebhookUseCase
+	RotateSecretUC     *webhookUseCases.RotateWebhookSecretUseCase
+	ListDeliveriesUC   *webhookUseCases.ListDeliveriesUseCase
+	GetDeliveryUC      *webhookUseCases.GetDeliveryUseCase
+	ReplayDeliveryUC   *webhookUseCases.ReplayDeliveryUseCase
+	ReplayDeliveriesUC *webhookUseCases.ReplayDeliveriesUseCase
+
+	// Chat Use Cases
+	ListChatsUC      *chatUseCases.ListChatsUseCase
+	ArchiveChatUC    *chatUseCases.ArchiveChatUseCase
+	PinChatUC        *chatUseCases.PinChatUseCase
+	MuteChatUC       *chatUseCases.MuteChatUseCase
+	MarkChatUnreadUC *chatUseCases.MarkChatUnreadUseCase
+	ClearChatUC      *chatUseCases.ClearChatUseCase
+	DeleteChatUC     *chatUseCases.DeleteChatUseCase
+
+	// Contact Use Cases
+	ContactCache         *services.ContactCache
+	CheckContactsUC      *contactUseCases.CheckContactsUseCase
+	ListContactsUC       *contactUseCases.ListContactsUseCase
+	GetContactUC         *contactUseCases.GetContactUseCase
+	GetProfilePictureUC  *contactUseCases.GetProfilePictureUseCase
+	GetBusinessProfileUC *contactUseCases.GetBusinessProfileUseCase
+
+	// Media Use Cases
+	SetAutoDownloadUC *mediaUseCases.SetAutoDownloadUseCase
+	GetStoredMediaUC  *mediaUseCases.GetStoredMediaUseCase
 
 	// Group Use Cases
 	CreateGroupUC          *groupUseCases.CreateGroupUseCase
@@ -69,21 +197,36 @@ type Container struct {
 	GetInviteInfoUC        *groupUseCases.GetInviteInfoUseCase
 
 	// Handlers
-	SessionHandler *handlers.SessionHandler
-	HealthHandler  *handlers.HealthHandler
-	MessageHandler *handlers.MessageHandler
-	ChatHandler    *handlers.ChatHandler
-	GroupHandler   *handlers.GroupHandler
+	SessionHandler  *handlers.SessionHandler
+	HealthHandler   *handlers.HealthHandler
+	MessageHandler  *handlers.MessageHandler
+	ChatHandler     *handlers.ChatHandler
+	GroupHandler    *handlers.GroupHandler
+	ContactHandler  *handlers.ContactHandler
+	AuthHandler     *handlers.AuthHandler
+	WebhookHandler  *handlers.WebhookHandler
+	MediaHandler    *handlers.MediaHandler
+	EventsHandler   *handlers.EventsHandler
+	ScheduleHandler *handlers.ScheduleHandler
+	CampaignHandler *handlers.CampaignHandler
+
+	// Middlewares
+	AuthMiddleware        *appMiddleware.AuthMiddleware
+	IdempotencyMiddleware *appMiddleware.IdempotencyMiddleware
 
 	// Logger
 	Logger logger.Logger
 }
 
 // NewContainer cria um novo container de dependências
-func NewContainer(db *bun.DB, whatsappManager whatsapp.WhatsAppManager) (*Container, error) {
+func NewContainer(db *bun.DB, whatsappManager whatsapp.WhatsAppManager, webhookService whatsapp.WebhookService, eventBus whatsapp.EventStream, metrics whatsapp.MetricsService, cfg *config.Config) (*Container, error) {
 	c := &Container{
+		Config:          cfg,
 		DB:              db,
 		WhatsAppManager: whatsappManager,
+		WebhookService:  webhookService,
+		EventBus:        eventBus,
+		Metrics:         metrics,
 		Logger:          logger.WithComponent("di-container"),
 	}
 
@@ -105,6 +248,23 @@ func NewContainer(db *bun.DB, whatsappManager whatsapp.WhatsAppManager) (*Contai
 // initRepositories inicializa os repositórios
 func (c *Container) initRepositories() error {
 	c.SessionRepo = database.NewSessionRepository(c.DB)
+	c.APIKeyRepo = database.NewAPIKeyRepository(c.DB)
+	c.WebhookRepo = database.NewWebhookRepository(c.DB)
+	c.DeliveryRepo = database.NewWebhookDeliveryRepository(c.DB)
+	c.MessageRepo = database.NewMessageRepository(c.DB)
+	c.ChatRepo = database.NewChatRepository(c.DB)
+	c.ContactRepo = database.NewContactRepository(c.DB)
+	c.OutboundRepo = database.NewOutboundRepository(c.DB)
+	c.ScheduleRepo = database.NewScheduleRepository(c.DB)
+	c.CampaignRepo = database.NewCampaignRepository(c.DB)
+	c.IdempotencyRepo = database.NewIdempotencyRepository(c.DB)
+
+	mediaStorage, err := storage.New(c.Config)
+	if err != nil {
+		return err
+	}
+	c.MediaStorage = mediaStorage
+	c.MediaSigner = storage.NewURLSigner(c.Config)
 	return nil
 }
 
@@ -112,7 +272,9 @@ func (c *Container) initRepositories() error {
 func (c *Container) initUseCases() {
 	c.CreateSessionUC = sessionUseCases.NewCreateSessionUseCase(
 		c.SessionRepo,
+		c.WebhookRepo,
 		c.WhatsAppManager,
+		c.WebhookService,
 		c.Logger,
 	)
 
@@ -165,60 +327,371 @@ func (c *Container) initUseCases() {
 		c.Logger,
 	)
 
+	c.GetStatsUC = sessionUseCases.NewGetStatsUseCase(
+		c.SessionRepo,
+		c.Metrics,
+		c.Logger,
+	)
+
+	c.SetCallPolicyUC = sessionUseCases.NewSetCallPolicyUseCase(
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.GetCallPolicyUC = sessionUseCases.NewGetCallPolicyUseCase(c.SessionRepo)
+
+	c.SetHistorySyncUC = sessionUseCases.NewSetHistorySyncUseCase(
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.GetHistorySyncUC = sessionUseCases.NewGetHistorySyncUseCase(c.SessionRepo)
+
+	c.SubscribeEventsUC = sessionUseCases.NewSubscribeEventsUseCase(
+		c.SessionRepo,
+		c.EventBus,
+		c.Logger,
+	)
+
+	// Cache das consultas de contatos, compartilhado pela verificação de destinatários e pela API de contatos
+	c.ContactCache = services.NewContactCache(c.Config.Contacts.CacheTTL)
+
 	// Inicializar casos de uso de mensagem
 	c.initMessageUseCases()
 
+	// Inicializar a fila de envio assíncrono e os agendamentos (dependem dos casos de uso de envio)
+	c.initOutboundUseCases()
+
+	// Inicializar casos de uso de chat
+	c.initChatUseCases()
+
+	// Inicializar casos de uso de contatos
+	c.initContactUseCases()
+
 	// Inicializar casos de uso de grupo
 	c.initGroupUseCases()
+
+	// Inicializar casos de uso de autenticação
+	c.initAuthUseCases()
+
+	// Inicializar casos de uso de webhook
+	c.initWebhookUseCases()
+
+	// Inicializar casos de uso de mídia
+	c.initMediaUseCases()
+}
+
+// initOutboundUseCases inicializa a fila de envio assíncrono, o scheduler dos envios agendados, o runner das campanhas
+// e seus casos de uso
+func (c *Container) initOutboundUseCases() {
+	c.SendDispatcher = messageUseCases.NewSendDispatcher(
+		c.SendTextMessageUC,
+		c.SendMediaMessageUC,
+		c.SendLocationMessageUC,
+		c.SendContactMessageUC,
+		c.SendStickerMessageUC,
+		c.SendButtonsMessageUC,
+		c.SendListMessageUC,
+		c.SendPollMessageUC,
+	)
+
+	// O resultado de cada envio é publicado como evento message.queue pelo dispatcher de eventos do manager
+	publisher, _ := c.WhatsAppManager.(whatsapp.EventPublisher)
+
+	// Um único Pacer espaça os envios automáticos de cada sessão (fila, agendamentos e campanhas)
+	c.SendPacer = worker.NewPacer(worker.PerMinute(c.Config.Outbound.RatePerMinute, c.Config.Outbound.Jitter))
+
+	c.OutboundQueue = outboundUseCases.NewQueue(
+		c.OutboundRepo,
+		c.WhatsAppManager,
+		c.SendDispatcher,
+		publisher,
+		c.SendPacer,
+		outboundUseCases.QueueOptions{
+			TypingSimulation:     c.Config.Outbound.TypingSimulation,
+			TypingCharsPerSecond: c.Config.Outbound.TypingCharsPerSecond,
+			MaxTypingDelay:       c.Config.Outbound.MaxTypingDelay,
+			MaxAttempts:          c.Config.Outbound.MaxAttempts,
+			RetryBackoff:         c.Config.Outbound.RetryBackoff,
+			PollInterval:         c.Config.Outbound.PollInterval,
+		},
+		c.Logger,
+	)
+
+	c.EnqueueMessageUC = outboundUseCases.NewEnqueueMessageUseCase(c.OutboundRepo, c.SessionRepo, c.OutboundQueue, c.SendDispatcher, c.Logger)
+	c.ListOutboundUC = outboundUseCases.NewListJobsUseCase(c.OutboundRepo, c.SessionRepo, c.Logger)
+	c.GetOutboundUC = outboundUseCases.NewGetJobUseCase(c.OutboundRepo, c.Logger)
+	c.CancelOutboundUC = outboundUseCases.NewCancelJobUseCase(c.OutboundRepo, c.Logger)
+
+	// Envios agendados, despachados pelos mesmos casos de uso de envio
+	c.Scheduler = scheduleUseCases.NewScheduler(
+		c.ScheduleRepo,
+		c.WhatsAppManager,
+		c.SendDispatcher,
+		publisher,
+		c.SendPacer,
+		scheduleUseCases.SchedulerOptions{
+			PollInterval: c.Config.Schedule.PollInterval,
+			BatchSize:    c.Config.Schedule.BatchSize,
+			MaxAttempts:  c.Config.Schedule.MaxAttempts,
+			RetryBackoff: c.Config.Schedule.RetryBackoff,
+			MaxDelay:     c.Config.Schedule.MaxDelay,
+		},
+		c.Logger,
+	)
+
+	c.ScheduleMessageUC = scheduleUseCases.NewScheduleMessageUseCase(c.ScheduleRepo, c.SessionRepo, c.SendDispatcher, c.Scheduler, c.Logger)
+	c.ListSchedulesUC = scheduleUseCases.NewListSchedulesUseCase(c.ScheduleRepo, c.SessionRepo, c.Logger)
+	c.GetScheduleUC = scheduleUseCases.NewGetScheduleUseCase(c.ScheduleRepo, c.Logger)
+	c.UpdateScheduleUC = scheduleUseCases.NewUpdateScheduleUseCase(c.ScheduleRepo, c.SendDispatcher, c.Scheduler, c.Logger)
+	c.CancelScheduleUC = scheduleUseCases.NewCancelScheduleUseCase(c.ScheduleRepo, c.Logger)
+
+	// Campanhas de envio em massa, também despachadas pelos casos de uso de envio
+	c.CampaignRunner = campaignUseCases.NewRunner(
+		c.CampaignRepo,
+		c.WhatsAppManager,
+		c.SendDispatcher,
+		c.SendPacer,
+		campaignUseCases.RunnerOptions{
+			Jitter:       c.Config.Campaign.Jitter,
+			MaxAttempts:  c.Config.Campaign.MaxAttempts,
+			PollInterval: c.Config.Campaign.PollInterval,
+		},
+		c.Logger,
+	)
+
+	c.CreateCampaignUC = campaignUseCases.NewCreateCampaignUseCase(
+		c.CampaignRepo,
+		c.SessionRepo,
+		c.SendDispatcher,
+		c.CampaignRunner,
+		campaignUseCases.CreateOptions{
+			RatePerMinute:    c.Config.Campaign.RatePerMinute,
+			MaxRatePerMinute: c.Config.Campaign.MaxRatePerMinute,
+			MaxRecipients:    c.Config.Campaign.MaxRecipients,
+		},
+		c.Logger,
+	)
+	c.ListCampaignsUC = campaignUseCases.NewListCampaignsUseCase(c.CampaignRepo, c.SessionRepo, c.Logger)
+	c.GetCampaignUC = campaignUseCases.NewGetCampaignUseCase(c.CampaignRepo, c.Logger)
+	c.CampaignReportUC = campaignUseCases.NewGetCampaignReportUseCase(c.CampaignRepo, c.Logger)
+	c.CampaignRecipientsUC = campaignUseCases.NewListRecipientsUseCase(c.CampaignRepo, c.Logger)
+	c.ControlCampaignUC = campaignUseCases.NewControlCampaignUseCase(c.CampaignRepo, c.CampaignRunner, c.Logger)
+}
+
+// initChatUseCases inicializa os casos de uso de chat
+func (c *Container) initChatUseCases() {
+	c.ListChatsUC = chatUseCases.NewListChatsUseCase(
+		c.SessionRepo,
+		c.ChatRepo,
+		c.Logger,
+	)
+
+	c.ArchiveChatUC = chatUseCases.NewArchiveChatUseCase(c.SessionRepo, c.MessageRepo, c.ChatRepo, c.WhatsAppManager, c.Logger)
+	c.PinChatUC = chatUseCases.NewPinChatUseCase(c.SessionRepo, c.MessageRepo, c.ChatRepo, c.WhatsAppManager, c.Logger)
+	c.MuteChatUC = chatUseCases.NewMuteChatUseCase(c.SessionRepo, c.MessageRepo, c.ChatRepo, c.WhatsAppManager, c.Logger)
+	c.MarkChatUnreadUC = chatUseCases.NewMarkChatUnreadUseCase(c.SessionRepo, c.MessageRepo, c.ChatRepo, c.WhatsAppManager, c.Logger)
+	c.ClearChatUC = chatUseCases.NewClearChatUseCase(c.SessionRepo, c.MessageRepo, c.ChatRepo, c.WhatsAppManager, c.Logger)
+	c.DeleteChatUC = chatUseCases.NewDeleteChatUseCase(c.SessionRepo, c.MessageRepo, c.ChatRepo, c.WhatsAppManager, c.Logger)
+}
+
+// initContactUseCases inicializa os casos de uso de contatos, que compartilham o cache das consultas
+func (c *Container) initContactUseCases() {
+	c.CheckContactsUC = contactUseCases.NewCheckContactsUseCase(c.SessionRepo, c.WhatsAppManager, c.ContactCache, c.Logger)
+	c.ListContactsUC = contactUseCases.NewListContactsUseCase(c.SessionRepo, c.ContactRepo, c.Logger)
+	c.GetContactUC = contactUseCases.NewGetContactUseCase(c.SessionRepo, c.ContactRepo, c.WhatsAppManager, c.ContactCache, c.Logger)
+	c.GetProfilePictureUC = contactUseCases.NewGetProfilePictureUseCase(c.SessionRepo, c.WhatsAppManager, c.ContactCache, c.Logger)
+	c.GetBusinessProfileUC = contactUseCases.NewGetBusinessProfileUseCase(c.SessionRepo, c.WhatsAppManager, c.ContactCache, c.Logger)
+}
+
+// initMediaUseCases inicializa os casos de uso do armazenamento de mídias
+func (c *Container) initMediaUseCases() {
+	c.SetAutoDownloadUC = mediaUseCases.NewSetAutoDownloadUseCase(
+		c.SessionRepo,
+		c.MediaStorage,
+		c.Logger,
+	)
+
+	c.GetStoredMediaUC = mediaUseCases.NewGetStoredMediaUseCase(
+		c.MediaStorage,
+		c.Logger,
+	)
+}
+
+// initWebhookUseCases inicializa os casos de uso de webhook
+func (c *Container) initWebhookUseCases() {
+	c.ListWebhooksUC = webhookUseCases.NewListWebhooksUseCase(
+		c.WebhookRepo,
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.CreateWebhookUC = webhookUseCases.NewCreateWebhookUseCase(
+		c.WebhookRepo,
+		c.SessionRepo,
+		c.WebhookService,
+		c.Logger,
+	)
+
+	c.GetWebhookUC = webhookUseCases.NewGetWebhookUseCase(
+		c.WebhookRepo,
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.SetWebhookUC = webhookUseCases.NewSetWebhookUseCase(
+		c.WebhookRepo,
+		c.SessionRepo,
+		c.WebhookService,
+		c.Logger,
+	)
+
+	c.DeleteWebhookUC = webhookUseCases.NewDeleteWebhookUseCase(
+		c.WebhookRepo,
+		c.WebhookService,
+		c.Logger,
+	)
+
+	c.EnableWebhookUC = webhookUseCases.NewEnableWebhookUseCase(
+		c.WebhookRepo,
+		c.WebhookService,
+		c.Logger,
+	)
+
+	c.DisableWebhookUC = webhookUseCases.NewDisableWebhookUseCase(
+		c.WebhookRepo,
+		c.WebhookService,
+		c.Logger,
+	)
+
+	c.TestWebhookUC = webhookUseCases.NewTestWebhookUseCase(
+		c.WebhookRepo,
+		c.WebhookService,
+		c.Logger,
+	)
+
+	c.RotateSecretUC = webhookUseCases.NewRotateWebhookSecretUseCase(
+		c.WebhookRepo,
+		c.WebhookService,
+		c.Logger,
+	)
+
+	c.ListDeliveriesUC = webhookUseCases.NewListDeliveriesUseCase(
+		c.DeliveryRepo,
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.GetDeliveryUC = webhookUseCases.NewGetDeliveryUseCase(
+		c.DeliveryRepo,
+		c.Logger,
+	)
+
+	c.ReplayDeliveryUC = webhookUseCases.NewReplayDeliveryUseCase(
+		c.DeliveryRepo,
+		c.Logger,
+	)
+
+	c.ReplayDeliveriesUC = webhookUseCases.NewReplayDeliveriesUseCase(
+		c.DeliveryRepo,
+		c.SessionRepo,
+		c.Logger,
+	)
+}
+
+// initAuthUseCases inicializa os casos de uso de autenticação
+func (c *Container) initAuthUseCases() {
+	c.AuthenticateUC = authUseCases.NewAuthenticateUseCase(
+		c.APIKeyRepo,
+		c.Config.Auth.AdminKey,
+		c.Logger,
+	)
+
+	c.CreateAPIKeyUC = authUseCases.NewCreateAPIKeyUseCase(
+		c.APIKeyRepo,
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.ListAPIKeysUC = authUseCases.NewListAPIKeysUseCase(
+		c.APIKeyRepo,
+		c.Logger,
+	)
+
+	c.RotateAPIKeyUC = authUseCases.NewRotateAPIKeyUseCase(
+		c.APIKeyRepo,
+		c.Logger,
+	)
+
+	c.RevokeAPIKeyUC = authUseCases.NewRevokeAPIKeyUseCase(
+		c.APIKeyRepo,
+		c.Logger,
+	)
+
+	c.IdempotencyUC = idempotencyUseCases.NewIdempotencyUseCase(
+		c.IdempotencyRepo,
+		c.Config.Idempotency.TTL,
+		c.Logger,
+	)
 }
 
 // initMessageUseCases inicializa os casos de uso de mensagem
 func (c *Container) initMessageUseCases() {
+	c.RecipientVerifier = messageUseCases.NewRecipientVerifier(c.WhatsAppManager, c.ContactCache, c.Logger)
+
 	c.SendTextMessageUC = messageUseCases.NewSendTextMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
 	c.SendMediaMessageUC = messageUseCases.NewSendMediaMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
 	c.SendLocationMessageUC = messageUseCases.NewSendLocationMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
 	c.SendContactMessageUC = messageUseCases.NewSendContactMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
 	c.SendStickerMessageUC = messageUseCases.NewSendStickerMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
 	c.SendButtonsMessageUC = messageUseCases.NewSendButtonsMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
 	c.SendListMessageUC = messageUseCases.NewSendListMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
 	c.SendPollMessageUC = messageUseCases.NewSendPollMessageUseCase(
 		c.SessionRepo,
 		c.WhatsAppManager,
+		c.RecipientVerifier,
 		c.Logger,
 	)
 
@@ -239,6 +712,38 @@ func (c *Container) initMessageUseCases() {
 		c.WhatsAppManager,
 		c.Logger,
 	)
+
+	c.GetMessageHistoryUC = messageUseCases.NewGetMessageHistoryUseCase(
+		c.MessageRepo,
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.GetMessageStatusUC = messageUseCases.NewGetMessageStatusUseCase(
+		c.MessageRepo,
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.SendChatPresenceUC = messageUseCases.NewSendChatPresenceUseCase(
+		c.SessionRepo,
+		c.WhatsAppManager,
+		c.Logger,
+	)
+
+	c.MarkReadUC = messageUseCases.NewMarkReadUseCase(
+		c.SessionRepo,
+		c.MessageRepo,
+		c.WhatsAppManager,
+		c.Logger,
+	)
+
+	c.DownloadMediaUC = messageUseCases.NewDownloadMediaUseCase(
+		c.SessionRepo,
+		c.MessageRepo,
+		c.WhatsAppManager,
+		c.Logger,
+	)
 }
 
 // initGroupUseCases inicializa os casos de uso de grupo
@@ -356,6 +861,11 @@ func (c *Container) initHandlers() {
 		c.PairPhoneUC,
 		c.SetProxyUC,
 		c.GetStatusUC,
+		c.GetStatsUC,
+		c.SetCallPolicyUC,
+		c.GetCallPolicyUC,
+		c.SetHistorySyncUC,
+		c.GetHistorySyncUC,
 		c.Logger,
 	)
 
@@ -373,6 +883,12 @@ func (c *Container) initHandlers() {
 		c.EditMessageUC,
 		c.DeleteMessageUC,
 		c.ReactMessageUC,
+		c.GetMessageHistoryUC,
+		c.GetMessageStatusUC,
+		c.EnqueueMessageUC,
+		c.ListOutboundUC,
+		c.GetOutboundUC,
+		c.CancelOutboundUC,
 		c.Logger,
 	)
 
@@ -388,6 +904,24 @@ func (c *Container) initHandlers() {
 		c.EditMessageUC,
 		c.DeleteMessageUC,
 		c.ReactMessageUC,
+		c.SendChatPresenceUC,
+		c.MarkReadUC,
+		c.DownloadMediaUC,
+		c.ListChatsUC,
+		c.ArchiveChatUC,
+		c.PinChatUC,
+		c.MuteChatUC,
+		c.MarkChatUnreadUC,
+		c.ClearChatUC,
+		c.DeleteChatUC,
+	)
+
+	c.ContactHandler = handlers.NewContactHandler(
+		c.CheckContactsUC,
+		c.ListContactsUC,
+		c.GetContactUC,
+		c.GetProfilePictureUC,
+		c.GetBusinessProfileUC,
 	)
 
 	c.GroupHandler = handlers.NewGroupHandler(
@@ -408,6 +942,79 @@ func (c *Container) initHandlers() {
 		c.GetInviteInfoUC,
 		c.Logger,
 	)
+
+	c.AuthHandler = handlers.NewAuthHandler(
+		c.CreateAPIKeyUC,
+		c.ListAPIKeysUC,
+		c.RotateAPIKeyUC,
+		c.RevokeAPIKeyUC,
+		c.Logger,
+	)
+
+	c.WebhookHandler = handlers.NewWebhookHandler(
+		c.ListWebhooksUC,
+		c.CreateWebhookUC,
+		c.GetWebhookUC,
+		c.SetWebhookUC,
+		c.DeleteWebhookUC,
+		c.EnableWebhookUC,
+		c.DisableWebhookUC,
+		c.TestWebhookUC,
+		c.RotateSecretUC,
+		c.ListDeliveriesUC,
+		c.GetDeliveryUC,
+		c.ReplayDeliveryUC,
+		c.ReplayDeliveriesUC,
+		c.Logger,
+	)
+
+	c.MediaHandler = handlers.NewMediaHandler(
+		c.SetAutoDownloadUC,
+		c.GetStoredMediaUC,
+		c.MediaSigner,
+		c.Logger,
+	)
+
+	c.EventsHandler = handlers.NewEventsHandler(
+		c.SubscribeEventsUC,
+		c.Config.Events.HeartbeatInterval,
+		c.Config.Events.AllowedOrigins,
+		c.Logger,
+	)
+
+	c.ScheduleHandler = handlers.NewScheduleHandler(
+		c.ScheduleMessageUC,
+		c.ListSchedulesUC,
+		c.GetScheduleUC,
+		c.UpdateScheduleUC,
+		c.CancelScheduleUC,
+		c.Logger,
+	)
+
+	c.CampaignHandler = handlers.NewCampaignHandler(
+		c.CreateCampaignUC,
+		c.ListCampaignsUC,
+		c.GetCampaignUC,
+		c.CampaignReportUC,
+		c.CampaignRecipientsUC,
+		c.ControlCampaignUC,
+		c.Logger,
+	)
+
+	c.AuthMiddleware = appMiddleware.NewAuthMiddleware(
+		c.AuthenticateUC,
+		c.Config.Auth.Enabled,
+		c.Logger,
+	)
+
+	c.IdempotencyMiddleware = appMiddleware.NewIdempotencyMiddleware(
+		c.IdempotencyUC,
+		c.Logger,
+	)
+
+	if c.Config.Auth.Enabled && c.Config.Auth.AdminKey == "" {
+		c.Logger.Warn().Msg("Authentication enabled without AUTH_ADMIN_KEY; only keys stored in the database will be accepted")
+	}
 }
 
 // Close encerra o container e todos os seus recursos
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// KeyRole representa o papel de uma chave de API
+type KeyRole string
+
+const (
+	// KeyRoleAdmin concede acesso a todas as rotas, incluindo gerenciamento de sessões e chaves
+	KeyRoleAdmin KeyRole = "admin"
+	// KeyRoleSession concede acesso apenas às rotas da sessão vinculada
+	KeyRoleSession KeyRole = "session"
+)
+
+// APIKey representa uma chave de acesso à API
+type APIKey struct {
+	bun.BaseModel `bun:"table:zapcore_api_keys,alias:k"`
+
+	ID         uuid.UUID  `bun:"id,pk,type:uuid" json:"id"`
+	Name       string     `bun:"name,type:varchar(100),notnull" json:"name"`
+	Role       KeyRole    `bun:"role,type:varchar(20),notnull" json:"role"`
+	SessionID  *uuid.UUID `bun:"sessionId,type:uuid" json:"sessionId,omitempty"`
+	KeyHash    string     `bun:"keyHash,type:varchar(64),notnull,unique" json:"-"`
+	KeyPrefix  string     `bun:"keyPrefix,type:varchar(16),notnull" json:"keyPrefix"`
+	IsActive   bool       `bun:"isActive,type:boolean" json:"isActive"`
+	LastUsedAt *time.Time `bun:"lastUsedAt,type:timestamptz" json:"lastUsedAt,omitempty"`
+	RotatedAt  *time.Time `bun:"rotatedAt,type:timestamptz" json:"rotatedAt,omitempty"`
+	RevokedAt  *time.Time `bun:"revokedAt,type:timestamptz" json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt  time.Time  `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*APIKey) TableName() string {
+	return "zapcore_api_keys"
+}
+
+// IsAdmin verifica se a chave possui papel de administrador
+func (k *APIKey) IsAdmin() bool {
+	return k.Role == KeyRoleAdmin
+}
+
+// CanAccessSession verifica se a chave pode acessar as rotas da sessão informada
+func (k *APIKey) CanAccessSession(sessionID uuid.UUID) bool {
+	if k.IsAdmin() {
+		return true
+	}
+	return k.Role == KeyRoleSession && k.SessionID != nil && *k.SessionID == sessionID
+}
+
+// IsRevoked verifica se a chave foi revogada
+func (k *APIKey) IsRevoked() bool {
+	return !k.IsActive || k.RevokedAt != nil
+}
+
+// Revoke revoga a chave
+func (k *APIKey) Revoke() {
+	now := time.Now()
+	k.IsActive = false
+	k.RevokedAt = &now
+	k.UpdatedAt = now
+}
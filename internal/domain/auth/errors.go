@@ -0,0 +1,27 @@
+package auth
+
+import "errors"
+
+// Erros de domínio específicos para autenticação
+var (
+	// ErrAPIKeyNotFound indica que a chave de API não foi encontrada
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	// ErrAPIKeyMissing indica que a requisição não informou chave de API
+	ErrAPIKeyMissing = errors.New("api key missing")
+
+	// ErrAPIKeyInvalid indica que a chave de API é inválida
+	ErrAPIKeyInvalid = errors.New("invalid api key")
+
+	// ErrAPIKeyRevoked indica que a chave de API foi revogada
+	ErrAPIKeyRevoked = errors.New("api key revoked")
+
+	// ErrInvalidKeyRole indica que o papel informado para a chave é inválido
+	ErrInvalidKeyRole = errors.New("invalid api key role")
+
+	// ErrSessionIDRequired indica que chaves de sessão precisam de um sessionId
+	ErrSessionIDRequired = errors.New("session id is required for session keys")
+
+	// ErrForbidden indica que a chave não tem permissão para o recurso
+	ErrForbidden = errors.New("api key not allowed to access this resource")
+)
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// KeyPrefix é o prefixo de todas as chaves geradas pelo zmeow
+	KeyPrefix = "zmk_"
+
+	// keyRandomBytes é a quantidade de bytes aleatórios de cada chave
+	keyRandomBytes = 32
+
+	// keyDisplayLength é o tamanho do trecho da chave exibido em listagens
+	keyDisplayLength = 12
+)
+
+// GenerateKey gera uma nova chave em texto puro
+func GenerateKey() (string, error) {
+	buf := make([]byte, keyRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	return KeyPrefix + hex.EncodeToString(buf), nil
+}
+
+// HashKey calcula o hash SHA-256 de uma chave; apenas o hash é persistido
+func HashKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// DisplayPrefix retorna o trecho inicial da chave usado para identificá-la
+func DisplayPrefix(rawKey string) string {
+	if len(rawKey) <= keyDisplayLength {
+		return rawKey
+	}
+	return rawKey[:keyDisplayLength]
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyRepository define as operações de persistência para chaves de API
+type APIKeyRepository interface {
+	// Create cria uma nova chave no banco de dados
+	Create(ctx context.Context, key *APIKey) error
+
+	// GetByID busca uma chave pelo ID
+	GetByID(ctx context.Context, id uuid.UUID) (*APIKey, error)
+
+	// GetByHash busca uma chave pelo hash do segredo
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+
+	// List retorna todas as chaves
+	List(ctx context.Context) ([]*APIKey, error)
+
+	// Update atualiza uma chave existente
+	Update(ctx context.Context, key *APIKey) error
+
+	// UpdateLastUsed atualiza a data do último uso de uma chave
+	UpdateLastUsed(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,74 @@
+package campaign
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseRecipientsCSV lê a lista de destinatários de um CSV com cabeçalho. A coluna number é obrigatória;
+// as demais colunas viram as variáveis do destinatário, com o nome do cabeçalho.
+// O separador pode ser vírgula ou ponto e vírgula.
+func ParseRecipientsCSV(r io.Reader, maxRecipients int) ([]RecipientInput, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+	}
+	content := strings.TrimPrefix(string(data), "\ufeff")
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	if header, _, _ := strings.Cut(content, "\n"); strings.Count(header, ";") > strings.Count(header, ",") {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidCSV)
+	}
+
+	numberColumn := -1
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+		if strings.EqualFold(header[i], NumberVariable) {
+			numberColumn = i
+		}
+	}
+	if numberColumn < 0 {
+		return nil, fmt.Errorf("%w: header must have a number column", ErrInvalidCSV)
+	}
+
+	var recipients []RecipientInput
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+		}
+		if numberColumn >= len(record) || strings.TrimSpace(record[numberColumn]) == "" {
+			return nil, fmt.Errorf("%w: line %d has no number", ErrInvalidCSV, line)
+		}
+
+		input := RecipientInput{
+			Number:    strings.TrimSpace(record[numberColumn]),
+			Variables: make(map[string]string, len(header)-1),
+		}
+		for i, name := range header {
+			if i == numberColumn || i >= len(record) || name == "" {
+				continue
+			}
+			input.Variables[name] = strings.TrimSpace(record[i])
+		}
+		recipients = append(recipients, input)
+
+		if maxRecipients > 0 && len(recipients) > maxRecipients {
+			return nil, fmt.Errorf("%w: limit is %d", ErrTooManyRecipients, maxRecipients)
+		}
+	}
+	return recipients, nil
+}
@@ -0,0 +1,118 @@
+package campaign
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRecipientsCSV(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		maxRecipients int
+		want          []RecipientInput
+		wantErr       error
+	}{
+		{
+			name:    "comma separated",
+			content: "number,name,coupon\n5511999999999,Ana,AGO10\n5511988888888,Bruno,AGO15\n",
+			want: []RecipientInput{
+				{Number: "5511999999999", Variables: map[string]string{"name": "Ana", "coupon": "AGO10"}},
+				{Number: "5511988888888", Variables: map[string]string{"name": "Bruno", "coupon": "AGO15"}},
+			},
+		},
+		{
+			name:    "semicolon separated with a comma in a value",
+			content: "name;number;city\nAna;5511999999999;São Paulo, SP\n",
+			want: []RecipientInput{
+				{Number: "5511999999999", Variables: map[string]string{"name": "Ana", "city": "São Paulo, SP"}},
+			},
+		},
+		{
+			name:    "utf-8 bom, crlf and spaces",
+			content: "\ufeff Number , name\r\n 5511999999999 ,  Ana \r\n",
+			want: []RecipientInput{
+				{Number: "5511999999999", Variables: map[string]string{"name": "Ana"}},
+			},
+		},
+		{
+			name:    "quoted values",
+			content: "number,message\n5511999999999,\"Olá, \"\"Ana\"\"\"\n",
+			want: []RecipientInput{
+				{Number: "5511999999999", Variables: map[string]string{"message": `Olá, "Ana"`}},
+			},
+		},
+		{
+			name:    "short rows and unnamed columns",
+			content: "number,name,,coupon\n5511999999999,Ana,ignored\n",
+			want: []RecipientInput{
+				{Number: "5511999999999", Variables: map[string]string{"name": "Ana"}},
+			},
+		},
+		{
+			name:    "only the number column",
+			content: "number\n5511999999999\n",
+			want: []RecipientInput{
+				{Number: "5511999999999", Variables: map[string]string{}},
+			},
+		},
+		{
+			name:    "header only",
+			content: "number,name\n",
+		},
+		{
+			name:          "exactly at the limit",
+			content:       "number\n5511999999999\n5511988888888\n",
+			maxRecipients: 2,
+			want: []RecipientInput{
+				{Number: "5511999999999", Variables: map[string]string{}},
+				{Number: "5511988888888", Variables: map[string]string{}},
+			},
+		},
+		{
+			name:          "over the limit",
+			content:       "number\n5511999999999\n5511988888888\n5511977777777\n",
+			maxRecipients: 2,
+			wantErr:       ErrTooManyRecipients,
+		},
+		{
+			name:    "empty file",
+			content: "",
+			wantErr: ErrInvalidCSV,
+		},
+		{
+			name:    "no number column",
+			content: "phone,name\n5511999999999,Ana\n",
+			wantErr: ErrInvalidCSV,
+		},
+		{
+			name:    "row without number",
+			content: "number,name\n5511999999999,Ana\n,Bruno\n",
+			wantErr: ErrInvalidCSV,
+		},
+		{
+			name:    "row shorter than the number column",
+			content: "name,number\nAna\n",
+			wantErr: ErrInvalidCSV,
+		},
+		{
+			name:    "malformed quotes",
+			content: "number,name\n5511999999999,\"Ana\n",
+			wantErr: ErrInvalidCSV,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRecipientsCSV(strings.NewReader(tt.content), tt.maxRecipients)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseRecipientsCSV() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseRecipientsCSV() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package campaign
+
+import (
+	"encoding/json"
+
+	"zmeow/internal/domain/outbound"
+)
+
+// RecipientInput representa um destinatário informado na criação da campanha
+type RecipientInput struct {
+	Number    string            `json:"number" example:"5511999999999"`
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// CreateCampaignRequest representa a criação de uma campanha
+type CreateCampaignRequest struct {
+	Name string `json:"name" example:"Promoção de agosto"`
+	// Kind é o tipo de envio do modelo: text, media, location, contact, sticker, buttons, list ou poll
+	Kind outbound.Kind `json:"kind" example:"text"`
+	// Template é a requisição do endpoint de envio do tipo, com variáveis {{nome}} nos textos; o destino vem de cada destinatário
+	Template json.RawMessage `json:"template" swaggertype:"object"`
+	// RatePerMinute limita os envios por minuto da campanha (padrão da configuração)
+	RatePerMinute int              `json:"ratePerMinute,omitempty" example:"20"`
+	Recipients    []RecipientInput `json:"recipients"`
+}
+
+// ListCampaignsRequest representa os filtros da listagem de campanhas
+type ListCampaignsRequest struct {
+	Status string `json:"status,omitempty" example:"running"`
+	Limit  int    `json:"limit,omitempty" example:"50"`
+	Offset int    `json:"offset,omitempty" example:"0"`
+}
+
+// CampaignListResponse representa uma página de campanhas
+type CampaignListResponse struct {
+	Campaigns []*Campaign `json:"campaigns"`
+	Total     int         `json:"total"`
+	Limit     int         `json:"limit"`
+	Offset    int         `json:"offset"`
+}
+
+// ListRecipientsRequest representa os filtros da listagem de destinatários
+type ListRecipientsRequest struct {
+	Status string `json:"status,omitempty" example:"failed"`
+	Limit  int    `json:"limit,omitempty" example:"100"`
+	Offset int    `json:"offset,omitempty" example:"0"`
+}
+
+// RecipientListResponse representa uma página de destinatários
+type RecipientListResponse struct {
+	Recipients []*Recipient `json:"recipients"`
+	Total      int          `json:"total"`
+	Limit      int          `json:"limit"`
+	Offset     int          `json:"offset"`
+}
+
+// CampaignReport representa o andamento de uma campanha
+type CampaignReport struct {
+	Campaign *Campaign `json:"campaign"`
+	// Counts traz a quantidade de destinatários em cada status
+	Counts map[RecipientStatus]int `json:"counts"`
+	// Processed é a quantidade de destinatários que já saíram da fila (enviados, falhos ou cancelados)
+	Processed int `json:"processed"`
+	// Progress é o percentual processado da campanha
+	Progress float64 `json:"progress" example:"42.5"`
+	// DeliveryRate e ReadRate são os percentuais de entregues e lidas sobre as mensagens enviadas
+	DeliveryRate float64 `json:"deliveryRate" example:"97.1"`
+	ReadRate     float64 `json:"readRate" example:"61.3"`
+}
@@ -0,0 +1,153 @@
+package campaign
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/outbound"
+)
+
+// Status representa o estado de uma campanha
+type Status string
+
+const (
+	// StatusRunning indica que a campanha está enviando para os destinatários na fila
+	StatusRunning Status = "running"
+	// StatusPaused indica que os envios foram suspensos e podem ser retomados
+	StatusPaused Status = "paused"
+	// StatusCompleted indica que todos os destinatários foram processados
+	StatusCompleted Status = "completed"
+	// StatusCanceled indica que a campanha foi cancelada; os destinatários ainda na fila não recebem a mensagem
+	StatusCanceled Status = "canceled"
+)
+
+// IsValid verifica se o status é conhecido
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusRunning, StatusPaused, StatusCompleted, StatusCanceled:
+		return true
+	}
+	return false
+}
+
+// RecipientStatus representa o estado do envio para um destinatário da campanha
+type RecipientStatus string
+
+const (
+	RecipientQueued    RecipientStatus = "queued"
+	RecipientSending   RecipientStatus = "sending"
+	RecipientSent      RecipientStatus = "sent"
+	RecipientDelivered RecipientStatus = "delivered"
+	RecipientRead      RecipientStatus = "read"
+	RecipientFailed    RecipientStatus = "failed"
+	RecipientCanceled  RecipientStatus = "canceled"
+)
+
+// RecipientStatuses lista os status dos destinatários na ordem do relatório
+var RecipientStatuses = []RecipientStatus{
+	RecipientQueued, RecipientSending, RecipientSent, RecipientDelivered, RecipientRead, RecipientFailed, RecipientCanceled,
+}
+
+// IsValid verifica se o status do destinatário é conhecido
+func (s RecipientStatus) IsValid() bool {
+	for _, status := range RecipientStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Campaign representa um envio em massa do mesmo modelo de mensagem para uma lista de destinatários
+type Campaign struct {
+	bun.BaseModel `bun:"table:zapcore_campaigns,alias:cp"`
+
+	ID            uuid.UUID       `bun:"id,pk,type:uuid" json:"id"`
+	SessionID     uuid.UUID       `bun:"sessionId,type:uuid,notnull" json:"sessionId"`
+	Name          string          `bun:"name,type:varchar(255),notnull" json:"name"`
+	Kind          outbound.Kind   `bun:"kind,type:varchar(20),notnull" json:"kind"`
+	Template      json.RawMessage `bun:"template,type:jsonb,notnull" json:"template"`
+	Status        Status          `bun:"status,type:varchar(20),notnull" json:"status"`
+	RatePerMinute int             `bun:"ratePerMinute,type:integer,notnull" json:"ratePerMinute"`
+	Recipients    int             `bun:"recipients,type:integer,notnull" json:"recipients"`
+	CreatedAt     time.Time       `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt     time.Time       `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+	CompletedAt   *time.Time      `bun:"completedAt,type:timestamptz" json:"completedAt,omitempty"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Campaign) TableName() string {
+	return "zapcore_campaigns"
+}
+
+// Recipient representa um destinatário da campanha com suas variáveis do modelo
+type Recipient struct {
+	bun.BaseModel `bun:"table:zapcore_campaign_recipients,alias:cr"`
+
+	ID          uuid.UUID         `bun:"id,pk,type:uuid" json:"id"`
+	CampaignID  uuid.UUID         `bun:"campaignId,type:uuid,notnull" json:"campaignId"`
+	SessionID   uuid.UUID         `bun:"sessionId,type:uuid,notnull" json:"-"`
+	Seq         int               `bun:"seq,type:integer,notnull" json:"seq"`
+	Number      string            `bun:"number,type:varchar(64),notnull" json:"number"`
+	Variables   map[string]string `bun:"variables,type:jsonb" json:"variables,omitempty"`
+	Status      RecipientStatus   `bun:"status,type:varchar(20),notnull" json:"status"`
+	Attempts    int               `bun:"attempts,type:integer,notnull,default:0" json:"attempts"`
+	MessageID   string            `bun:"messageId,type:varchar(128)" json:"messageId,omitempty"`
+	LastError   string            `bun:"lastError,type:text" json:"lastError,omitempty"`
+	SentAt      *time.Time        `bun:"sentAt,type:timestamptz" json:"sentAt,omitempty"`
+	DeliveredAt *time.Time        `bun:"deliveredAt,type:timestamptz" json:"deliveredAt,omitempty"`
+	ReadAt      *time.Time        `bun:"readAt,type:timestamptz" json:"readAt,omitempty"`
+	UpdatedAt   time.Time         `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Recipient) TableName() string {
+	return "zapcore_campaign_recipients"
+}
+
+// MarkSent marca o destinatário como enviado com o ID da mensagem no WhatsApp
+func (r *Recipient) MarkSent(messageID string) {
+	now := time.Now()
+	r.Status = RecipientSent
+	r.MessageID = messageID
+	r.LastError = ""
+	r.SentAt = &now
+	r.UpdatedAt = now
+}
+
+// MarkFailed marca o envio para o destinatário como falho
+func (r *Recipient) MarkFailed(reason string) {
+	r.Status = RecipientFailed
+	r.LastError = reason
+	r.UpdatedAt = time.Now()
+}
+
+// Requeue devolve o destinatário à fila para uma nova tentativa, ou o marca como falho se as tentativas acabaram
+func (r *Recipient) Requeue(reason string, maxAttempts int) {
+	if r.Attempts >= maxAttempts {
+		r.MarkFailed(reason)
+		return
+	}
+	r.Status = RecipientQueued
+	r.LastError = reason
+	r.UpdatedAt = time.Now()
+}
+
+// Filter define os filtros para listagem das campanhas
+type Filter struct {
+	SessionID uuid.UUID
+	Status    Status
+	Limit     int
+	Offset    int
+}
+
+// RecipientFilter define os filtros para listagem dos destinatários de uma campanha
+type RecipientFilter struct {
+	CampaignID uuid.UUID
+	Status     RecipientStatus
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,36 @@
+package campaign
+
+import "errors"
+
+// Erros de domínio específicos das campanhas
+var (
+	// ErrCampaignNotFound indica que a campanha não foi encontrada na sessão
+	ErrCampaignNotFound = errors.New("campaign not found")
+
+	// ErrInvalidTransition indica que a campanha não está em um status que permita a operação
+	ErrInvalidTransition = errors.New("campaign status does not allow this operation")
+
+	// ErrInvalidTemplate indica que o modelo não é um payload JSON válido
+	ErrInvalidTemplate = errors.New("invalid campaign template")
+
+	// ErrMissingVariable indica que um destinatário não informa uma variável usada no modelo
+	ErrMissingVariable = errors.New("missing template variable")
+
+	// ErrNoRecipients indica que a campanha não tem destinatários
+	ErrNoRecipients = errors.New("campaign has no recipients")
+
+	// ErrTooManyRecipients indica que a lista de destinatários excede o limite configurado
+	ErrTooManyRecipients = errors.New("too many campaign recipients")
+
+	// ErrInvalidRecipient indica que um destinatário tem número inválido
+	ErrInvalidRecipient = errors.New("invalid campaign recipient")
+
+	// ErrInvalidRate indica um ritmo de envio fora do intervalo permitido
+	ErrInvalidRate = errors.New("invalid campaign rate per minute")
+
+	// ErrInvalidCSV indica um arquivo CSV de destinatários malformado ou sem a coluna number
+	ErrInvalidCSV = errors.New("invalid recipients CSV")
+
+	// ErrInvalidStatus indica que o status do filtro é desconhecido
+	ErrInvalidStatus = errors.New("invalid campaign status")
+)
@@ -0,0 +1,50 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository define as operações de persistência das campanhas e seus destinatários
+type Repository interface {
+	// Create insere a campanha e seus destinatários em uma única transação
+	Create(ctx context.Context, campaign *Campaign, recipients []*Recipient) error
+
+	// GetByID busca uma campanha de uma sessão pelo ID
+	GetByID(ctx context.Context, sessionID, id uuid.UUID) (*Campaign, error)
+
+	// List retorna as campanhas que atendem ao filtro, das mais recentes às mais antigas, e o total encontrado
+	List(ctx context.Context, filter Filter) ([]*Campaign, int, error)
+
+	// Transition altera o status da campanha se o status atual estiver entre from; retorna ErrInvalidTransition caso contrário.
+	// Ao cancelar, os destinatários ainda na fila também são cancelados.
+	Transition(ctx context.Context, sessionID, id uuid.UUID, from []Status, to Status) (*Campaign, error)
+
+	// CountRecipients retorna a quantidade de destinatários da campanha por status
+	CountRecipients(ctx context.Context, campaignID uuid.UUID) (map[RecipientStatus]int, error)
+
+	// ListRecipients retorna os destinatários que atendem ao filtro, na ordem da lista, e o total encontrado
+	ListRecipients(ctx context.Context, filter RecipientFilter) ([]*Recipient, int, error)
+
+	// ListActiveSessions retorna as sessões com campanhas em andamento e destinatários na fila
+	ListActiveSessions(ctx context.Context) ([]uuid.UUID, error)
+
+	// NextRecipient retorna o próximo destinatário na fila das campanhas em andamento da sessão, ou nil
+	NextRecipient(ctx context.Context, sessionID uuid.UUID) (*Recipient, error)
+
+	// ClaimRecipient marca o destinatário como em envio se ele ainda estiver na fila e a campanha em andamento
+	ClaimRecipient(ctx context.Context, recipient *Recipient) (bool, error)
+
+	// UpdateRecipient persiste o resultado do envio para o destinatário
+	UpdateRecipient(ctx context.Context, recipient *Recipient) error
+
+	// ApplyDeliveryStatus avança para delivered ou read os destinatários da mensagem e retorna quantos foram alterados
+	ApplyDeliveryStatus(ctx context.Context, sessionID uuid.UUID, messageID string, status RecipientStatus) (int, error)
+
+	// CompleteFinished marca como concluídas as campanhas em andamento sem destinatários pendentes
+	CompleteFinished(ctx context.Context) (int, error)
+
+	// FailInterrupted marca como falhos os destinatários que estavam em envio quando o processo foi encerrado
+	FailInterrupted(ctx context.Context, reason string) (int, error)
+}
@@ -0,0 +1,104 @@
+package campaign
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// variablePattern encontra as variáveis {{nome}} do modelo
+var variablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// NumberVariable é a variável preenchida automaticamente com o número do destinatário
+const NumberVariable = "number"
+
+// TemplateVariables retorna as variáveis usadas nos textos do modelo, em ordem alfabética
+func TemplateVariables(template json.RawMessage) ([]string, error) {
+	var value interface{}
+	if err := json.Unmarshal(template, &value); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	found := make(map[string]struct{})
+	walkStrings(value, func(s string) string {
+		for _, match := range variablePattern.FindAllStringSubmatch(s, -1) {
+			found[match[1]] = struct{}{}
+		}
+		return s
+	})
+
+	variables := make([]string, 0, len(found))
+	for name := range found {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+	return variables, nil
+}
+
+// MissingVariables retorna as variáveis do modelo que o destinatário não informa
+func MissingVariables(variables []string, values map[string]string) []string {
+	var missing []string
+	for _, name := range variables {
+		if name == NumberVariable {
+			continue
+		}
+		if _, ok := values[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// Render substitui as variáveis nos textos do modelo e direciona o payload ao número do destinatário
+func Render(template json.RawMessage, number string, values map[string]string) (json.RawMessage, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(template, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	if payload == nil {
+		return nil, fmt.Errorf("%w: must be a JSON object", ErrInvalidTemplate)
+	}
+
+	var missing string
+	rendered := walkStrings(payload, func(s string) string {
+		return variablePattern.ReplaceAllStringFunc(s, func(match string) string {
+			name := variablePattern.FindStringSubmatch(match)[1]
+			if name == NumberVariable {
+				return number
+			}
+			value, ok := values[name]
+			if !ok && missing == "" {
+				missing = name
+			}
+			return value
+		})
+	}).(map[string]interface{})
+	if missing != "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingVariable, missing)
+	}
+
+	rendered["number"] = number
+	delete(rendered, "groupJid")
+
+	return json.Marshal(rendered)
+}
+
+// walkStrings aplica fn a todos os textos de um valor JSON decodificado, retornando o valor alterado
+func walkStrings(value interface{}, fn func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return fn(v)
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = walkStrings(item, fn)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = walkStrings(item, fn)
+		}
+		return v
+	}
+	return value
+}
@@ -0,0 +1,151 @@
+package campaign
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTemplateVariables(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     []string
+		wantErr  error
+	}{
+		{name: "no variables", template: `{"text": "Olá"}`, want: []string{}},
+		{name: "sorted and deduplicated", template: `{"text": "{{name}}, {{coupon}} e {{name}}"}`, want: []string{"coupon", "name"}},
+		{name: "spaces inside braces", template: `{"text": "{{ name }}"}`, want: []string{"name"}},
+		{name: "number variable", template: `{"text": "{{number}}"}`, want: []string{"number"}},
+		{name: "dots, dashes and underscores", template: `{"text": "{{user.first_name}} {{due-date}}"}`, want: []string{"due-date", "user.first_name"}},
+		{name: "nested objects and arrays", template: `{"caption": "{{a}}", "options": ["{{b}}", {"title": "{{c}}"}], "count": 2}`, want: []string{"a", "b", "c"}},
+		{name: "variables in keys are ignored", template: `{"{{key}}": "x"}`, want: []string{}},
+		{name: "invalid names are ignored", template: `{"text": "{{first name}} {{}} {name}"}`, want: []string{}},
+		{name: "invalid json", template: `{"text": `, wantErr: ErrInvalidTemplate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TemplateVariables(json.RawMessage(tt.template))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TemplateVariables() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("TemplateVariables() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissingVariables(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables []string
+		values    map[string]string
+		want      []string
+	}{
+		{name: "all informed", variables: []string{"coupon", "name"}, values: map[string]string{"coupon": "X", "name": "Ana"}},
+		{name: "empty value counts as informed", variables: []string{"name"}, values: map[string]string{"name": ""}},
+		{name: "number is always informed", variables: []string{"number"}},
+		{name: "missing in order", variables: []string{"a", "b", "c"}, values: map[string]string{"b": "x"}, want: []string{"a", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MissingVariables(tt.variables, tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("MissingVariables() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		number   string
+		values   map[string]string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "text with variables",
+			template: `{"text": "Olá {{name}}, seu cupom é {{ coupon }}"}`,
+			number:   "5511999999999",
+			values:   map[string]string{"name": "Ana", "coupon": "AGO10"},
+			want:     `{"number": "5511999999999", "text": "Olá Ana, seu cupom é AGO10"}`,
+		},
+		{
+			name:     "number variable",
+			template: `{"text": "Seu número: {{number}}"}`,
+			number:   "5511999999999",
+			want:     `{"number": "5511999999999", "text": "Seu número: 5511999999999"}`,
+		},
+		{
+			name:     "values are not expanded again",
+			template: `{"text": "{{a}}"}`,
+			number:   "5511999999999",
+			values:   map[string]string{"a": "{{b}}", "b": "x"},
+			want:     `{"number": "5511999999999", "text": "{{b}}"}`,
+		},
+		{
+			name:     "nested fields and non text values",
+			template: `{"question": "{{q}}", "options": ["{{a}}", "Não"], "maxAnswers": 1, "poll": {"title": "{{q}}"}}`,
+			number:   "5511999999999",
+			values:   map[string]string{"q": "Vem?", "a": "Sim"},
+			want:     `{"number": "5511999999999", "question": "Vem?", "options": ["Sim", "Não"], "maxAnswers": 1, "poll": {"title": "Vem?"}}`,
+		},
+		{
+			name:     "destination in the template is replaced",
+			template: `{"number": "5500000000000", "groupJid": "123@g.us", "text": "oi"}`,
+			number:   "5511999999999",
+			want:     `{"number": "5511999999999", "text": "oi"}`,
+		},
+		{
+			name:     "missing variable",
+			template: `{"text": "{{name}} {{coupon}}"}`,
+			number:   "5511999999999",
+			values:   map[string]string{"name": "Ana"},
+			wantErr:  ErrMissingVariable,
+		},
+		{
+			name:     "invalid json",
+			template: `{"text": `,
+			wantErr:  ErrInvalidTemplate,
+		},
+		{
+			name:     "array template",
+			template: `["{{name}}"]`,
+			wantErr:  ErrInvalidTemplate,
+		},
+		{
+			name:     "null template",
+			template: `null`,
+			wantErr:  ErrInvalidTemplate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(json.RawMessage(tt.template), tt.number, tt.values)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Render() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			var gotValue, wantValue interface{}
+			if err := json.Unmarshal(got, &gotValue); err != nil {
+				t.Fatalf("Render() returned invalid JSON %s: %v", got, err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &wantValue); err != nil {
+				t.Fatalf("invalid expected JSON %s: %v", tt.want, err)
+			}
+			if !reflect.DeepEqual(gotValue, wantValue) {
+				t.Fatalf("Render() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package chat
+
+import "time"
+
+// Tipos de chat aceitos no filtro da listagem
+const (
+	TypeGroup      = "group"
+	TypeIndividual = "individual"
+)
+
+// ListChatsRequest representa os filtros da listagem de chats
+type ListChatsRequest struct {
+	// Archived: "true" apenas arquivados, "false" apenas não arquivados; vazio retorna todos
+	Archived *bool  `json:"archived,omitempty"`
+	Unread   bool   `json:"unread,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Search   string `json:"search,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	Offset   int    `json:"offset,omitempty"`
+}
+
+// ChatListResponse representa uma página da listagem de chats
+type ChatListResponse struct {
+	Chats      []*Chat `json:"chats"`
+	TotalCount int     `json:"totalCount"`
+	HasMore    bool    `json:"hasMore"`
+}
+
+// ArchiveChatRequest representa a requisição para arquivar ou desarquivar um chat
+type ArchiveChatRequest struct {
+	Number   string `json:"number,omitempty" example:"559981769536" description:"Número do chat"`
+	GroupJid string `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo"`
+	Archive  bool   `json:"archive" example:"true" description:"true arquiva, false desarquiva"`
+}
+
+// PinChatRequest representa a requisição para fixar ou desafixar um chat
+type PinChatRequest struct {
+	Number   string `json:"number,omitempty" example:"559981769536" description:"Número do chat"`
+	GroupJid string `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo"`
+	Pin      bool   `json:"pin" example:"true" description:"true fixa, false desafixa"`
+}
+
+// MuteChatRequest representa a requisição para silenciar ou reativar as notificações de um chat
+type MuteChatRequest struct {
+	Number   string `json:"number,omitempty" example:"559981769536" description:"Número do chat"`
+	GroupJid string `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo"`
+	Mute     bool   `json:"mute" example:"true" description:"true silencia, false reativa"`
+	// DurationSeconds é o tempo de silenciamento; 0 silencia por tempo indeterminado
+	DurationSeconds int64 `json:"durationSeconds,omitempty" example:"28800" description:"Duração em segundos (0 = para sempre)"`
+}
+
+// MarkChatUnreadRequest representa a requisição para marcar um chat como não lido ou lido
+type MarkChatUnreadRequest struct {
+	Number   string `json:"number,omitempty" example:"559981769536" description:"Número do chat"`
+	GroupJid string `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo"`
+	Unread   bool   `json:"unread" example:"true" description:"true marca como não lido, false como lido"`
+}
+
+// ChatTargetRequest identifica o chat das operações de limpeza e exclusão
+type ChatTargetRequest struct {
+	Number   string `json:"number,omitempty" example:"559981769536" description:"Número do chat"`
+	GroupJid string `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo"`
+}
+
+// ChatActionResponse representa o resultado de uma operação em um chat
+type ChatActionResponse struct {
+	Chat      string    `json:"chat"`
+	Action    string    `json:"action"`
+	Timestamp time.Time `json:"timestamp"`
+	// State é o estado do chat após a operação (ausente após a exclusão)
+	State *Chat `json:"state,omitempty"`
+}
@@ -0,0 +1,42 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// MutedForever é o fim do silenciamento de chats silenciados por tempo indeterminado
+var MutedForever = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+// Chat representa uma conversa da sessão, individual ou de grupo
+type Chat struct {
+	bun.BaseModel `bun:"table:zapcore_chats,alias:c"`
+
+	ID          uuid.UUID  `bun:"id,pk,type:uuid" json:"-"`
+	SessionID   uuid.UUID  `bun:"sessionId,type:uuid,notnull,unique:session_chat" json:"sessionId"`
+	JID         string     `bun:"jid,type:varchar(100),notnull,unique:session_chat" json:"jid"`
+	Name        string     `bun:"name,type:varchar(255)" json:"name,omitempty"`
+	IsGroup     bool       `bun:"isGroup,type:boolean,notnull,default:false" json:"isGroup"`
+	UnreadCount int        `bun:"unreadCount,type:integer,notnull,default:0" json:"unreadCount"`
+	Archived    bool       `bun:"archived,type:boolean,notnull,default:false" json:"archived"`
+	Pinned      bool       `bun:"pinned,type:boolean,notnull,default:false" json:"pinned"`
+	MuteEndTime *time.Time `bun:"muteEndTime,type:timestamptz" json:"muteEndTime,omitempty"`
+	// MarkedUnread indica que o chat foi marcado como não lido manualmente
+	MarkedUnread bool `bun:"markedUnread,type:boolean,notnull,default:false" json:"markedUnread"`
+	// LastMessageAt é o horário da mensagem mais recente conhecida do chat
+	LastMessageAt *time.Time `bun:"lastMessageAt,type:timestamptz" json:"lastMessageAt,omitempty"`
+	CreatedAt     time.Time  `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt     time.Time  `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Chat) TableName() string {
+	return "zapcore_chats"
+}
+
+// IsMuted verifica se as notificações do chat estão silenciadas no momento
+func (c *Chat) IsMuted() bool {
+	return c.MuteEndTime != nil && c.MuteEndTime.After(time.Now())
+}
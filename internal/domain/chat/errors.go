@@ -0,0 +1,15 @@
+package chat
+
+import "errors"
+
+// Erros de domínio específicos para chats
+var (
+	// ErrChatNotFound indica que o chat não foi encontrado na sessão
+	ErrChatNotFound = errors.New("chat not found")
+
+	// ErrInvalidChatType indica que o tipo de chat do filtro é inválido
+	ErrInvalidChatType = errors.New("invalid chat type")
+
+	// ErrInvalidMuteDuration indica que a duração do silenciamento é inválida
+	ErrInvalidMuteDuration = errors.New("invalid mute duration")
+)
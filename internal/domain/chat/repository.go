@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatRepository define as operações de persistência dos chats das sessões
+type ChatRepository interface {
+	// Upsert cria o chat ou atualiza o existente com o mesmo JID na sessão.
+	// Nomes vazios não sobrescrevem o nome conhecido e lastMessageAt nunca retrocede;
+	// contadores, arquivamento, fixação e silenciamento assumem os valores informados.
+	Upsert(ctx context.Context, chat *Chat) error
+
+	// GetByJID busca um chat da sessão pelo JID
+	GetByJID(ctx context.Context, sessionID uuid.UUID, jid string) (*Chat, error)
+
+	// List retorna os chats que atendem ao filtro: fixados primeiro, depois pela mensagem mais recente
+	List(ctx context.Context, filter ChatFilter) ([]*Chat, error)
+
+	// RecordMessage registra uma mensagem no chat, criando-o se necessário.
+	// Mensagens recebidas incrementam o contador de não lidas; enviadas pela conta marcam o chat como lido.
+	RecordMessage(ctx context.Context, sessionID uuid.UUID, jid string, isGroup, fromMe bool, at time.Time) error
+
+	// SetArchived arquiva ou desarquiva o chat; arquivar também desafixa, como no WhatsApp
+	SetArchived(ctx context.Context, sessionID uuid.UUID, jid string, archived bool) error
+
+	// SetPinned fixa ou desafixa o chat
+	SetPinned(ctx context.Context, sessionID uuid.UUID, jid string, pinned bool) error
+
+	// SetMuteEndTime silencia o chat até o horário informado (nil remove o silenciamento)
+	SetMuteEndTime(ctx context.Context, sessionID uuid.UUID, jid string, muteEndTime *time.Time) error
+
+	// SetRead marca o chat como lido (zerando as não lidas) ou como não lido
+	SetRead(ctx context.Context, sessionID uuid.UUID, jid string, read bool) error
+
+	// Clear zera as não lidas do chat após a limpeza das mensagens
+	Clear(ctx context.Context, sessionID uuid.UUID, jid string) error
+
+	// Delete remove o chat da sessão
+	Delete(ctx context.Context, sessionID uuid.UUID, jid string) error
+}
+
+// ChatFilter define os filtros da listagem de chats
+type ChatFilter struct {
+	SessionID uuid.UUID
+	// Archived filtra por arquivamento; nil retorna arquivados e não arquivados
+	Archived *bool
+	// Unread retorna apenas chats com mensagens não lidas ou marcados como não lidos
+	Unread bool
+	// IsGroup filtra chats de grupo (true) ou individuais (false)
+	IsGroup *bool
+	// Search busca no nome e no JID do chat
+	Search string
+	Limit  int
+	Offset int
+}
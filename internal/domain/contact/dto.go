@@ -0,0 +1,92 @@
+package contact
+
+// MaxCheckNumbers é o limite de números por verificação
+const MaxCheckNumbers = 100
+
+// CheckContactsRequest representa a requisição para verificar se números têm conta no WhatsApp
+type CheckContactsRequest struct {
+	Numbers []string `json:"numbers" example:"5511999999999,5521988888888" description:"Números em formato internacional"`
+}
+
+// CheckResult representa o resultado da verificação de um número
+type CheckResult struct {
+	// Number é o número como informado na requisição
+	Number       string `json:"number"`
+	IsOnWhatsApp bool   `json:"isOnWhatsApp"`
+	// JID é o JID canônico da conta; pode diferir do número informado (ex.: nono dígito no Brasil)
+	JID          string `json:"jid,omitempty"`
+	IsBusiness   bool   `json:"isBusiness"`
+	VerifiedName string `json:"verifiedName,omitempty"`
+}
+
+// CheckContactsResponse representa o resultado da verificação de números
+type CheckContactsResponse struct {
+	Results    []CheckResult `json:"results"`
+	TotalCount int           `json:"totalCount"`
+}
+
+// ListContactsRequest representa os filtros da listagem de contatos sincronizados
+type ListContactsRequest struct {
+	Search string `json:"search,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Offset int    `json:"offset,omitempty"`
+}
+
+// ContactListResponse representa uma página da listagem de contatos
+type ContactListResponse struct {
+	Contacts   []*Contact `json:"contacts"`
+	TotalCount int        `json:"totalCount"`
+	HasMore    bool       `json:"hasMore"`
+}
+
+// ContactInfoResponse reúne os nomes sincronizados e as informações públicas de um contato
+type ContactInfoResponse struct {
+	JID          string `json:"jid"`
+	Name         string `json:"name,omitempty"`
+	PushName     string `json:"pushName,omitempty"`
+	FullName     string `json:"fullName,omitempty"`
+	BusinessName string `json:"businessName,omitempty"`
+	// About é o recado ("sobre") do perfil
+	About        string   `json:"about,omitempty"`
+	PictureID    string   `json:"pictureId,omitempty"`
+	IsBusiness   bool     `json:"isBusiness"`
+	VerifiedName string   `json:"verifiedName,omitempty"`
+	Devices      []string `json:"devices,omitempty"`
+}
+
+// ProfilePictureResponse representa a foto de perfil de um contato
+type ProfilePictureResponse struct {
+	JID string `json:"jid"`
+	// URL é temporária e pode ser baixada diretamente
+	URL string `json:"url"`
+	ID  string `json:"id"`
+	// Type é "preview" (miniatura) ou "image" (resolução completa)
+	Type       string `json:"type"`
+	DirectPath string `json:"directPath,omitempty"`
+}
+
+// BusinessHours representa o horário de funcionamento de um dia da semana
+type BusinessHours struct {
+	DayOfWeek string `json:"dayOfWeek"`
+	Mode      string `json:"mode"`
+	OpenTime  string `json:"openTime,omitempty"`
+	CloseTime string `json:"closeTime,omitempty"`
+}
+
+// BusinessCategory representa uma categoria da conta comercial
+type BusinessCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// BusinessProfileResponse representa o perfil de uma conta comercial
+type BusinessProfileResponse struct {
+	JID               string             `json:"jid"`
+	VerifiedName      string             `json:"verifiedName,omitempty"`
+	Address           string             `json:"address,omitempty"`
+	Email             string             `json:"email,omitempty"`
+	Categories        []BusinessCategory `json:"categories"`
+	ProfileOptions    map[string]string  `json:"profileOptions,omitempty"`
+	BusinessHoursZone string             `json:"businessHoursTimeZone,omitempty"`
+	BusinessHours     []BusinessHours    `json:"businessHours"`
+}
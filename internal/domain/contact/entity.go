@@ -0,0 +1,40 @@
+package contact
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Contact representa um contato conhecido pela sessão
+type Contact struct {
+	bun.BaseModel `bun:"table:zapcore_contacts,alias:ct"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid" json:"-"`
+	SessionID uuid.UUID `bun:"sessionId,type:uuid,notnull,unique:session_contact" json:"sessionId"`
+	JID       string    `bun:"jid,type:varchar(100),notnull,unique:session_contact" json:"jid"`
+	// PushName é o nome que o próprio contato definiu no WhatsApp
+	PushName string `bun:"pushName,type:varchar(255)" json:"pushName,omitempty"`
+	// FullName é o nome salvo na agenda do aparelho
+	FullName     string    `bun:"fullName,type:varchar(255)" json:"fullName,omitempty"`
+	BusinessName string    `bun:"businessName,type:varchar(255)" json:"businessName,omitempty"`
+	CreatedAt    time.Time `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt    time.Time `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Contact) TableName() string {
+	return "zapcore_contacts"
+}
+
+// DisplayName retorna o melhor nome disponível do contato
+func (c *Contact) DisplayName() string {
+	switch {
+	case c.FullName != "":
+		return c.FullName
+	case c.BusinessName != "":
+		return c.BusinessName
+	}
+	return c.PushName
+}
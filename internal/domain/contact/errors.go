@@ -0,0 +1,30 @@
+package contact
+
+import "errors"
+
+// Erros de domínio específicos para contatos
+var (
+	// ErrContactNotFound indica que o contato não foi encontrado na sessão
+	ErrContactNotFound = errors.New("contact not found")
+
+	// ErrNumbersRequired indica que nenhum número foi informado para a verificação
+	ErrNumbersRequired = errors.New("at least one number is required")
+
+	// ErrTooManyNumbers indica que a verificação excede o limite de números por requisição
+	ErrTooManyNumbers = errors.New("too many numbers in a single check")
+
+	// ErrInvalidNumber indica que o número ou JID do contato é inválido
+	ErrInvalidNumber = errors.New("invalid contact number")
+
+	// ErrNotOnWhatsApp indica que o número não tem conta no WhatsApp
+	ErrNotOnWhatsApp = errors.New("number is not on whatsapp")
+
+	// ErrNotBusiness indica que o contato não é uma conta comercial
+	ErrNotBusiness = errors.New("contact is not a business account")
+
+	// ErrProfilePictureNotSet indica que o contato não tem foto de perfil
+	ErrProfilePictureNotSet = errors.New("contact has no profile picture")
+
+	// ErrProfilePictureHidden indica que o contato ocultou a foto de perfil da conta da sessão
+	ErrProfilePictureHidden = errors.New("contact has hidden the profile picture")
+)
@@ -0,0 +1,29 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ContactRepository define as operações de persistência dos contatos das sessões
+type ContactRepository interface {
+	// Upsert cria o contato ou atualiza o existente com o mesmo JID na sessão.
+	// Campos vazios não sobrescrevem os valores conhecidos.
+	Upsert(ctx context.Context, contact *Contact) error
+
+	// GetByJID busca um contato da sessão pelo JID
+	GetByJID(ctx context.Context, sessionID uuid.UUID, jid string) (*Contact, error)
+
+	// List retorna os contatos que atendem ao filtro, ordenados pelo nome
+	List(ctx context.Context, filter ContactFilter) ([]*Contact, error)
+}
+
+// ContactFilter define os filtros da listagem de contatos
+type ContactFilter struct {
+	SessionID uuid.UUID
+	// Search busca nos nomes e no JID do contato
+	Search string
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,48 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// MaxKeyLength é o tamanho máximo aceito para o header Idempotency-Key
+const MaxKeyLength = 255
+
+// Status representa o estado de uma requisição idempotente
+type Status string
+
+const (
+	// StatusProcessing indica que a primeira requisição com a chave ainda está em execução
+	StatusProcessing Status = "processing"
+	// StatusCompleted indica que a resposta da requisição foi gravada e será repetida nas novas tentativas
+	StatusCompleted Status = "completed"
+)
+
+// Record representa uma chave de idempotência com o hash da requisição original e a resposta gravada
+type Record struct {
+	bun.BaseModel `bun:"table:zapcore_idempotency_keys,alias:ik"`
+
+	SessionID   uuid.UUID `bun:"sessionId,pk,type:uuid"`
+	Key         string    `bun:"key,pk,type:varchar(255)"`
+	RequestHash string    `bun:"requestHash,type:varchar(64),notnull"`
+	Method      string    `bun:"method,type:varchar(10),notnull"`
+	Path        string    `bun:"path,type:text,notnull"`
+	Status      Status    `bun:"status,type:varchar(20),notnull"`
+	StatusCode  int       `bun:"statusCode,type:integer,notnull,default:0"`
+	ContentType string    `bun:"contentType,type:varchar(255)"`
+	Response    []byte    `bun:"response,type:bytea"`
+	CreatedAt   time.Time `bun:"createdAt,type:timestamptz,notnull"`
+	ExpiresAt   time.Time `bun:"expiresAt,type:timestamptz,notnull"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Record) TableName() string {
+	return "zapcore_idempotency_keys"
+}
+
+// IsExpired verifica se a chave já passou da janela de retenção
+func (r *Record) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
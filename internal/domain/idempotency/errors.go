@@ -0,0 +1,15 @@
+package idempotency
+
+import "errors"
+
+// Erros de domínio específicos das chaves de idempotência
+var (
+	// ErrInvalidKey indica um header Idempotency-Key vazio ou longo demais
+	ErrInvalidKey = errors.New("invalid idempotency key")
+
+	// ErrKeyReused indica que a chave já foi usada com uma requisição diferente
+	ErrKeyReused = errors.New("idempotency key was already used with a different request")
+
+	// ErrRequestInProgress indica que a requisição original com a chave ainda está em execução
+	ErrRequestInProgress = errors.New("a request with this idempotency key is still in progress")
+)
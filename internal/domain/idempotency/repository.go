@@ -0,0 +1,24 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository define as operações de persistência das chaves de idempotência
+type Repository interface {
+	// Reserve grava a chave como em processamento. Se a chave já existir e não estiver expirada, não altera nada e
+	// retorna o registro existente; caso contrário retorna nil.
+	Reserve(ctx context.Context, record *Record) (*Record, error)
+
+	// Complete grava a resposta da requisição original da chave
+	Complete(ctx context.Context, record *Record) error
+
+	// Release remove a chave, permitindo que a requisição seja refeita
+	Release(ctx context.Context, sessionID uuid.UUID, key string) error
+
+	// DeleteExpired remove as chaves vencidas e retorna quantas foram removidas
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
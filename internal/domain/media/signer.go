@@ -0,0 +1,80 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// URLSigner monta as URLs de acesso às mídias armazenadas, servidas em /media/<chave>.
+// Com um segredo configurado, as URLs são assinadas (HMAC-SHA256) e dispensam a chave de API;
+// sem TTL a assinatura não expira, mantendo a URL estável.
+type URLSigner struct {
+	baseURL string
+	secret  []byte
+	ttl     time.Duration
+}
+
+// NewURLSigner cria um URLSigner; secret vazio desabilita a assinatura
+func NewURLSigner(baseURL, secret string, ttl time.Duration) *URLSigner {
+	return &URLSigner{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  []byte(secret),
+		ttl:     ttl,
+	}
+}
+
+// Enabled indica se as URLs são assinadas
+func (s *URLSigner) Enabled() bool {
+	return len(s.secret) > 0
+}
+
+// URL retorna a URL de acesso ao objeto, assinada quando houver segredo
+func (s *URLSigner) URL(key string) string {
+	objectURL := s.baseURL + "/media/" + key
+	if !s.Enabled() {
+		return objectURL
+	}
+
+	query := url.Values{}
+	var expires string
+	if s.ttl > 0 {
+		expires = strconv.FormatInt(time.Now().Add(s.ttl).Unix(), 10)
+		query.Set("expires", expires)
+	}
+	query.Set("signature", s.sign(key, expires))
+
+	return objectURL + "?" + query.Encode()
+}
+
+// Verify valida a assinatura e a expiração de uma URL; expires vazio significa sem expiração
+func (s *URLSigner) Verify(key, expires, signature string) error {
+	if !s.Enabled() || signature == "" {
+		return ErrInvalidSignature
+	}
+
+	if expires != "" {
+		unix, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil || time.Now().Unix() > unix {
+			return ErrInvalidSignature
+		}
+	}
+
+	expected := s.sign(key, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// sign calcula a assinatura da chave e da expiração
+func (s *URLSigner) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
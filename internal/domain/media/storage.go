@@ -0,0 +1,67 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// Erros de domínio do armazenamento de mídias
+var (
+	// ErrObjectNotFound indica que o objeto não existe no armazenamento
+	ErrObjectNotFound = errors.New("media object not found")
+
+	// ErrInvalidObjectKey indica que a chave do objeto é inválida
+	ErrInvalidObjectKey = errors.New("invalid media object key")
+
+	// ErrStorageDisabled indica que nenhum backend de armazenamento foi configurado
+	ErrStorageDisabled = errors.New("media storage is disabled")
+
+	// ErrInvalidSignature indica que a assinatura da URL é inválida ou expirou
+	ErrInvalidSignature = errors.New("invalid or expired media URL signature")
+)
+
+// objectNamePattern aceita apenas nomes endereçados por conteúdo: sha256 em hex e extensão opcional
+var objectNamePattern = regexp.MustCompile(`^[a-f0-9]{64}(\.[a-z0-9]{1,10})?$`)
+
+// ObjectInfo representa os metadados de um objeto armazenado
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// Storage define o backend de armazenamento das mídias baixadas automaticamente.
+// As chaves têm o formato <sessionID>/<sha256><extensão> (ver ObjectKey).
+type Storage interface {
+	// Put grava o objeto; size pode ser -1 quando desconhecido
+	Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error
+	// Get abre o objeto para leitura; o chamador deve fechar o reader
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	// Exists verifica se o objeto já está armazenado
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete remove o objeto; remover um objeto inexistente não é erro
+	Delete(ctx context.Context, key string) error
+}
+
+// ObjectKey monta a chave endereçada por conteúdo de uma mídia da sessão
+func ObjectKey(sessionID uuid.UUID, sha256Hex, extension string) string {
+	return sessionID.String() + "/" + sha256Hex + extension
+}
+
+// ParseObjectKey valida o nome do objeto recebido na URL e monta a chave correspondente
+func ParseObjectKey(sessionID uuid.UUID, objectName string) (string, error) {
+	if !objectNamePattern.MatchString(objectName) {
+		return "", ErrInvalidObjectKey
+	}
+	return sessionID.String() + "/" + objectName, nil
+}
+
+// AutoDownloadResponse representa a configuração de download automático de mídias de uma sessão
+type AutoDownloadResponse struct {
+	SessionID    uuid.UUID `json:"sessionId" example:"0b6f1c52-8d1f-4a39-9a57-2c1f1d0e7a10"`
+	AutoDownload bool      `json:"autoDownload" example:"true"`
+}
@@ -1,6 +1,7 @@
 package message
 
 import (
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,125 +9,136 @@ import (
 
 // SendTextMessageRequest representa a requisição para envio de mensagem de texto
 type SendTextMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" swaggertype:"string" format:"phone" description:"Número do destinatário (formato: código do país + DDD + número)"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Text        string                 `json:"text" validate:"required" example:"Olá, isso é um teste!" description:"Texto da mensagem a ser enviada"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem (reply, menções)"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados para a mensagem"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" swaggertype:"string" format:"phone" description:"Número do destinatário (formato: código do país + DDD + número)"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Text            string                 `json:"text" validate:"required" example:"Olá, isso é um teste!" description:"Texto da mensagem a ser enviada"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem (reply, menções)"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados para a mensagem"`
 }
 
 // SendMediaMessageRequest representa a requisição para envio de mídia
 type SendMediaMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	MediaType   string                 `json:"mediaType" validate:"required,oneof=image audio video document" example:"image" enum:"image,audio,video,document" description:"Tipo de mídia a ser enviada"`
-	Media       string                 `json:"media" validate:"required" example:"data:image/jpeg;base64,/9j/4AAQSkZJRgABAQEASABIAAD..." description:"URL da mídia ou dados Base64 (formato: data:tipo/mime;base64,dados)"`
-	Caption     string                 `json:"caption,omitempty" example:"Legenda da imagem" description:"Legenda opcional para a mídia"`
-	FileName    string                 `json:"fileName,omitempty" example:"documento.pdf" description:"Nome do arquivo (obrigatório para documentos)"`
-	MimeType    string                 `json:"mimeType,omitempty" example:"image/jpeg" description:"Tipo MIME da mídia (detectado automaticamente se não fornecido)"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	MediaType       string                 `json:"mediaType" validate:"required,oneof=image audio video document" example:"image" enum:"image,audio,video,document" description:"Tipo de mídia a ser enviada"`
+	Media           string                 `json:"media" validate:"required" example:"data:image/jpeg;base64,/9j/4AAQSkZJRgABAQEASABIAAD..." description:"URL da mídia ou dados Base64 (formato: data:tipo/mime;base64,dados)"`
+	Caption         string                 `json:"caption,omitempty" example:"Legenda da imagem" description:"Legenda opcional para a mídia"`
+	FileName        string                 `json:"fileName,omitempty" example:"documento.pdf" description:"Nome do arquivo (obrigatório para documentos)"`
+	MimeType        string                 `json:"mimeType,omitempty" example:"image/jpeg" description:"Tipo MIME da mídia (detectado automaticamente se não fornecido)"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendImageMessageRequest representa a requisição para envio de imagem
 type SendImageMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Image       string                 `json:"image" validate:"required" example:"data:image/jpeg;base64,/9j/4AAQSkZJRgABAQEASABIAAD..." description:"Imagem em Base64 data URL ou URL pública"`
-	Caption     string                 `json:"caption,omitempty" example:"Olha essa imagem!" description:"Legenda opcional da imagem"`
-	MimeType    string                 `json:"mimeType,omitempty" example:"image/jpeg" description:"Tipo MIME da imagem (image/jpeg, image/png, etc.)"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Image           string                 `json:"image" validate:"required" example:"data:image/jpeg;base64,/9j/4AAQSkZJRgABAQEASABIAAD..." description:"Imagem em Base64 data URL ou URL pública"`
+	Caption         string                 `json:"caption,omitempty" example:"Olha essa imagem!" description:"Legenda opcional da imagem"`
+	MimeType        string                 `json:"mimeType,omitempty" example:"image/jpeg" description:"Tipo MIME da imagem (image/jpeg, image/png, etc.)"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendAudioMessageRequest representa a requisição para envio de áudio
 type SendAudioMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Audio       string                 `json:"audio" validate:"required" example:"data:audio/mpeg;base64,SUQzAwAAAAAfdlBSSVYAAAAgAAAAUGVhY2UuLi4..." description:"Áudio em Base64 data URL ou URL pública"`
-	Caption     string                 `json:"caption,omitempty" example:"Mensagem de áudio" description:"Legenda opcional do áudio"`
-	PTT         bool                   `json:"ptt,omitempty" example:"true" description:"Push to talk - true para mensagem de voz, false para áudio normal"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Audio           string                 `json:"audio" validate:"required" example:"data:audio/mpeg;base64,SUQzAwAAAAAfdlBSSVYAAAAgAAAAUGVhY2UuLi4..." description:"Áudio em Base64 data URL ou URL pública"`
+	Caption         string                 `json:"caption,omitempty" example:"Mensagem de áudio" description:"Legenda opcional do áudio"`
+	PTT             bool                   `json:"ptt,omitempty" example:"true" description:"Push to talk - true para mensagem de voz, false para áudio normal"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendVideoMessageRequest representa a requisição para envio de vídeo
 type SendVideoMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Video       string                 `json:"video" validate:"required" example:"data:video/mp4;base64,AAAAIGZ0eXBpc29tAAACAGlzb21pc28yYXZjMW1wNDE..." description:"Vídeo em Base64 data URL ou URL pública"`
-	Caption     string                 `json:"caption,omitempty" example:"Vídeo interessante!" description:"Legenda opcional do vídeo"`
-	MimeType    string                 `json:"mimeType,omitempty" example:"video/mp4" description:"Tipo MIME do vídeo (video/mp4, video/avi, etc.)"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Video           string                 `json:"video" validate:"required" example:"data:video/mp4;base64,AAAAIGZ0eXBpc29tAAACAGlzb21pc28yYXZjMW1wNDE..." description:"Vídeo em Base64 data URL ou URL pública"`
+	Caption         string                 `json:"caption,omitempty" example:"Vídeo interessante!" description:"Legenda opcional do vídeo"`
+	MimeType        string                 `json:"mimeType,omitempty" example:"video/mp4" description:"Tipo MIME do vídeo (video/mp4, video/avi, etc.)"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendDocumentMessageRequest representa a requisição para envio de documento
 type SendDocumentMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Document    string                 `json:"document" validate:"required" example:"data:application/pdf;base64,JVBERi0xLjQKJdP0zOEKMSAwIG9iag..." description:"Documento em Base64 data URL ou URL pública"`
-	FileName    string                 `json:"fileName" validate:"required" example:"relatorio.pdf" description:"Nome do arquivo com extensão (obrigatório)"`
-	Caption     string                 `json:"caption,omitempty" example:"Relatório mensal" description:"Legenda opcional do documento"`
-	MimeType    string                 `json:"mimeType,omitempty" example:"application/pdf" description:"Tipo MIME do documento"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Document        string                 `json:"document" validate:"required" example:"data:application/pdf;base64,JVBERi0xLjQKJdP0zOEKMSAwIG9iag..." description:"Documento em Base64 data URL ou URL pública"`
+	FileName        string                 `json:"fileName" validate:"required" example:"relatorio.pdf" description:"Nome do arquivo com extensão (obrigatório)"`
+	Caption         string                 `json:"caption,omitempty" example:"Relatório mensal" description:"Legenda opcional do documento"`
+	MimeType        string                 `json:"mimeType,omitempty" example:"application/pdf" description:"Tipo MIME do documento"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendStickerMessageRequest representa a requisição para envio de sticker
 type SendStickerMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Sticker     string                 `json:"sticker" validate:"required" example:"data:image/webp;base64,UklGRh4AAABXRUJQVlA4TBIAAAAvAAAAAAfQ..." description:"Sticker em Base64 data URL (preferencialmente WebP)"`
-	MimeType    string                 `json:"mimeType,omitempty" example:"image/webp" description:"Tipo MIME do sticker (image/webp recomendado)"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Sticker         string                 `json:"sticker" validate:"required" example:"data:image/webp;base64,UklGRh4AAABXRUJQVlA4TBIAAAAvAAAAAAfQ..." description:"Sticker em Base64 data URL (preferencialmente WebP)"`
+	MimeType        string                 `json:"mimeType,omitempty" example:"image/webp" description:"Tipo MIME do sticker (image/webp recomendado)"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendLocationMessageRequest representa a requisição para envio de localização
 type SendLocationMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Latitude    float64                `json:"latitude" validate:"required" example:"-23.550520" description:"Latitude da localização (coordenadas decimais)"`
-	Longitude   float64                `json:"longitude" validate:"required" example:"-46.633309" description:"Longitude da localização (coordenadas decimais)"`
-	Name        string                 `json:"name,omitempty" example:"Avenida Paulista" description:"Nome opcional do local"`
-	Address     string                 `json:"address,omitempty" example:"Av. Paulista, 1578 - Bela Vista, São Paulo - SP" description:"Endereço opcional do local"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Latitude        float64                `json:"latitude" validate:"required" example:"-23.550520" description:"Latitude da localização (coordenadas decimais)"`
+	Longitude       float64                `json:"longitude" validate:"required" example:"-46.633309" description:"Longitude da localização (coordenadas decimais)"`
+	Name            string                 `json:"name,omitempty" example:"Avenida Paulista" description:"Nome opcional do local"`
+	Address         string                 `json:"address,omitempty" example:"Av. Paulista, 1578 - Bela Vista, São Paulo - SP" description:"Endereço opcional do local"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendContactMessageRequest representa a requisição para envio de contato
 type SendContactMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	ContactName string                 `json:"contactName" validate:"required" example:"João Silva" description:"Nome do contato a ser compartilhado"`
-	ContactJID  string                 `json:"contactJID" validate:"required" example:"559987654321@s.whatsapp.net" description:"JID do contato no WhatsApp"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	ContactName     string                 `json:"contactName" validate:"required" example:"João Silva" description:"Nome do contato a ser compartilhado"`
+	ContactJID      string                 `json:"contactJID" validate:"required" example:"559987654321@s.whatsapp.net" description:"JID do contato no WhatsApp"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendButtonsMessageRequest representa a requisição para envio de mensagem com botões
 type SendButtonsMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Text        string                 `json:"text" validate:"required" example:"Escolha uma opção:" description:"Texto principal da mensagem"`
-	Footer      string                 `json:"footer,omitempty" example:"Powered by ZMeow" description:"Texto opcional no rodapé da mensagem"`
-	Buttons     []MessageButton        `json:"buttons" validate:"required,min=1,max=3" description:"Lista de botões (mínimo 1, máximo 3)"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Text            string                 `json:"text" validate:"required" example:"Escolha uma opção:" description:"Texto principal da mensagem"`
+	Footer          string                 `json:"footer,omitempty" example:"Powered by ZMeow" description:"Texto opcional no rodapé da mensagem"`
+	Buttons         []MessageButton        `json:"buttons" validate:"required,min=1,max=3" description:"Lista de botões (mínimo 1, máximo 3)"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendListMessageRequest representa a requisição para envio de mensagem com lista
 type SendListMessageRequest struct {
-	Number      string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
-	GroupJid    string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
-	Text        string                 `json:"text" validate:"required" example:"Escolha uma das opções abaixo:" description:"Texto principal da mensagem"`
-	Footer      string                 `json:"footer,omitempty" example:"Powered by ZMeow" description:"Texto opcional no rodapé"`
-	Title       string                 `json:"title" validate:"required" example:"Opções disponíveis" description:"Título da lista"`
-	ButtonText  string                 `json:"buttonText" validate:"required" example:"Ver opções" description:"Texto do botão que abre a lista"`
-	Sections    []MessageListSection   `json:"sections" validate:"required,min=1" description:"Seções da lista com itens"`
-	ContextInfo *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
+	Number          string                 `json:"number,omitempty" example:"559981769536" description:"Número do destinatário"`
+	GroupJid        string                 `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo de destino"`
+	Text            string                 `json:"text" validate:"required" example:"Escolha uma das opções abaixo:" description:"Texto principal da mensagem"`
+	Footer          string                 `json:"footer,omitempty" example:"Powered by ZMeow" description:"Texto opcional no rodapé"`
+	Title           string                 `json:"title" validate:"required" example:"Opções disponíveis" description:"Título da lista"`
+	ButtonText      string                 `json:"buttonText" validate:"required" example:"Ver opções" description:"Texto do botão que abre a lista"`
+	Sections        []MessageListSection   `json:"sections" validate:"required,min=1" description:"Seções da lista com itens"`
+	ContextInfo     *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
 // SendPollMessageRequest representa a requisição para envio de enquete
@@ -137,6 +149,7 @@ type SendPollMessageRequest struct {
 	Options                []string               `json:"options" validate:"required,min=2,max=12" example:"[\"Azul\", \"Verde\", \"Vermelho\"]" description:"Opções da enquete (mínimo 2, máximo 12)"`
 	SelectableOptionsCount int                    `json:"selectableOptionsCount" validate:"min=1" example:"1" description:"Número de opções que podem ser selecionadas"`
 	ContextInfo            *MessageContextInfo    `json:"contextInfo,omitempty" description:"Informações de contexto da mensagem"`
+	VerifyRecipient        bool                   `json:"verifyRecipient,omitempty" example:"false" description:"Verifica se o número tem conta no WhatsApp antes de enviar (considera o nono dígito)"`
 	Metadata               map[string]interface{} `json:"metadata,omitempty" description:"Metadados customizados"`
 }
 
@@ -153,6 +166,9 @@ type MessageContextInfo struct {
 	StanzaID      *string  `json:"stanzaId,omitempty" example:"ABCD123456" description:"ID da mensagem sendo respondida (para reply)"`
 	Participant   *string  `json:"participant,omitempty" example:"558199999999@s.whatsapp.net" description:"JID do participante que enviou a mensagem original (necessário para reply em grupos)"`
 	MentionedJIDs []string `json:"mentionedJids,omitempty" example:"[\"558199999999@s.whatsapp.net\"]" description:"Lista de JIDs mencionados na mensagem (@mencionar)"`
+	MentionAll    bool     `json:"mentionAll,omitempty" example:"false" description:"Menciona todos os participantes do grupo (apenas envios para grupos)"`
+	IsForwarded   bool     `json:"isForwarded,omitempty" example:"false" description:"Marca a mensagem como encaminhada"`
+	Expiration    uint32   `json:"expiration,omitempty" example:"604800" description:"Tempo de expiração em segundos, para chats com mensagens temporárias (86400, 604800 ou 7776000)"`
 }
 
 // MessageButton representa um botão em mensagem interativa
@@ -179,17 +195,19 @@ type MessageListRow struct {
 type SendMessageResponse struct {
 	ID        string                 `json:"id" example:"ABCD123456" description:"ID da mensagem enviada"`
 	Timestamp time.Time              `json:"timestamp" example:"2023-12-01T15:30:00Z" description:"Timestamp do envio"`
-	Status    string                 `json:"status" example:"sent" description:"Status da mensagem (sent, delivered, read)"`
+	Status    string                 `json:"status" example:"sent" description:"Status da mensagem no envio; acompanhe entregas e leituras em GET /messages/{sessionID}/{messageID}/status"`
 	Details   map[string]interface{} `json:"details,omitempty" description:"Detalhes adicionais do envio"`
 }
 
 // MessageHistoryRequest representa a requisição para histórico de mensagens
 type MessageHistoryRequest struct {
-	Limit  int    `json:"limit,omitempty"`
-	Offset int    `json:"offset,omitempty"`
-	ChatID string `json:"chatId,omitempty"`
-	Before string `json:"before,omitempty"` // Message ID
-	After  string `json:"after,omitempty"`  // Message ID
+	Limit     int    `json:"limit,omitempty"`
+	Offset    int    `json:"offset,omitempty"`
+	ChatID    string `json:"chatId,omitempty"`
+	Before    string `json:"before,omitempty"` // Message ID
+	After     string `json:"after,omitempty"`  // Message ID
+	Type      string `json:"type,omitempty"`
+	Direction string `json:"direction,omitempty"`
 }
 
 // MessageHistoryResponse representa a resposta do histórico de mensagens
@@ -215,15 +233,85 @@ type ReactMessageRequest struct {
 	Reaction string `json:"reaction" example:"👍" description:"Emoji da reação (ex: 👍, ❤️) ou string vazia para remover reação"`
 }
 
+// ChatPresenceState representa o indicador de presença enviado para um chat
+type ChatPresenceState string
+
+const (
+	// ChatPresenceComposing indica que a sessão está digitando
+	ChatPresenceComposing ChatPresenceState = "composing"
+	// ChatPresenceRecording indica que a sessão está gravando áudio
+	ChatPresenceRecording ChatPresenceState = "recording"
+	// ChatPresencePaused indica que a sessão parou de digitar ou gravar
+	ChatPresencePaused ChatPresenceState = "paused"
+)
+
+// IsValid verifica se o estado de presença é conhecido
+func (s ChatPresenceState) IsValid() bool {
+	return s == ChatPresenceComposing || s == ChatPresenceRecording || s == ChatPresencePaused
+}
+
+// ChatPresenceRequest representa a requisição para definir presença no chat
+type ChatPresenceRequest struct {
+	Number   string            `json:"number,omitempty" example:"559981769536" description:"Número do chat"`
+	GroupJid string            `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo"`
+	State    ChatPresenceState `json:"state" validate:"required,oneof=composing recording paused" example:"composing" description:"Estado da presença (composing, recording, paused)"`
+}
+
+// ChatPresenceResponse representa a resposta de definição de presença no chat
+type ChatPresenceResponse struct {
+	Chat  string            `json:"chat" example:"559981769536@s.whatsapp.net"`
+	State ChatPresenceState `json:"state" example:"composing"`
+}
+
+// MarkReadRequest representa a requisição para marcar mensagens como lidas
+type MarkReadRequest struct {
+	Number     string   `json:"number,omitempty" example:"559981769536" description:"Número do chat"`
+	GroupJid   string   `json:"groupJid,omitempty" example:"120363123456789012@g.us" description:"JID do grupo"`
+	MessageIDs []string `json:"messageIds" validate:"required" description:"IDs das mensagens a marcar como lidas"`
+	Sender     string   `json:"sender,omitempty" example:"559981769536@s.whatsapp.net" description:"Remetente das mensagens em grupos; se omitido, é obtido do histórico"`
+}
+
+// MarkReadResponse representa a resposta de marcação de mensagens como lidas
+type MarkReadResponse struct {
+	Chat       string    `json:"chat" example:"120363123456789012@g.us"`
+	MessageIDs []string  `json:"messageIds"`
+	ReadAt     time.Time `json:"readAt"`
+}
+
+// DownloadMediaRequest representa a requisição para download de mídia.
+// Aceita os campos do bloco "media" recebido no webhook ou apenas o ID de uma mensagem do histórico.
+type DownloadMediaRequest struct {
+	MessageID string `json:"messageId,omitempty" example:"3EB0C767D26A1D8E5A1F" description:"ID da mensagem no histórico; dispensa os demais campos"`
+	InboundMedia
+}
+
+// MediaContent representa uma mídia baixada e descriptografada.
+// Data deve ser fechado pelo chamador após a leitura.
+type MediaContent struct {
+	MimeType string
+	FileName string
+	Size     int64
+	Data     io.ReadCloser
+}
+
+// MediaDataURLResponse representa a mídia codificada como data URL (format=base64)
+type MediaDataURLResponse struct {
+	MimeType string `json:"mimeType" example:"image/jpeg"`
+	FileName string `json:"fileName,omitempty" example:"foto.jpg"`
+	Size     int64  `json:"size" example:"102400"`
+	DataURL  string `json:"dataUrl" example:"data:image/jpeg;base64,/9j/4AAQ..."`
+}
+
 // MessageInfo representa informações de uma mensagem
 type MessageInfo struct {
-	ID        string                 `json:"id"`
-	SessionID uuid.UUID              `json:"sessionId"`
-	ChatID    string                 `json:"chatId"`
-	SenderID  string                 `json:"senderId"`
-	Type      string                 `json:"type"`
-	Content   map[string]interface{} `json:"content"`
-	Timestamp time.Time              `json:"timestamp"`
-	IsFromMe  bool                   `json:"isFromMe"`
-	Status    string                 `json:"status,omitempty"`
+	ID        string          `json:"id"`
+	SessionID uuid.UUID       `json:"sessionId"`
+	ChatID    string          `json:"chatId"`
+	SenderID  string          `json:"senderId"`
+	Type      string          `json:"type"`
+	Direction Direction       `json:"direction"`
+	Content   *InboundMessage `json:"content"`
+	Timestamp time.Time       `json:"timestamp"`
+	IsFromMe  bool            `json:"isFromMe"`
+	Status    string          `json:"status,omitempty"`
 }
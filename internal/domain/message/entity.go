@@ -0,0 +1,104 @@
+package message
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Direction indica se a mensagem foi recebida ou enviada pela sessão
+type Direction string
+
+const (
+	// DirectionInbound indica mensagem recebida de outro contato
+	DirectionInbound Direction = "inbound"
+	// DirectionOutbound indica mensagem enviada pela sessão (pela API ou por outro dispositivo da conta)
+	DirectionOutbound Direction = "outbound"
+)
+
+// IsValid verifica se a direção é conhecida
+func (d Direction) IsValid() bool {
+	return d == DirectionInbound || d == DirectionOutbound
+}
+
+// Message representa uma mensagem persistida no histórico da sessão
+type Message struct {
+	bun.BaseModel `bun:"table:zapcore_messages,alias:m"`
+
+	ID        uuid.UUID          `bun:"id,pk,type:uuid" json:"-"`
+	Seq       int64              `bun:"seq,autoincrement" json:"-"`
+	SessionID uuid.UUID          `bun:"sessionId,type:uuid,notnull,unique:session_message" json:"sessionId"`
+	MessageID string             `bun:"messageId,type:varchar(128),notnull,unique:session_message" json:"messageId"`
+	ChatJID   string             `bun:"chatJid,type:varchar(100),notnull" json:"chatJid"`
+	SenderJID string             `bun:"senderJid,type:varchar(100)" json:"senderJid"`
+	Direction Direction          `bun:"direction,type:varchar(10),notnull" json:"direction"`
+	Type      InboundMessageType `bun:"type,type:varchar(30),notnull" json:"type"`
+	Text      string             `bun:"text,type:text" json:"text,omitempty"`
+	Content   *InboundMessage    `bun:"content,type:jsonb" json:"content"`
+	Timestamp time.Time          `bun:"timestamp,type:timestamptz,notnull" json:"timestamp"`
+	CreatedAt time.Time          `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+
+	// Status de entrega, rastreado apenas para mensagens enviadas
+	Status          Status     `bun:"status,type:varchar(20)" json:"status,omitempty"`
+	StatusUpdatedAt *time.Time `bun:"statusUpdatedAt,type:timestamptz" json:"statusUpdatedAt,omitempty"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Message) TableName() string {
+	return "zapcore_messages"
+}
+
+// NewMessage cria o registro de histórico a partir de uma mensagem normalizada
+func NewMessage(sessionID uuid.UUID, content *InboundMessage) *Message {
+	direction := DirectionInbound
+	var status Status
+	if content.FromMe {
+		direction = DirectionOutbound
+		status = StatusSent
+	}
+
+	return &Message{
+		ID:        uuid.New(),
+		SessionID: sessionID,
+		MessageID: content.ID,
+		ChatJID:   content.Chat,
+		SenderJID: content.Sender,
+		Direction: direction,
+		Type:      content.Type,
+		Text:      content.Text,
+		Content:   content,
+		Timestamp: content.Timestamp,
+		CreatedAt: time.Now(),
+		Status:    status,
+	}
+}
+
+// ToInfo converte o registro para o formato de resposta do histórico
+func (m *Message) ToInfo() MessageInfo {
+	return MessageInfo{
+		ID:        m.MessageID,
+		SessionID: m.SessionID,
+		ChatID:    m.ChatJID,
+		SenderID:  m.SenderJID,
+		Type:      string(m.Type),
+		Direction: m.Direction,
+		Content:   m.Content,
+		Timestamp: m.Timestamp,
+		IsFromMe:  m.Direction == DirectionOutbound,
+		Status:    string(m.Status),
+	}
+}
+
+// MessageFilter define os filtros para consulta do histórico
+type MessageFilter struct {
+	SessionID uuid.UUID
+	ChatJID   string
+	Type      InboundMessageType
+	Direction Direction
+	// Before e After são cursores: apenas mensagens anteriores/posteriores à mensagem de referência
+	Before *Message
+	After  *Message
+	Limit  int
+	Offset int
+}
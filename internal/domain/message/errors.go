@@ -0,0 +1,54 @@
+package message
+
+import "errors"
+
+// Erros de domínio específicos para mensagens
+var (
+	// ErrMessageNotFound indica que a mensagem não foi encontrada no histórico
+	ErrMessageNotFound = errors.New("message not found")
+
+	// ErrInvalidDirection indica que a direção informada é inválida
+	ErrInvalidDirection = errors.New("invalid message direction")
+
+	// ErrInvalidMessageType indica que o tipo de mensagem informado é inválido
+	ErrInvalidMessageType = errors.New("invalid message type")
+
+	// ErrConflictingCursors indica que before e after foram informados juntos
+	ErrConflictingCursors = errors.New("before and after cannot be used together")
+
+	// ErrReceiptNotFound indica que não há recibo do destinatário para a mensagem
+	ErrReceiptNotFound = errors.New("message receipt not found")
+
+	// ErrInvalidSendRequest indica que a requisição de envio não passou na validação (campos obrigatórios, formatos ou limites)
+	ErrInvalidSendRequest = errors.New("invalid send request")
+
+	// ErrInvalidDestination indica que o chat de destino (number ou groupJid) é inválido
+	ErrInvalidDestination = errors.New("invalid destination")
+
+	// ErrRecipientNotOnWhatsApp indica que a verificação prévia (verifyRecipient) não encontrou conta no WhatsApp para o número
+	ErrRecipientNotOnWhatsApp = errors.New("recipient is not on whatsapp")
+
+	// ErrInvalidContextInfo indica que o contexto da mensagem (resposta ou menções) é inválido
+	ErrInvalidContextInfo = errors.New("invalid context info")
+
+	// ErrInvalidPresenceState indica que o estado de presença informado é inválido
+	ErrInvalidPresenceState = errors.New("invalid chat presence state")
+
+	// ErrMessageIDsRequired indica que nenhum ID de mensagem foi informado
+	ErrMessageIDsRequired = errors.New("at least one message ID is required")
+
+	// ErrSenderRequired indica que o remetente de uma mensagem de grupo não pôde ser determinado
+	ErrSenderRequired = errors.New("sender is required for group messages not found in history")
+
+	// ErrInvalidMediaInfo indica que os dados de mídia informados são insuficientes ou inválidos
+	ErrInvalidMediaInfo = errors.New("invalid media info")
+
+	// ErrMessageHasNoMedia indica que a mensagem do histórico não contém mídia
+	ErrMessageHasNoMedia = errors.New("message has no media")
+
+	// ErrMediaTypeMismatch indica que a mídia da mensagem não é do tipo solicitado
+	ErrMediaTypeMismatch = errors.New("message media type does not match the requested type")
+
+	// ErrMediaExpired indica que a mídia não está mais disponível nos servidores do WhatsApp
+	ErrMediaExpired = errors.New("media is no longer available")
+)
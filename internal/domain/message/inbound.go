@@ -0,0 +1,213 @@
+package message
+
+import "time"
+
+// InboundMessageVersion é a versão do formato de InboundMessage enviado nos webhooks.
+// Deve ser incrementada sempre que um campo existente mudar de significado ou for removido.
+const InboundMessageVersion = 1
+
+// InboundMessageType representa o tipo normalizado de uma mensagem recebida
+type InboundMessageType string
+
+const (
+	InboundTypeText          InboundMessageType = "text"
+	InboundTypeExtendedText  InboundMessageType = "extended_text"
+	InboundTypeImage         InboundMessageType = "image"
+	InboundTypeVideo         InboundMessageType = "video"
+	InboundTypeAudio         InboundMessageType = "audio"
+	InboundTypePTT           InboundMessageType = "ptt"
+	InboundTypeDocument      InboundMessageType = "document"
+	InboundTypeSticker       InboundMessageType = "sticker"
+	InboundTypeLocation      InboundMessageType = "location"
+	InboundTypeLiveLocation  InboundMessageType = "live_location"
+	InboundTypeContact       InboundMessageType = "contact"
+	InboundTypeContactsArray InboundMessageType = "contacts_array"
+	InboundTypePollCreation  InboundMessageType = "poll_creation"
+	InboundTypePollVote      InboundMessageType = "poll_vote"
+	InboundTypeReaction      InboundMessageType = "reaction"
+	InboundTypeEdit          InboundMessageType = "edit"
+	InboundTypeRevoke        InboundMessageType = "revoke"
+	InboundTypeButtonReply   InboundMessageType = "button_reply"
+	InboundTypeListReply     InboundMessageType = "list_reply"
+	InboundTypeButtons       InboundMessageType = "buttons"
+	InboundTypeList          InboundMessageType = "list"
+	InboundTypeUnknown       InboundMessageType = "unknown"
+)
+
+// IsValid verifica se o tipo de mensagem é conhecido
+func (t InboundMessageType) IsValid() bool {
+	switch t {
+	case InboundTypeText, InboundTypeExtendedText, InboundTypeImage, InboundTypeVideo, InboundTypeAudio,
+		InboundTypePTT, InboundTypeDocument, InboundTypeSticker, InboundTypeLocation, InboundTypeLiveLocation,
+		InboundTypeContact, InboundTypeContactsArray, InboundTypePollCreation, InboundTypePollVote,
+		InboundTypeReaction, InboundTypeEdit, InboundTypeRevoke, InboundTypeButtonReply, InboundTypeListReply,
+		InboundTypeButtons, InboundTypeList, InboundTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// IsMedia verifica se o tipo de mensagem carrega mídia
+func (t InboundMessageType) IsMedia() bool {
+	switch t {
+	case InboundTypeImage, InboundTypeVideo, InboundTypeAudio, InboundTypePTT, InboundTypeDocument, InboundTypeSticker:
+		return true
+	}
+	return false
+}
+
+// IsChatMessage indica se a mensagem aparece na conversa; reações, edições, revogações e votos
+// alteram mensagens existentes e não movem o chat nem contam como não lidas
+func (t InboundMessageType) IsChatMessage() bool {
+	switch t {
+	case InboundTypeReaction, InboundTypeEdit, InboundTypeRevoke, InboundTypePollVote, InboundTypeUnknown:
+		return false
+	}
+	return true
+}
+
+// MediaKind agrupa os tipos de mídia pela chave de criptografia usada no download:
+// sticker é baixado como imagem e ptt como áudio. Retorna vazio para tipos sem mídia.
+func (t InboundMessageType) MediaKind() InboundMessageType {
+	switch t {
+	case InboundTypeImage, InboundTypeSticker:
+		return InboundTypeImage
+	case InboundTypeAudio, InboundTypePTT:
+		return InboundTypeAudio
+	case InboundTypeVideo, InboundTypeDocument:
+		return t
+	}
+	return ""
+}
+
+// InboundMessage representa uma mensagem recebida em formato estável, independente do protobuf do WhatsApp.
+// O mesmo formato é usado para as mensagens enviadas no histórico (FromMe = true).
+// Apenas o bloco correspondente a Type é preenchido (ex.: Media para image, Location para location).
+type InboundMessage struct {
+	Version   int                `json:"version" example:"1"`
+	ID        string             `json:"id" example:"3EB0C767D26A1D8E5A1F"`
+	Type      InboundMessageType `json:"type" example:"text"`
+	Chat      string             `json:"chat" example:"559981769536@s.whatsapp.net"`
+	Sender    string             `json:"sender" example:"559981769536@s.whatsapp.net"`
+	PushName  string             `json:"pushName,omitempty" example:"João Silva"`
+	FromMe    bool               `json:"fromMe"`
+	IsGroup   bool               `json:"isGroup"`
+	Timestamp time.Time          `json:"timestamp"`
+	Ephemeral bool               `json:"ephemeral,omitempty"`
+	ViewOnce  bool               `json:"viewOnce,omitempty"`
+
+	// Text contém o texto da mensagem ou a legenda da mídia
+	Text    string                 `json:"text,omitempty" example:"Olá!"`
+	Context *InboundMessageContext `json:"context,omitempty"`
+
+	Media       *InboundMedia       `json:"media,omitempty"`
+	Location    *InboundLocation    `json:"location,omitempty"`
+	Contacts    []InboundContact    `json:"contacts,omitempty"`
+	Poll        *InboundPoll        `json:"poll,omitempty"`
+	PollVote    *InboundPollVote    `json:"pollVote,omitempty"`
+	Reaction    *InboundReaction    `json:"reaction,omitempty"`
+	Edit        *InboundEdit        `json:"edit,omitempty"`
+	Revoke      *InboundRevoke      `json:"revoke,omitempty"`
+	ButtonReply *InboundButtonReply `json:"buttonReply,omitempty"`
+	ListReply   *InboundListReply   `json:"listReply,omitempty"`
+}
+
+// InboundMessageContext representa a mensagem citada, as menções e o encaminhamento
+type InboundMessageContext struct {
+	QuotedMessageID   string             `json:"quotedMessageId,omitempty" example:"3EB0C767D26A1D8E5A1F"`
+	QuotedParticipant string             `json:"quotedParticipant,omitempty" example:"559981769536@s.whatsapp.net"`
+	QuotedType        InboundMessageType `json:"quotedType,omitempty" example:"text"`
+	QuotedText        string             `json:"quotedText,omitempty" example:"Mensagem original"`
+	Mentions          []string           `json:"mentions,omitempty"`
+	Forwarded         bool               `json:"forwarded,omitempty"`
+	ForwardingScore   uint32             `json:"forwardingScore,omitempty"`
+}
+
+// InboundMedia representa os metadados de uma mídia recebida, suficientes para baixá-la
+type InboundMedia struct {
+	MimeType      string `json:"mimeType,omitempty" example:"image/jpeg"`
+	FileName      string `json:"fileName,omitempty" example:"relatorio.pdf"`
+	FileLength    uint64 `json:"fileLength,omitempty" example:"102400"`
+	FileSHA256    string `json:"fileSha256,omitempty"`
+	FileEncSHA256 string `json:"fileEncSha256,omitempty"`
+	MediaKey      string `json:"mediaKey,omitempty"`
+	URL           string `json:"url,omitempty"`
+	DirectPath    string `json:"directPath,omitempty"`
+	Width         uint32 `json:"width,omitempty"`
+	Height        uint32 `json:"height,omitempty"`
+	Seconds       uint32 `json:"seconds,omitempty"`
+	PageCount     uint32 `json:"pageCount,omitempty"`
+	PTT           bool   `json:"ptt,omitempty"`
+	GIFPlayback   bool   `json:"gifPlayback,omitempty"`
+	Animated      bool   `json:"animated,omitempty"`
+
+	// StorageKey e StoredURL são preenchidos quando a sessão baixa as mídias automaticamente
+	StorageKey string `json:"storageKey,omitempty" example:"0b6f1c52-8d1f-4a39-9a57-2c1f1d0e7a10/9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.jpg"`
+	StoredURL  string `json:"storedUrl,omitempty" example:"http://localhost:8080/media/0b6f1c52-8d1f-4a39-9a57-2c1f1d0e7a10/9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.jpg"`
+}
+
+// InboundLocation representa uma localização fixa ou em tempo real
+type InboundLocation struct {
+	Latitude       float64 `json:"latitude" example:"-23.550520"`
+	Longitude      float64 `json:"longitude" example:"-46.633309"`
+	Name           string  `json:"name,omitempty"`
+	Address        string  `json:"address,omitempty"`
+	URL            string  `json:"url,omitempty"`
+	Live           bool    `json:"live,omitempty"`
+	AccuracyMeters uint32  `json:"accuracyMeters,omitempty"`
+	SpeedMps       float32 `json:"speedMps,omitempty"`
+	Sequence       int64   `json:"sequence,omitempty"`
+}
+
+// InboundContact representa um contato compartilhado
+type InboundContact struct {
+	DisplayName string `json:"displayName" example:"João Silva"`
+	VCard       string `json:"vcard,omitempty"`
+}
+
+// InboundPoll representa a criação de uma enquete
+type InboundPoll struct {
+	Name                   string   `json:"name" example:"Qual sua cor favorita?"`
+	Options                []string `json:"options"`
+	SelectableOptionsCount uint32   `json:"selectableOptionsCount" example:"1"`
+}
+
+// InboundPollVote representa um voto em enquete. O conteúdo do voto chega criptografado
+// e só é identificável pela mensagem da enquete.
+type InboundPollVote struct {
+	PollMessageID string     `json:"pollMessageId" example:"3EB0C767D26A1D8E5A1F"`
+	VotedAt       *time.Time `json:"votedAt,omitempty"`
+}
+
+// InboundReaction representa uma reação; Emoji vazio indica remoção da reação
+type InboundReaction struct {
+	MessageID string `json:"messageId" example:"3EB0C767D26A1D8E5A1F"`
+	FromMe    bool   `json:"fromMe"`
+	Emoji     string `json:"emoji,omitempty" example:"👍"`
+	Removed   bool   `json:"removed,omitempty"`
+}
+
+// InboundEdit representa a edição de uma mensagem enviada anteriormente
+type InboundEdit struct {
+	MessageID string `json:"messageId" example:"3EB0C767D26A1D8E5A1F"`
+	Text      string `json:"text,omitempty" example:"Texto corrigido"`
+}
+
+// InboundRevoke representa a remoção de uma mensagem para todos
+type InboundRevoke struct {
+	MessageID string `json:"messageId" example:"3EB0C767D26A1D8E5A1F"`
+	FromMe    bool   `json:"fromMe"`
+}
+
+// InboundButtonReply representa o clique em um botão de resposta
+type InboundButtonReply struct {
+	ID          string `json:"id" example:"btn1"`
+	DisplayText string `json:"displayText,omitempty" example:"Sim"`
+}
+
+// InboundListReply representa a seleção de um item de lista
+type InboundListReply struct {
+	RowID       string `json:"rowId" example:"row1"`
+	Title       string `json:"title,omitempty" example:"Opção 1"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,41 @@
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MessageRepository define as operações de persistência do histórico de mensagens
+type MessageRepository interface {
+	// Save registra uma mensagem, ignorando duplicatas do mesmo ID na sessão
+	Save(ctx context.Context, msg *Message) error
+
+	// SaveBatch registra várias mensagens em uma única operação, ignorando duplicatas
+	SaveBatch(ctx context.Context, msgs []*Message) error
+
+	// GetByMessageID busca uma mensagem da sessão pelo ID do WhatsApp
+	GetByMessageID(ctx context.Context, sessionID uuid.UUID, messageID string) (*Message, error)
+
+	// List retorna as mensagens que atendem ao filtro, das mais recentes para as mais antigas
+	List(ctx context.Context, filter MessageFilter) ([]*Message, error)
+
+	// DeleteByChat remove do histórico as mensagens de um chat da sessão
+	DeleteByChat(ctx context.Context, sessionID uuid.UUID, chatJID string) error
+
+	// UpdateStatus atualiza o status agregado de entrega da mensagem
+	UpdateStatus(ctx context.Context, sessionID uuid.UUID, messageID string, status Status, updatedAt time.Time) error
+
+	// UpdateContent substitui o conteúdo normalizado da mensagem, como quando a mídia termina de ser arquivada
+	UpdateContent(ctx context.Context, sessionID uuid.UUID, messageID string, content *InboundMessage) error
+
+	// GetReceipt busca o status da mensagem para um destinatário
+	GetReceipt(ctx context.Context, sessionID uuid.UUID, messageID, recipientJID string) (*MessageReceipt, error)
+
+	// SaveReceipt cria ou atualiza o status da mensagem para um destinatário
+	SaveReceipt(ctx context.Context, receipt *MessageReceipt) error
+
+	// ListReceipts retorna o status da mensagem para cada destinatário
+	ListReceipts(ctx context.Context, sessionID uuid.UUID, messageID string) ([]*MessageReceipt, error)
+}
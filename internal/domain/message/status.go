@@ -0,0 +1,102 @@
+package message
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Status representa o status de entrega de uma mensagem enviada
+type Status string
+
+const (
+	// StatusSent indica que a mensagem foi aceita pelo servidor do WhatsApp
+	StatusSent Status = "sent"
+	// StatusDelivered indica que a mensagem chegou ao aparelho do destinatário
+	StatusDelivered Status = "delivered"
+	// StatusRead indica que o destinatário leu a mensagem
+	StatusRead Status = "read"
+	// StatusPlayed indica que o destinatário reproduziu o áudio ou vídeo
+	StatusPlayed Status = "played"
+	// StatusServerError indica que o servidor não conseguiu entregar a mensagem
+	StatusServerError Status = "server_error"
+	// StatusRetry indica que o destinatário pediu o reenvio por falha na descriptografia
+	StatusRetry Status = "retry"
+)
+
+// rank define a ordem de progressão dos status; status de falha não têm posição
+func (s Status) rank() int {
+	switch s {
+	case StatusSent:
+		return 1
+	case StatusDelivered:
+		return 2
+	case StatusRead:
+		return 3
+	case StatusPlayed:
+		return 4
+	}
+	return 0
+}
+
+// IsFailure verifica se o status indica falha na entrega
+func (s Status) IsFailure() bool {
+	return s == StatusServerError || s == StatusRetry
+}
+
+// CanTransitionTo verifica se o status pode ser substituído por next.
+// Os status só avançam (sent → delivered → read → played); falhas só são
+// registradas enquanto a mensagem ainda não foi entregue.
+func (s Status) CanTransitionTo(next Status) bool {
+	if s == next {
+		return false
+	}
+	if next.IsFailure() {
+		return s == "" || s == StatusSent || s.IsFailure()
+	}
+	if s.IsFailure() {
+		return true
+	}
+	return next.rank() > s.rank()
+}
+
+// MessageReceipt representa o status de uma mensagem enviada para um destinatário específico.
+// Em chats individuais há um único destinatário; em grupos, um por participante.
+type MessageReceipt struct {
+	bun.BaseModel `bun:"table:zapcore_message_receipts,alias:mr"`
+
+	ID           uuid.UUID `bun:"id,pk,type:uuid" json:"-"`
+	SessionID    uuid.UUID `bun:"sessionId,type:uuid,notnull,unique:session_message_recipient" json:"-"`
+	MessageID    string    `bun:"messageId,type:varchar(128),notnull,unique:session_message_recipient" json:"-"`
+	RecipientJID string    `bun:"recipientJid,type:varchar(100),notnull,unique:session_message_recipient" json:"recipient"`
+	Status       Status    `bun:"status,type:varchar(20),notnull" json:"status"`
+	UpdatedAt    time.Time `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*MessageReceipt) TableName() string {
+	return "zapcore_message_receipts"
+}
+
+// StatusChange descreve a mudança de status de uma mensagem causada por um recibo.
+// Status é o novo status do destinatário e MessageStatus o status agregado da mensagem.
+type StatusChange struct {
+	MessageID      string    `json:"messageId"`
+	Chat           string    `json:"chat"`
+	Recipient      string    `json:"recipient"`
+	Status         Status    `json:"status"`
+	PreviousStatus Status    `json:"previousStatus,omitempty"`
+	MessageStatus  Status    `json:"messageStatus"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// MessageStatusResponse representa o status de uma mensagem enviada e de cada destinatário
+type MessageStatusResponse struct {
+	MessageID  string            `json:"messageId" example:"3EB0C767D26A1D8E5A1F"`
+	Chat       string            `json:"chat" example:"559981769536@s.whatsapp.net"`
+	Direction  Direction         `json:"direction" example:"outbound"`
+	Status     Status            `json:"status" example:"read"`
+	UpdatedAt  *time.Time        `json:"updatedAt,omitempty"`
+	Recipients []*MessageReceipt `json:"recipients"`
+}
@@ -0,0 +1,61 @@
+package message
+
+import "testing"
+
+func TestStatusCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name string
+		from Status
+		to   Status
+		want bool
+	}{
+		{name: "first receipt delivered", from: "", to: StatusDelivered, want: true},
+		{name: "first receipt server error", from: "", to: StatusServerError, want: true},
+		{name: "sent to delivered", from: StatusSent, to: StatusDelivered, want: true},
+		{name: "sent to read skipping delivered", from: StatusSent, to: StatusRead, want: true},
+		{name: "delivered to read", from: StatusDelivered, to: StatusRead, want: true},
+		{name: "read to played", from: StatusRead, to: StatusPlayed, want: true},
+		{name: "same status", from: StatusDelivered, to: StatusDelivered, want: false},
+		{name: "read back to delivered", from: StatusRead, to: StatusDelivered, want: false},
+		{name: "played back to read", from: StatusPlayed, to: StatusRead, want: false},
+		{name: "delivered back to sent", from: StatusDelivered, to: StatusSent, want: false},
+		{name: "sent to server error", from: StatusSent, to: StatusServerError, want: true},
+		{name: "sent to retry", from: StatusSent, to: StatusRetry, want: true},
+		{name: "retry to server error", from: StatusRetry, to: StatusServerError, want: true},
+		{name: "delivered to server error", from: StatusDelivered, to: StatusServerError, want: false},
+		{name: "read to retry", from: StatusRead, to: StatusRetry, want: false},
+		{name: "retry to delivered", from: StatusRetry, to: StatusDelivered, want: true},
+		{name: "server error to sent", from: StatusServerError, to: StatusSent, want: true},
+		{name: "retry to retry", from: StatusRetry, to: StatusRetry, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Fatalf("%q.CanTransitionTo(%q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusIsFailure(t *testing.T) {
+	tests := []struct {
+		status Status
+		want   bool
+	}{
+		{status: StatusSent, want: false},
+		{status: StatusDelivered, want: false},
+		{status: StatusRead, want: false},
+		{status: StatusPlayed, want: false},
+		{status: StatusServerError, want: true},
+		{status: StatusRetry, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if got := tt.status.IsFailure(); got != tt.want {
+				t.Fatalf("%q.IsFailure() = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package outbound
+
+import "github.com/google/uuid"
+
+// EnqueueResponse representa a confirmação de uma mensagem aceita na fila de envio
+type EnqueueResponse struct {
+	JobID     uuid.UUID `json:"jobId" example:"0b6f3c9e-7f0e-4a55-9a39-5b1f1f0f6c11"`
+	SessionID uuid.UUID `json:"sessionId"`
+	Kind      Kind      `json:"kind" example:"text"`
+	Status    JobStatus `json:"status" example:"queued"`
+}
+
+// ListJobsRequest representa os filtros da listagem dos jobs da fila
+type ListJobsRequest struct {
+	Status string `json:"status,omitempty" example:"queued"`
+	Kind   string `json:"kind,omitempty" example:"text"`
+	Limit  int    `json:"limit,omitempty" example:"50"`
+	Offset int    `json:"offset,omitempty" example:"0"`
+}
+
+// JobListResponse representa uma página de jobs da fila
+type JobListResponse struct {
+	Jobs   []*Job `json:"jobs"`
+	Total  int    `json:"total"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+}
@@ -0,0 +1,132 @@
+package outbound
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Kind identifica o tipo de envio de um job, que define o formato do payload (a requisição do endpoint de envio)
+type Kind string
+
+const (
+	KindText     Kind = "text"
+	KindMedia    Kind = "media"
+	KindLocation Kind = "location"
+	KindContact  Kind = "contact"
+	KindSticker  Kind = "sticker"
+	KindButtons  Kind = "buttons"
+	KindList     Kind = "list"
+	KindPoll     Kind = "poll"
+)
+
+// IsValid verifica se o tipo de envio é conhecido
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindText, KindMedia, KindLocation, KindContact, KindSticker, KindButtons, KindList, KindPoll:
+		return true
+	}
+	return false
+}
+
+// JobStatus representa o estado de um envio na fila
+type JobStatus string
+
+const (
+	// JobStatusQueued indica que o envio aguarda sua vez na fila da sessão (primeira tentativa ou retry)
+	JobStatusQueued JobStatus = "queued"
+	// JobStatusSending indica que o envio está em andamento
+	JobStatusSending JobStatus = "sending"
+	// JobStatusSent indica que a mensagem foi enviada ao WhatsApp
+	JobStatusSent JobStatus = "sent"
+	// JobStatusFailed indica que o envio falhou definitivamente
+	JobStatusFailed JobStatus = "failed"
+	// JobStatusCanceled indica que o envio foi cancelado antes de sair da fila
+	JobStatusCanceled JobStatus = "canceled"
+)
+
+// IsValid verifica se o status do job é conhecido
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case JobStatusQueued, JobStatusSending, JobStatusSent, JobStatusFailed, JobStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// Job representa uma mensagem na fila de envio assíncrono de uma sessão
+type Job struct {
+	bun.BaseModel `bun:"table:zapcore_outbound_messages,alias:o"`
+
+	ID            uuid.UUID       `bun:"id,pk,type:uuid" json:"id"`
+	Seq           int64           `bun:"seq,autoincrement" json:"-"`
+	SessionID     uuid.UUID       `bun:"sessionId,type:uuid,notnull" json:"sessionId"`
+	Kind          Kind            `bun:"kind,type:varchar(20),notnull" json:"kind"`
+	Payload       json.RawMessage `bun:"payload,type:jsonb,notnull" json:"payload"`
+	Status        JobStatus       `bun:"status,type:varchar(20),notnull" json:"status"`
+	Attempts      int             `bun:"attempts,type:integer,notnull,default:0" json:"attempts"`
+	MaxAttempts   int             `bun:"maxAttempts,type:integer,notnull" json:"maxAttempts"`
+	NextAttemptAt time.Time       `bun:"nextAttemptAt,type:timestamptz,notnull" json:"nextAttemptAt"`
+	MessageID     string          `bun:"messageId,type:varchar(128)" json:"messageId,omitempty"`
+	LastError     string          `bun:"lastError,type:text" json:"lastError,omitempty"`
+	SentAt        *time.Time      `bun:"sentAt,type:timestamptz" json:"sentAt,omitempty"`
+	CreatedAt     time.Time       `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt     time.Time       `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Job) TableName() string {
+	return "zapcore_outbound_messages"
+}
+
+// MarkSending registra o início de uma tentativa de envio
+func (j *Job) MarkSending() {
+	j.Status = JobStatusSending
+	j.Attempts++
+	j.UpdatedAt = time.Now()
+}
+
+// MarkSent marca o job como enviado com o ID da mensagem no WhatsApp
+func (j *Job) MarkSent(messageID string) {
+	now := time.Now()
+	j.Status = JobStatusSent
+	j.MessageID = messageID
+	j.LastError = ""
+	j.SentAt = &now
+	j.UpdatedAt = now
+}
+
+// MarkFailed marca o job como falho definitivamente
+func (j *Job) MarkFailed(reason string) {
+	j.Status = JobStatusFailed
+	j.LastError = reason
+	j.UpdatedAt = time.Now()
+}
+
+// ScheduleRetry recoloca o job na fila após o atraso informado, ou o marca como falho se as tentativas acabaram
+func (j *Job) ScheduleRetry(reason string, backoff time.Duration) {
+	if j.Attempts >= j.MaxAttempts {
+		j.MarkFailed(reason)
+		return
+	}
+	j.Status = JobStatusQueued
+	j.LastError = reason
+	j.NextAttemptAt = time.Now().Add(backoff)
+	j.UpdatedAt = time.Now()
+}
+
+// IsFinal verifica se o job não será mais processado
+func (j *Job) IsFinal() bool {
+	return j.Status == JobStatusSent || j.Status == JobStatusFailed || j.Status == JobStatusCanceled
+}
+
+// JobFilter define os filtros para listagem dos jobs da fila
+type JobFilter struct {
+	SessionID uuid.UUID
+	Status    JobStatus
+	Kind      Kind
+	Limit     int
+	Offset    int
+}
@@ -0,0 +1,21 @@
+package outbound
+
+import "errors"
+
+// Erros de domínio específicos da fila de envio
+var (
+	// ErrJobNotFound indica que o job não foi encontrado na fila da sessão
+	ErrJobNotFound = errors.New("outbound job not found")
+
+	// ErrJobNotCancelable indica que o job já saiu da fila e não pode ser cancelado
+	ErrJobNotCancelable = errors.New("outbound job is no longer queued")
+
+	// ErrInvalidKind indica que o tipo de envio é desconhecido
+	ErrInvalidKind = errors.New("invalid outbound message kind")
+
+	// ErrInvalidPayload indica que o payload do job não corresponde à requisição do tipo de envio
+	ErrInvalidPayload = errors.New("invalid outbound message payload")
+
+	// ErrInvalidStatus indica que o status do filtro é desconhecido
+	ErrInvalidStatus = errors.New("invalid outbound job status")
+)
@@ -0,0 +1,36 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobRepository define as operações de persistência da fila de envio
+type JobRepository interface {
+	// Enqueue insere um novo job na fila
+	Enqueue(ctx context.Context, job *Job) error
+
+	// GetByID busca um job de uma sessão pelo ID
+	GetByID(ctx context.Context, sessionID, id uuid.UUID) (*Job, error)
+
+	// List retorna os jobs que atendem ao filtro, do mais recente ao mais antigo, e o total encontrado
+	List(ctx context.Context, filter JobFilter) ([]*Job, int, error)
+
+	// ListDueSessions retorna as sessões com jobs na fila cujo horário de envio já chegou
+	ListDueSessions(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+
+	// NextDue retorna o job mais antigo da fila da sessão cujo horário de envio já chegou, ou nil
+	NextDue(ctx context.Context, sessionID uuid.UUID, now time.Time) (*Job, error)
+
+	// Update persiste o resultado de uma tentativa de envio
+	Update(ctx context.Context, job *Job) error
+
+	// Cancel cancela um job que ainda está na fila; retorna ErrJobNotCancelable se já saiu dela
+	Cancel(ctx context.Context, sessionID, id uuid.UUID) (*Job, error)
+
+	// FailInterrupted marca como falhos os jobs que estavam em envio quando o processo foi encerrado,
+	// já que não é possível saber se a mensagem chegou ao WhatsApp, e retorna quantos foram afetados
+	FailInterrupted(ctx context.Context, reason string) (int, error)
+}
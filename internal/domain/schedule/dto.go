@@ -0,0 +1,45 @@
+package schedule
+
+import (
+	"encoding/json"
+
+	"zmeow/internal/domain/outbound"
+)
+
+// ScheduleMessageRequest representa o agendamento de um envio
+type ScheduleMessageRequest struct {
+	// Kind é o tipo de envio: text, media, location, contact, sticker, buttons, list ou poll
+	Kind outbound.Kind `json:"kind" example:"text"`
+	// Payload é a mesma requisição aceita pelo endpoint de envio do tipo
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+	// SendAt aceita RFC3339 com offset ou data e hora locais (2006-01-02T15:04:05) interpretadas no timezone
+	SendAt string `json:"sendAt" example:"2025-08-01T09:00:00"`
+	// Timezone é o nome IANA do fuso horário (padrão UTC)
+	Timezone string `json:"timezone,omitempty" example:"America/Sao_Paulo"`
+	// IfDisconnected define o que fazer se a sessão estiver desconectada no horário: retry (padrão) ou skip
+	IfDisconnected DisconnectedPolicy `json:"ifDisconnected,omitempty" example:"retry"`
+}
+
+// UpdateScheduleRequest representa a alteração de um agendamento; campos omitidos são mantidos
+type UpdateScheduleRequest struct {
+	Kind           outbound.Kind      `json:"kind,omitempty" example:"text"`
+	Payload        json.RawMessage    `json:"payload,omitempty" swaggertype:"object"`
+	SendAt         string             `json:"sendAt,omitempty" example:"2025-08-01T10:30:00"`
+	Timezone       string             `json:"timezone,omitempty" example:"America/Sao_Paulo"`
+	IfDisconnected DisconnectedPolicy `json:"ifDisconnected,omitempty" example:"skip"`
+}
+
+// ListScheduleRequest representa os filtros da listagem de agendamentos
+type ListScheduleRequest struct {
+	Status string `json:"status,omitempty" example:"scheduled"`
+	Limit  int    `json:"limit,omitempty" example:"50"`
+	Offset int    `json:"offset,omitempty" example:"0"`
+}
+
+// ScheduleListResponse representa uma página de agendamentos
+type ScheduleListResponse struct {
+	Messages []*ScheduledMessage `json:"messages"`
+	Total    int                 `json:"total"`
+	Limit    int                 `json:"limit"`
+	Offset   int                 `json:"offset"`
+}
@@ -0,0 +1,134 @@
+package schedule
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/outbound"
+)
+
+// Status representa o estado de uma mensagem agendada
+type Status string
+
+const (
+	// StatusScheduled indica que a mensagem aguarda o horário de envio (ou a reconexão da sessão)
+	StatusScheduled Status = "scheduled"
+	// StatusSending indica que o envio está em andamento
+	StatusSending Status = "sending"
+	// StatusSent indica que a mensagem foi enviada ao WhatsApp
+	StatusSent Status = "sent"
+	// StatusFailed indica que o envio falhou definitivamente
+	StatusFailed Status = "failed"
+	// StatusSkipped indica que o envio foi descartado porque a sessão estava desconectada no horário
+	StatusSkipped Status = "skipped"
+	// StatusCanceled indica que o agendamento foi cancelado antes do envio
+	StatusCanceled Status = "canceled"
+)
+
+// IsValid verifica se o status é conhecido
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusScheduled, StatusSending, StatusSent, StatusFailed, StatusSkipped, StatusCanceled:
+		return true
+	}
+	return false
+}
+
+// DisconnectedPolicy define o que fazer quando a sessão está desconectada no horário do envio
+type DisconnectedPolicy string
+
+const (
+	// DisconnectedRetry aguarda a reconexão da sessão até o atraso máximo configurado
+	DisconnectedRetry DisconnectedPolicy = "retry"
+	// DisconnectedSkip descarta o envio
+	DisconnectedSkip DisconnectedPolicy = "skip"
+)
+
+// IsValid verifica se a política é conhecida
+func (p DisconnectedPolicy) IsValid() bool {
+	return p == DisconnectedRetry || p == DisconnectedSkip
+}
+
+// ScheduledMessage representa um envio agendado; o payload é a requisição do endpoint de envio do tipo informado
+type ScheduledMessage struct {
+	bun.BaseModel `bun:"table:zapcore_scheduled_messages,alias:sm"`
+
+	ID             uuid.UUID          `bun:"id,pk,type:uuid" json:"id"`
+	SessionID      uuid.UUID          `bun:"sessionId,type:uuid,notnull" json:"sessionId"`
+	Kind           outbound.Kind      `bun:"kind,type:varchar(20),notnull" json:"kind"`
+	Payload        json.RawMessage    `bun:"payload,type:jsonb,notnull" json:"payload"`
+	SendAt         time.Time          `bun:"sendAt,type:timestamptz,notnull" json:"sendAt"`
+	Timezone       string             `bun:"timezone,type:varchar(64),notnull" json:"timezone"`
+	IfDisconnected DisconnectedPolicy `bun:"ifDisconnected,type:varchar(10),notnull" json:"ifDisconnected"`
+	Status         Status             `bun:"status,type:varchar(20),notnull" json:"status"`
+	Attempts       int                `bun:"attempts,type:integer,notnull,default:0" json:"attempts"`
+	MaxAttempts    int                `bun:"maxAttempts,type:integer,notnull" json:"maxAttempts"`
+	NextAttemptAt  time.Time          `bun:"nextAttemptAt,type:timestamptz,notnull" json:"nextAttemptAt"`
+	MessageID      string             `bun:"messageId,type:varchar(128)" json:"messageId,omitempty"`
+	LastError      string             `bun:"lastError,type:text" json:"lastError,omitempty"`
+	SentAt         *time.Time         `bun:"sentAt,type:timestamptz" json:"sentAt,omitempty"`
+	CreatedAt      time.Time          `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt      time.Time          `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*ScheduledMessage) TableName() string {
+	return "zapcore_scheduled_messages"
+}
+
+// MarkSent marca o agendamento como enviado com o ID da mensagem no WhatsApp
+func (m *ScheduledMessage) MarkSent(messageID string) {
+	now := time.Now()
+	m.Status = StatusSent
+	m.MessageID = messageID
+	m.LastError = ""
+	m.SentAt = &now
+	m.UpdatedAt = now
+}
+
+// MarkFailed marca o agendamento como falho definitivamente
+func (m *ScheduledMessage) MarkFailed(reason string) {
+	m.Status = StatusFailed
+	m.LastError = reason
+	m.UpdatedAt = time.Now()
+}
+
+// MarkSkipped descarta o envio porque a sessão estava desconectada
+func (m *ScheduledMessage) MarkSkipped(reason string) {
+	m.Status = StatusSkipped
+	m.LastError = reason
+	m.UpdatedAt = time.Now()
+}
+
+// Postpone devolve o agendamento à espera para nova verificação, sem consumir tentativas
+func (m *ScheduledMessage) Postpone(reason string, delay time.Duration) {
+	m.Status = StatusScheduled
+	m.LastError = reason
+	m.NextAttemptAt = time.Now().Add(delay)
+	m.UpdatedAt = time.Now()
+}
+
+// ScheduleRetry registra uma tentativa falha e agenda a próxima, ou marca como falho se as tentativas acabaram
+func (m *ScheduledMessage) ScheduleRetry(reason string, backoff time.Duration) {
+	if m.Attempts >= m.MaxAttempts {
+		m.MarkFailed(reason)
+		return
+	}
+	m.Postpone(reason, backoff)
+}
+
+// IsEditable verifica se o agendamento ainda pode ser alterado ou cancelado
+func (m *ScheduledMessage) IsEditable() bool {
+	return m.Status == StatusScheduled
+}
+
+// Filter define os filtros para listagem dos agendamentos
+type Filter struct {
+	SessionID uuid.UUID
+	Status    Status
+	Limit     int
+	Offset    int
+}
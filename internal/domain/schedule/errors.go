@@ -0,0 +1,24 @@
+package schedule
+
+import "errors"
+
+// Erros de domínio específicos dos agendamentos
+var (
+	// ErrScheduleNotFound indica que o agendamento não foi encontrado na sessão
+	ErrScheduleNotFound = errors.New("scheduled message not found")
+
+	// ErrScheduleNotEditable indica que o agendamento já saiu da espera e não pode ser alterado ou cancelado
+	ErrScheduleNotEditable = errors.New("scheduled message is no longer pending")
+
+	// ErrInvalidSendAt indica que o horário de envio é inválido
+	ErrInvalidSendAt = errors.New("invalid sendAt")
+
+	// ErrInvalidTimezone indica que o fuso horário não é um nome IANA válido
+	ErrInvalidTimezone = errors.New("invalid timezone")
+
+	// ErrInvalidPolicy indica que a política para sessão desconectada é desconhecida
+	ErrInvalidPolicy = errors.New("invalid ifDisconnected policy")
+
+	// ErrInvalidStatus indica que o status do filtro é desconhecido
+	ErrInvalidStatus = errors.New("invalid scheduled message status")
+)
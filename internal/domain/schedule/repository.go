@@ -0,0 +1,37 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository define as operações de persistência dos agendamentos
+type Repository interface {
+	// Create insere um novo agendamento
+	Create(ctx context.Context, msg *ScheduledMessage) error
+
+	// GetByID busca um agendamento de uma sessão pelo ID
+	GetByID(ctx context.Context, sessionID, id uuid.UUID) (*ScheduledMessage, error)
+
+	// List retorna os agendamentos que atendem ao filtro, pelo horário de envio, e o total encontrado
+	List(ctx context.Context, filter Filter) ([]*ScheduledMessage, int, error)
+
+	// UpdatePending altera tipo, payload e horário de um agendamento que ainda aguarda o envio;
+	// retorna ErrScheduleNotEditable se ele já saiu da espera
+	UpdatePending(ctx context.Context, msg *ScheduledMessage) error
+
+	// ClaimDue marca como em envio e retorna até limit agendamentos vencidos, sem disputar com outras instâncias
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*ScheduledMessage, error)
+
+	// Update persiste o resultado de uma tentativa de envio
+	Update(ctx context.Context, msg *ScheduledMessage) error
+
+	// Cancel cancela um agendamento que ainda aguarda o envio; retorna ErrScheduleNotEditable se já saiu da espera
+	Cancel(ctx context.Context, sessionID, id uuid.UUID) (*ScheduledMessage, error)
+
+	// FailInterrupted marca como falhos os agendamentos que estavam em envio quando o processo foi encerrado
+	// e retorna quantos foram afetados
+	FailInterrupted(ctx context.Context, reason string) (int, error)
+}
@@ -16,6 +16,34 @@ const (
 	WhatsAppStatusConnected    WhatsAppSessionStatus = "connected"
 )
 
+// CallPolicy define o que a sessão faz com as chamadas recebidas
+type CallPolicy string
+
+const (
+	// CallPolicyIgnore apenas notifica a chamada, sem atendê-la nem rejeitá-la
+	CallPolicyIgnore CallPolicy = "ignore"
+	// CallPolicyReject rejeita a chamada automaticamente
+	CallPolicyReject CallPolicy = "reject"
+	// CallPolicyRejectAndReply rejeita a chamada e envia uma mensagem de texto a quem ligou
+	CallPolicyRejectAndReply CallPolicy = "reject_reply"
+)
+
+// IsValid verifica se a política de chamadas é conhecida
+func (p CallPolicy) IsValid() bool {
+	switch p {
+	case CallPolicyIgnore, CallPolicyReject, CallPolicyRejectAndReply:
+		return true
+	}
+	return false
+}
+
+const (
+	// DefaultHistorySyncDays é a janela padrão do histórico importado no pareamento
+	DefaultHistorySyncDays = 30
+	// MaxHistorySyncDays é a maior janela de histórico aceita
+	MaxHistorySyncDays = 3650
+)
+
 // Session representa uma sessão do WhatsApp
 type Session struct {
 	bun.BaseModel `bun:"table:zapcore_sessions,alias:s"`
@@ -32,6 +60,19 @@ type Session struct {
 	CreatedAt time.Time             `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
 	UpdatedAt time.Time             `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
 	Metadata  map[string]any        `bun:"-" json:"metadata,omitempty"` // Não persistir no banco por enquanto
+
+	// WebhookSecret é o segredo gerado para o webhook informado na criação, exibido apenas nessa resposta
+	WebhookSecret string `bun:"-" json:"webhookSecret,omitempty"`
+
+	// AutoDownloadMedia habilita o download automático das mídias recebidas para o armazenamento
+	AutoDownloadMedia bool `bun:"autoDownloadMedia,type:boolean,notnull,default:false" json:"autoDownloadMedia"`
+
+	// CallPolicy define o tratamento das chamadas recebidas; CallReplyMessage é o texto enviado em reject_reply
+	CallPolicy       CallPolicy `bun:"callPolicy,type:varchar(20),notnull,default:'ignore'" json:"callPolicy"`
+	CallReplyMessage string     `bun:"callReplyMessage,type:text" json:"callReplyMessage,omitempty"`
+
+	// HistorySyncDays limita, em dias, as mensagens importadas da sincronização de histórico (0 = não importar)
+	HistorySyncDays int `bun:"historySyncDays,type:integer,notnull,default:30" json:"historySyncDays"`
 }
 
 // TableName retorna o nome da tabela para o Bun ORM
@@ -86,6 +127,34 @@ func (s *Session) SetDisconnected() {
 	s.UpdatedAt = time.Now()
 }
 
+// SetAutoDownloadMedia habilita ou desabilita o download automático de mídias
+func (s *Session) SetAutoDownloadMedia(enabled bool) {
+	s.AutoDownloadMedia = enabled
+	s.UpdatedAt = time.Now()
+}
+
+// SetCallPolicy define o tratamento das chamadas recebidas
+func (s *Session) SetCallPolicy(policy CallPolicy, replyMessage string) {
+	s.CallPolicy = policy
+	s.CallReplyMessage = replyMessage
+	s.UpdatedAt = time.Now()
+}
+
+// SetHistorySyncDays define quantos dias de histórico são importados na sincronização
+func (s *Session) SetHistorySyncDays(days int) {
+	s.HistorySyncDays = days
+	s.UpdatedAt = time.Now()
+}
+
+// HistoryCutoff retorna o horário da mensagem mais antiga importada da sincronização de histórico.
+// Retorna false quando a importação está desabilitada.
+func (s *Session) HistoryCutoff(now time.Time) (time.Time, bool) {
+	if s.HistorySyncDays <= 0 {
+		return time.Time{}, false
+	}
+	return now.AddDate(0, 0, -s.HistorySyncDays), true
+}
+
 // Deactivate desativa a sessão
 func (s *Session) Deactivate() {
 	s.IsActive = false
@@ -41,6 +41,15 @@ var (
 
 	// ErrPairingCodeNotAvailable indica que o código de pareamento não está disponível
 	ErrPairingCodeNotAvailable = errors.New("pairing code not available")
+
+	// ErrInvalidCallPolicy indica que a política de chamadas é desconhecida
+	ErrInvalidCallPolicy = errors.New("invalid call policy")
+
+	// ErrCallReplyRequired indica que a política reject_reply exige a mensagem de resposta
+	ErrCallReplyRequired = errors.New("call reply message is required for reject_reply policy")
+
+	// ErrInvalidHistorySyncDays indica que a janela de histórico está fora do intervalo aceito
+	ErrInvalidHistorySyncDays = errors.New("history sync days must be between 0 and 3650")
 )
 
 // SessionError representa um erro específico de sessão com contexto adicional
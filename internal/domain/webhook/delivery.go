@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// DeliveryStatus representa o estado de uma entrega de webhook no outbox
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending indica que a entrega aguarda envio (primeira tentativa ou retry)
+	DeliveryStatusPending DeliveryStatus = "pending"
+	// DeliveryStatusDelivered indica que o receptor respondeu com sucesso (2xx)
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	// DeliveryStatusDead indica que a entrega esgotou as tentativas (dead-letter)
+	DeliveryStatusDead DeliveryStatus = "dead"
+)
+
+// IsValid verifica se o status de entrega é conhecido
+func (s DeliveryStatus) IsValid() bool {
+	switch s {
+	case DeliveryStatusPending, DeliveryStatusDelivered, DeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+// maxResponseBodyLength limita o corpo de resposta armazenado por tentativa
+const maxResponseBodyLength = 2048
+
+// DeliveryAttempt registra o resultado de uma tentativa de entrega
+type DeliveryAttempt struct {
+	Attempt    int       `json:"attempt"`
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"durationMs"`
+}
+
+// Delivery representa uma entrega de webhook persistida no outbox
+type Delivery struct {
+	bun.BaseModel `bun:"table:zapcore_webhook_deliveries,alias:d"`
+
+	ID             uuid.UUID         `bun:"id,pk,type:uuid" json:"id"`
+	Seq            int64             `bun:"seq,autoincrement" json:"-"`
+	SessionID      uuid.UUID         `bun:"sessionId,type:uuid,notnull" json:"sessionId"`
+	WebhookID      uuid.UUID         `bun:"webhookId,type:uuid,nullzero" json:"webhookId,omitempty"`
+	Event          string            `bun:"event,type:varchar(100),notnull" json:"event"`
+	Payload        json.RawMessage   `bun:"payload,type:jsonb,notnull" json:"payload"`
+	Status         DeliveryStatus    `bun:"status,type:varchar(20),notnull" json:"status"`
+	Attempts       int               `bun:"attempts,type:integer,notnull,default:0" json:"attempts"`
+	MaxAttempts    int               `bun:"maxAttempts,type:integer,notnull" json:"maxAttempts"`
+	NextAttemptAt  time.Time         `bun:"nextAttemptAt,type:timestamptz,notnull" json:"nextAttemptAt"`
+	URL            string            `bun:"url,type:varchar(2048)" json:"url,omitempty"`
+	LastStatusCode int               `bun:"lastStatusCode,type:integer" json:"lastStatusCode,omitempty"`
+	LastError      string            `bun:"lastError,type:text" json:"lastError,omitempty"`
+	LastResponse   string            `bun:"lastResponse,type:text" json:"lastResponse,omitempty"`
+	AttemptLog     []DeliveryAttempt `bun:"attemptLog,type:jsonb" json:"attemptLog,omitempty"`
+	DeliveredAt    *time.Time        `bun:"deliveredAt,type:timestamptz" json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time         `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt      time.Time         `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Delivery) TableName() string {
+	return "zapcore_webhook_deliveries"
+}
+
+// RecordAttempt registra o resultado de uma tentativa de entrega
+func (d *Delivery) RecordAttempt(url string, statusCode int, responseBody string, err error, duration time.Duration) {
+	now := time.Now()
+	d.Attempts++
+	d.URL = url
+	d.LastStatusCode = statusCode
+	d.LastResponse = truncate(responseBody, maxResponseBodyLength)
+	d.LastError = ""
+	if err != nil {
+		d.LastError = err.Error()
+	}
+
+	d.AttemptLog = append(d.AttemptLog, DeliveryAttempt{
+		Attempt:    d.Attempts,
+		At:         now,
+		StatusCode: statusCode,
+		Error:      d.LastError,
+		DurationMs: duration.Milliseconds(),
+	})
+	d.UpdatedAt = now
+}
+
+// MarkDelivered marca a entrega como concluída
+func (d *Delivery) MarkDelivered() {
+	now := time.Now()
+	d.Status = DeliveryStatusDelivered
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkDead move a entrega para o dead-letter
+func (d *Delivery) MarkDead(reason string) {
+	d.Status = DeliveryStatusDead
+	if reason != "" {
+		d.LastError = reason
+	}
+	d.UpdatedAt = time.Now()
+}
+
+// ScheduleRetry agenda a próxima tentativa ou move para o dead-letter se as tentativas acabaram
+func (d *Delivery) ScheduleRetry(backoff time.Duration) {
+	if d.Attempts >= d.MaxAttempts {
+		d.MarkDead("")
+		return
+	}
+	d.Status = DeliveryStatusPending
+	d.NextAttemptAt = time.Now().Add(backoff)
+	d.UpdatedAt = time.Now()
+}
+
+// CanReplay verifica se a entrega pode ser reenviada manualmente
+func (d *Delivery) CanReplay() bool {
+	return d.Status == DeliveryStatusDead || d.Status == DeliveryStatusDelivered
+}
+
+// DeliveryFilter define os filtros para listagem de entregas
+type DeliveryFilter struct {
+	SessionID uuid.UUID
+	WebhookID uuid.UUID
+	Status    DeliveryStatus
+	Event     string
+	Limit     int
+	Offset    int
+}
+
+// truncate limita uma string ao tamanho máximo informado
+func truncate(value string, max int) string {
+	if len(value) <= max {
+		return value
+	}
+	return value[:max]
+}
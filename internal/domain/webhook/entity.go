@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/whatsapp"
+)
+
+const (
+	// DefaultRetries é o número padrão de tentativas de entrega
+	DefaultRetries = 3
+	// DefaultTimeoutSeconds é o timeout padrão de cada entrega, em segundos
+	DefaultTimeoutSeconds = 30
+	// DefaultSecretOverlap é o tempo padrão em que o segredo anterior continua válido após uma rotação
+	DefaultSecretOverlap = 24 * time.Hour
+	// MaxWebhooksPerSession é o número máximo de webhooks de uma sessão
+	MaxWebhooksPerSession = 10
+)
+
+// Webhook representa um endpoint de webhook persistido de uma sessão.
+// Uma sessão pode ter vários; o mais antigo é o webhook principal, usado pelas rotas /webhook.
+type Webhook struct {
+	bun.BaseModel `bun:"table:zapcore_webhooks,alias:w"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid" json:"id"`
+	SessionID uuid.UUID `bun:"sessionId,type:uuid,notnull" json:"sessionId"`
+	URL       string    `bun:"url,type:varchar(2048),notnull" json:"url"`
+	Secret    string    `bun:"secret,type:varchar(255)" json:"-"`
+	Enabled   bool      `bun:"enabled,type:boolean" json:"enabled"`
+	Retries   int       `bun:"retries,type:integer" json:"retries"`
+	Timeout   int       `bun:"timeout,type:integer" json:"timeout"` // em segundos
+	CreatedAt time.Time `bun:"createdAt,type:timestamptz,notnull" json:"createdAt"`
+	UpdatedAt time.Time `bun:"updatedAt,type:timestamptz,notnull" json:"updatedAt"`
+
+	// Segredo anterior, ainda usado para assinar entregas até PreviousSecretExpiresAt
+	PreviousSecret          string     `bun:"previousSecret,type:varchar(255)" json:"-"`
+	PreviousSecretExpiresAt *time.Time `bun:"previousSecretExpiresAt,type:timestamptz" json:"previousSecretExpiresAt,omitempty"`
+
+	// Padrões de evento assinados ("message", "message.*", "*"); vazio entrega todos os eventos
+	Events []string `bun:"events,type:jsonb" json:"events"`
+
+	// Headers estáticos enviados em cada entrega (ex.: roteamento no gateway)
+	Headers map[string]string `bun:"headers,type:jsonb" json:"headers,omitempty"`
+}
+
+// TableName retorna o nome da tabela para o Bun ORM
+func (*Webhook) TableName() string {
+	return "zapcore_webhooks"
+}
+
+// HasSecret verifica se o webhook possui segredo para assinatura
+func (w *Webhook) HasSecret() bool {
+	return w.Secret != ""
+}
+
+// RotateSecret define um novo segredo mantendo o atual válido durante overlap
+func (w *Webhook) RotateSecret(newSecret string, overlap time.Duration) {
+	if w.Secret != "" && overlap > 0 {
+		expiresAt := time.Now().Add(overlap)
+		w.PreviousSecret = w.Secret
+		w.PreviousSecretExpiresAt = &expiresAt
+	} else {
+		w.PreviousSecret = ""
+		w.PreviousSecretExpiresAt = nil
+	}
+	w.Secret = newSecret
+}
+
+// ApplyDefaults preenche retries e timeout com os valores padrão quando ausentes
+func (w *Webhook) ApplyDefaults() {
+	if w.Retries <= 0 {
+		w.Retries = DefaultRetries
+	}
+	if w.Timeout <= 0 {
+		w.Timeout = DefaultTimeoutSeconds
+	}
+}
+
+// ToConfig converte o webhook persistido na configuração usada pelo WebhookService
+func (w *Webhook) ToConfig() *whatsapp.WebhookConfig {
+	return &whatsapp.WebhookConfig{
+		ID:        w.ID,
+		SessionID: w.SessionID,
+		URL:       w.URL,
+		Secret:    w.Secret,
+		Enabled:   w.Enabled,
+		Retries:   w.Retries,
+		Timeout:   w.Timeout,
+		Events:    w.Events,
+		Headers:   w.Headers,
+
+		PreviousSecret:          w.PreviousSecret,
+		PreviousSecretExpiresAt: w.PreviousSecretExpiresAt,
+	}
+}
@@ -0,0 +1,36 @@
+package webhook
+
+import "errors"
+
+// Erros de domínio específicos para webhooks
+var (
+	// ErrWebhookNotFound indica que a sessão não possui webhook configurado
+	ErrWebhookNotFound = errors.New("webhook not found")
+
+	// ErrWebhookDisabled indica que o webhook da sessão está desabilitado
+	ErrWebhookDisabled = errors.New("webhook is disabled")
+
+	// ErrInvalidWebhookURL indica que a URL do webhook é inválida
+	ErrInvalidWebhookURL = errors.New("invalid webhook URL")
+
+	// ErrInvalidWebhookEvent indica que um dos padrões de evento assinados não corresponde a nenhum evento
+	ErrInvalidWebhookEvent = errors.New("invalid webhook event type")
+
+	// ErrInvalidWebhookHeader indica que um dos headers estáticos do webhook é inválido ou reservado
+	ErrInvalidWebhookHeader = errors.New("invalid webhook header")
+
+	// ErrWebhookLimitReached indica que a sessão atingiu o número máximo de webhooks
+	ErrWebhookLimitReached = errors.New("webhook limit reached for session")
+
+	// ErrDeliveryNotFound indica que a entrega de webhook não foi encontrada
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// ErrDeliveryNotReplayable indica que a entrega ainda está pendente e não pode ser reenviada
+	ErrDeliveryNotReplayable = errors.New("webhook delivery is still pending")
+
+	// ErrInvalidDeliveryStatus indica que o status de entrega informado é inválido
+	ErrInvalidDeliveryStatus = errors.New("invalid webhook delivery status")
+
+	// ErrInvalidSecretOverlap indica que a janela de rotação do segredo é inválida
+	ErrInvalidSecretOverlap = errors.New("invalid secret overlap")
+)
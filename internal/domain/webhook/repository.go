@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookRepository define as operações de persistência para webhooks
+type WebhookRepository interface {
+	// Save cria ou atualiza um webhook (identificado pelo ID)
+	Save(ctx context.Context, webhook *Webhook) error
+
+	// GetByID busca um webhook de uma sessão pelo ID
+	GetByID(ctx context.Context, sessionID, id uuid.UUID) (*Webhook, error)
+
+	// GetPrimary busca o webhook principal (o mais antigo) de uma sessão
+	GetPrimary(ctx context.Context, sessionID uuid.UUID) (*Webhook, error)
+
+	// ListBySession retorna os webhooks de uma sessão, do mais antigo ao mais recente
+	ListBySession(ctx context.Context, sessionID uuid.UUID) ([]*Webhook, error)
+
+	// List retorna todos os webhooks configurados
+	List(ctx context.Context) ([]*Webhook, error)
+
+	// Delete remove um webhook de uma sessão
+	Delete(ctx context.Context, sessionID, id uuid.UUID) error
+
+	// SetEnabled habilita ou desabilita um webhook de uma sessão
+	SetEnabled(ctx context.Context, sessionID, id uuid.UUID, enabled bool) error
+}
+
+// DeliveryRepository define as operações de persistência do outbox de entregas de webhook
+type DeliveryRepository interface {
+	// Enqueue insere uma nova entrega pendente
+	Enqueue(ctx context.Context, delivery *Delivery) error
+
+	// GetByID busca uma entrega de uma sessão pelo ID
+	GetByID(ctx context.Context, sessionID, id uuid.UUID) (*Delivery, error)
+
+	// List retorna as entregas que atendem ao filtro e o total encontrado
+	List(ctx context.Context, filter DeliveryFilter) ([]*Delivery, int, error)
+
+	// ListDueHeads retorna, para cada webhook, a entrega pendente mais antiga cujo horário de envio já chegou.
+	// Entregas posteriores do mesmo webhook aguardam a primeira ser concluída, garantindo a ordem.
+	// Não há trava de linhas entre processos: o outbox suporta uma única instância consumidora.
+	ListDueHeads(ctx context.Context, now time.Time, limit int) ([]*Delivery, error)
+
+	// Update persiste o resultado de uma tentativa de entrega
+	Update(ctx context.Context, delivery *Delivery) error
+
+	// Requeue recoloca entregas na fila com tentativas zeradas e retorna quantas foram afetadas.
+	// Sem IDs, recoloca todas as entregas da sessão com o status informado.
+	Requeue(ctx context.Context, sessionID uuid.UUID, ids []uuid.UUID, status DeliveryStatus) (int, error)
+}
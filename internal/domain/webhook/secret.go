@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+)
+
+// SecretPrefix é o prefixo dos segredos gerados pelo zmeow
+const SecretPrefix = "whsec_"
+
+// GenerateSecret gera um novo segredo aleatório para assinatura de webhooks
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return SecretPrefix + hex.EncodeToString(buf), nil
+}
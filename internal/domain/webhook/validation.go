@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"zmeow/internal/domain/whatsapp"
+)
+
+const (
+	// MaxURLLength é o tamanho máximo aceito para a URL do webhook
+	MaxURLLength = 2048
+	// MaxHeaders é o número máximo de headers estáticos por webhook
+	MaxHeaders = 20
+	// MaxHeaderValueLength é o tamanho máximo do valor de um header estático
+	MaxHeaderValueLength = 1024
+)
+
+// headerNamePattern aceita os caracteres válidos em nomes de header HTTP (token da RFC 7230)
+var headerNamePattern = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+
+// reservedHeaders são definidos pelo próprio envio e não podem ser sobrescritos
+var reservedHeaders = map[string]bool{
+	"Content-Type":      true,
+	"Content-Length":    true,
+	"Host":              true,
+	"User-Agent":        true,
+	"Transfer-Encoding": true,
+	"Connection":        true,
+}
+
+// ValidateURL verifica se a URL do webhook é absoluta e usa http ou https
+func ValidateURL(rawURL string) error {
+	if rawURL == "" || len(rawURL) > MaxURLLength {
+		return ErrInvalidWebhookURL
+	}
+
+	parsed, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhookURL, err)
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+
+	return nil
+}
+
+// ValidateEvents verifica se cada padrão de evento assinado ("message", "message.*", "*")
+// corresponde a algum evento de sessão e retorna a lista sem duplicatas
+func ValidateEvents(events []string) ([]string, error) {
+	seen := make(map[string]bool, len(events))
+	result := make([]string, 0, len(events))
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		if !whatsapp.IsValidEventPattern(event) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidWebhookEvent, event)
+		}
+		if !seen[event] {
+			seen[event] = true
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+// ValidateHeaders verifica os headers estáticos do webhook e retorna os nomes na forma canônica.
+// Headers de assinatura (X-Webhook-*) e os definidos pelo envio são reservados.
+func ValidateHeaders(headers map[string]string) (map[string]string, error) {
+	if len(headers) > MaxHeaders {
+		return nil, fmt.Errorf("%w: at most %d headers are allowed", ErrInvalidWebhookHeader, MaxHeaders)
+	}
+
+	result := make(map[string]string, len(headers))
+	for name, value := range headers {
+		if !headerNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("%w: invalid name %q", ErrInvalidWebhookHeader, name)
+		}
+		canonical := http.CanonicalHeaderKey(name)
+		if reservedHeaders[canonical] || strings.HasPrefix(canonical, "X-Webhook-") {
+			return nil, fmt.Errorf("%w: %s is reserved", ErrInvalidWebhookHeader, canonical)
+		}
+		if len(value) > MaxHeaderValueLength || strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("%w: invalid value for %s", ErrInvalidWebhookHeader, canonical)
+		}
+		result[canonical] = value
+	}
+	return result, nil
+}
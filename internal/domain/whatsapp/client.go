@@ -2,6 +2,7 @@ package whatsapp
 
 import (
 	"context"
+	"time"
 	"zmeow/internal/domain/message"
 
 	"github.com/google/uuid"
@@ -39,32 +40,34 @@ type WhatsAppClient interface {
 	// DeleteSession remove uma sessão WhatsApp
 	DeleteSession(sessionID uuid.UUID) error
 
+	// Os métodos Send* anexam contextInfo (resposta, menções, encaminhamento e expiração) quando informado
+
 	// SendTextMessage envia uma mensagem de texto
-	SendTextMessage(ctx context.Context, sessionID uuid.UUID, phone, message string) (string, error)
+	SendTextMessage(ctx context.Context, sessionID uuid.UUID, phone, message string, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendMediaMessage envia mídia (imagem, áudio, vídeo, documento)
-	SendMediaMessage(ctx context.Context, sessionID uuid.UUID, phone, mediaType string, mediaData []byte, caption, fileName, mimeType string) (string, error)
+	SendMediaMessage(ctx context.Context, sessionID uuid.UUID, phone, mediaType string, mediaData []byte, caption, fileName, mimeType string, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendMediaFromURL baixa mídia de uma URL e envia como mensagem
-	SendMediaFromURL(ctx context.Context, sessionID uuid.UUID, phone, mediaType, mediaURL, caption, fileName, mimeType string) (string, error)
+	SendMediaFromURL(ctx context.Context, sessionID uuid.UUID, phone, mediaType, mediaURL, caption, fileName, mimeType string, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendLocationMessage envia uma localização
-	SendLocationMessage(ctx context.Context, sessionID uuid.UUID, phone string, latitude, longitude float64, name, address string) (string, error)
+	SendLocationMessage(ctx context.Context, sessionID uuid.UUID, phone string, latitude, longitude float64, name, address string, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendContactMessage envia um contato
-	SendContactMessage(ctx context.Context, sessionID uuid.UUID, phone, contactName, contactJID string) (string, error)
+	SendContactMessage(ctx context.Context, sessionID uuid.UUID, phone, contactName, contactJID string, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendStickerMessage envia um sticker
-	SendStickerMessage(ctx context.Context, sessionID uuid.UUID, phone string, stickerData []byte, mimeType string) (string, error)
+	SendStickerMessage(ctx context.Context, sessionID uuid.UUID, phone string, stickerData []byte, mimeType string, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendButtonsMessage envia mensagem com botões
-	SendButtonsMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer string, buttons []message.MessageButton) (string, error)
+	SendButtonsMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer string, buttons []message.MessageButton, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendListMessage envia mensagem com lista
-	SendListMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer, title, buttonText string, sections []message.MessageListSection) (string, error)
+	SendListMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer, title, buttonText string, sections []message.MessageListSection, contextInfo *message.MessageContextInfo) (string, error)
 
 	// SendPollMessage envia enquete
-	SendPollMessage(ctx context.Context, sessionID uuid.UUID, phone, name string, options []string, selectableCount int) (string, error)
+	SendPollMessage(ctx context.Context, sessionID uuid.UUID, phone, name string, options []string, selectableCount int, contextInfo *message.MessageContextInfo) (string, error)
 
 	// EditMessage edita mensagem existente
 	EditMessage(ctx context.Context, sessionID uuid.UUID, phone, messageID, newText string) (string, error)
@@ -74,6 +77,15 @@ type WhatsAppClient interface {
 
 	// ReactMessage reage a uma mensagem
 	ReactMessage(ctx context.Context, sessionID uuid.UUID, phone, messageID, emoji string) error
+
+	// SendChatPresence envia o indicador de digitação ou gravação para um chat
+	SendChatPresence(ctx context.Context, sessionID uuid.UUID, chat string, state message.ChatPresenceState) error
+
+	// MarkRead marca como lidas mensagens de um remetente em um chat; sender é ignorado fora de grupos
+	MarkRead(ctx context.Context, sessionID uuid.UUID, chat, sender string, messageIDs []string, timestamp time.Time) error
+
+	// DownloadMedia baixa e descriptografa uma mídia a partir dos metadados recebidos na mensagem
+	DownloadMedia(ctx context.Context, sessionID uuid.UUID, mediaType message.InboundMessageType, media *message.InboundMedia) (*message.MediaContent, error)
 }
 
 // WhatsAppManager gerencia múltiplas sessões WhatsApp
@@ -29,4 +29,7 @@ var (
 	
 	// ErrInvalidJID indica que o JID é inválido
 	ErrInvalidJID = errors.New("invalid jid")
+
+	// ErrInvalidEventType indica um tipo de evento desconhecido no filtro de um stream
+	ErrInvalidEventType = errors.New("invalid event type")
 )
\ No newline at end of file
@@ -1,6 +1,8 @@
 package whatsapp
 
 import (
+	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,10 +19,102 @@ const (
 	EventPairSuccess  EventType = "pair_success"
 	EventPairCode     EventType = "pair_code"
 	EventError        EventType = "error"
+
+	// Eventos também entregues ao webhook da sessão
+	EventLoggedOut     EventType = "logged_out"
+	EventPairError     EventType = "pair_error"
+	EventMessage       EventType = "message"
+	EventMessageStatus EventType = "message.status"
+	// EventMessageQueue informa o resultado dos envios da fila assíncrona (enviado, falha ou nova tentativa)
+	EventMessageQueue EventType = "message.queue"
+	// EventMessageScheduled informa o resultado dos envios agendados (enviado, falha, descartado ou nova tentativa)
+	EventMessageScheduled EventType = "message.scheduled"
+	// EventMessageMedia informa a conclusão do download automático de uma mídia que terminou depois do evento message
+	EventMessageMedia EventType = "message.media"
+
+	// Conexão
+	EventKeepAliveTimeout            EventType = "keep_alive_timeout"
+	EventKeepAliveRestored           EventType = "keep_alive_restored"
+	EventStreamError                 EventType = "stream_error"
+	EventStreamReplaced              EventType = "stream_replaced"
+	EventTemporaryBan                EventType = "temporary_ban"
+	EventQRScannedWithoutMultidevice EventType = "qr_scanned_without_multidevice"
+
+	// Mensagens
+	EventUndecryptableMessage EventType = "undecryptable_message"
+	EventMediaRetry           EventType = "media_retry"
+
+	// Presença
+	EventPresence     EventType = "presence"
+	EventChatPresence EventType = "chat_presence"
+
+	// Sincronização
+	EventHistorySync          EventType = "history_sync"
+	EventHistorySyncProgress  EventType = "history.sync.progress"
+	EventAppState             EventType = "app_state"
+	EventAppStateSyncComplete EventType = "app_state_sync_complete"
+	EventOfflineSyncPreview   EventType = "offline_sync_preview"
+	EventOfflineSyncCompleted EventType = "offline_sync_completed"
+
+	// Configurações da conta
+	EventPushNameSetting       EventType = "push_name_setting"
+	EventPushName              EventType = "push_name"
+	EventPrivacySettings       EventType = "privacy_settings"
+	EventUnarchiveChatsSetting EventType = "unarchive_chats_setting"
+
+	// Chats
+	EventArchive        EventType = "archive"
+	EventClearChat      EventType = "clear_chat"
+	EventDeleteChat     EventType = "delete_chat"
+	EventDeleteForMe    EventType = "delete_for_me"
+	EventMarkChatAsRead EventType = "mark_chat_as_read"
+	EventMute           EventType = "mute"
+	EventPin            EventType = "pin"
+	EventStar           EventType = "star"
+
+	// Contatos
+	EventContact        EventType = "contact"
+	EventBlocklist      EventType = "blocklist"
+	EventPicture        EventType = "picture"
+	EventUserAbout      EventType = "user_about"
+	EventUserStatusMute EventType = "user_status_mute"
+	EventBusinessName   EventType = "business_name"
+
+	// Chamadas
+	EventCallOffer        EventType = "call_offer"
+	EventCallOfferNotice  EventType = "call_offer_notice"
+	EventCallPreAccept    EventType = "call_pre_accept"
+	EventCallAccept       EventType = "call_accept"
+	EventCallReject       EventType = "call_reject"
+	EventCallTerminate    EventType = "call_terminate"
+	EventCallTransport    EventType = "call_transport"
+	EventCallRelayLatency EventType = "call_relay_latency"
+	EventCallUnknown      EventType = "call_unknown"
+
+	// Grupos
+	EventGroupInfo         EventType = "group_info"
+	EventGroupParticipants EventType = "group.participants"
+	EventJoinedGroup       EventType = "joined_group"
+
+	// Canais
+	EventNewsletterJoin       EventType = "newsletter_join"
+	EventNewsletterLeave      EventType = "newsletter_leave"
+	EventNewsletterLiveUpdate EventType = "newsletter_live_update"
+	EventNewsletterMuteChange EventType = "newsletter_mute_change"
+
+	// Etiquetas
+	EventLabelEdit               EventType = "label_edit"
+	EventLabelAssociationChat    EventType = "label_association_chat"
+	EventLabelAssociationMessage EventType = "label_association_message"
+
+	// Segurança
+	EventIdentityChange EventType = "identity_change"
 )
 
 // Event representa um evento do WhatsApp
 type Event struct {
+	// ID é sequencial e atribuído pelo EventBus; permite retomar streams a partir do último evento recebido
+	ID        uint64      `json:"id"`
 	Type      EventType   `json:"type"`
 	SessionID uuid.UUID   `json:"sessionId"`
 	Timestamp time.Time   `json:"timestamp"`
@@ -81,4 +175,136 @@ type EventBus interface {
 
 	// PublishAsync publica um evento de forma assíncrona
 	PublishAsync(event Event)
-}
\ No newline at end of file
+}
+
+// EventStream estende o EventBus com os eventos recentes de cada sessão, usados para retomar streams
+type EventStream interface {
+	EventBus
+
+	// Replay retorna os eventos da sessão com ID maior que afterID ainda mantidos em memória
+	Replay(sessionID uuid.UUID, afterID uint64) []Event
+}
+
+// EventSink recebe os eventos normalizados das sessões, na ordem em que ocorreram em cada sessão.
+// Os sinks são registrados no dispatcher de eventos e chamados em sequência para cada evento.
+type EventSink interface {
+	// Name identifica o sink nos logs e métricas
+	Name() string
+
+	// Handle processa um evento; um erro é registrado sem impedir a entrega aos demais sinks
+	Handle(ctx context.Context, event Event) error
+}
+
+// EventPublisher entrega aos sinks (webhooks, streams e broker) eventos gerados fora do whatsmeow,
+// como os resultados da fila de envio
+type EventPublisher interface {
+	// PublishEvent entrega o evento a todos os sinks registrados
+	PublishEvent(event Event)
+}
+
+// EventCategory agrupa os tipos de evento das sessões; aceita em padrões como "connection.*"
+type EventCategory string
+
+const (
+	CategoryConnection EventCategory = "connection"
+	CategoryMessage    EventCategory = "message"
+	CategoryPresence   EventCategory = "presence"
+	CategorySync       EventCategory = "sync"
+	CategoryAccount    EventCategory = "account"
+	CategoryChat       EventCategory = "chat"
+	CategoryContact    EventCategory = "contact"
+	CategoryCall       EventCategory = "call"
+	CategoryGroup      EventCategory = "group"
+	CategoryNewsletter EventCategory = "newsletter"
+	CategoryLabel      EventCategory = "label"
+	CategorySecurity   EventCategory = "security"
+)
+
+// sessionEventCatalog lista, em ordem de documentação, os eventos entregues ao webhook e aos streams das sessões
+var sessionEventCatalog = []struct {
+	category EventCategory
+	types    []EventType
+}{
+	{CategoryConnection, []EventType{
+		EventConnected, EventDisconnected, EventLoggedOut, EventPairSuccess, EventPairError,
+		EventKeepAliveTimeout, EventKeepAliveRestored, EventStreamError, EventStreamReplaced, EventTemporaryBan,
+		EventQRScannedWithoutMultidevice,
+	}},
+	{CategoryMessage, []EventType{EventMessage, EventMessageStatus, EventMessageQueue, EventMessageScheduled, EventMessageMedia, EventUndecryptableMessage, EventMediaRetry}},
+	{CategoryPresence, []EventType{EventPresence, EventChatPresence}},
+	{CategorySync, []EventType{
+		EventHistorySync, EventHistorySyncProgress, EventAppState, EventAppStateSyncComplete, EventOfflineSyncPreview, EventOfflineSyncCompleted,
+	}},
+	{CategoryAccount, []EventType{EventPushNameSetting, EventPushName, EventPrivacySettings, EventUnarchiveChatsSetting}},
+	{CategoryChat, []EventType{
+		EventArchive, EventClearChat, EventDeleteChat, EventDeleteForMe, EventMarkChatAsRead, EventMute, EventPin, EventStar,
+	}},
+	{CategoryContact, []EventType{
+		EventContact, EventBlocklist, EventPicture, EventUserAbout, EventUserStatusMute, EventBusinessName,
+	}},
+	{CategoryCall, []EventType{
+		EventCallOffer, EventCallOfferNotice, EventCallPreAccept, EventCallAccept, EventCallReject,
+		EventCallTerminate, EventCallTransport, EventCallRelayLatency, EventCallUnknown,
+	}},
+	{CategoryGroup, []EventType{EventGroupInfo, EventGroupParticipants, EventJoinedGroup}},
+	{CategoryNewsletter, []EventType{
+		EventNewsletterJoin, EventNewsletterLeave, EventNewsletterLiveUpdate, EventNewsletterMuteChange,
+	}},
+	{CategoryLabel, []EventType{EventLabelEdit, EventLabelAssociationChat, EventLabelAssociationMessage}},
+	{CategorySecurity, []EventType{EventIdentityChange}},
+}
+
+var sessionEventCategories = func() map[EventType]EventCategory {
+	categories := make(map[EventType]EventCategory)
+	for _, group := range sessionEventCatalog {
+		for _, t := range group.types {
+			categories[t] = group.category
+		}
+	}
+	return categories
+}()
+
+// SessionEventTypes retorna todos os tipos de evento que uma sessão pode assinar
+func SessionEventTypes() []EventType {
+	var types []EventType
+	for _, group := range sessionEventCatalog {
+		types = append(types, group.types...)
+	}
+	return types
+}
+
+// IsSessionEvent verifica se o tipo de evento é entregue ao webhook e aos streams das sessões
+func (t EventType) IsSessionEvent() bool {
+	_, ok := sessionEventCategories[t]
+	return ok
+}
+
+// Category retorna a categoria do tipo de evento, ou vazio se ele não for um evento de sessão
+func (t EventType) Category() EventCategory {
+	return sessionEventCategories[t]
+}
+
+// MatchEventPattern verifica se o evento atende ao padrão: "*" (todos), um tipo exato, ou "<prefixo>.*",
+// que aceita o próprio prefixo, os tipos iniciados por "<prefixo>." e os tipos da categoria de mesmo nome
+func MatchEventPattern(pattern string, event EventType) bool {
+	if pattern == "*" {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(pattern, ".*")
+	if !ok {
+		return string(event) == pattern
+	}
+	return string(event) == prefix ||
+		strings.HasPrefix(string(event), prefix+".") ||
+		string(event.Category()) == prefix
+}
+
+// IsValidEventPattern verifica se o padrão aceita ao menos um evento de sessão
+func IsValidEventPattern(pattern string) bool {
+	for t := range sessionEventCategories {
+		if MatchEventPattern(pattern, t) {
+			return true
+		}
+	}
+	return false
+}
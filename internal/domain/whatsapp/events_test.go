@@ -0,0 +1,77 @@
+package whatsapp
+
+import "testing"
+
+func TestMatchEventPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		event   EventType
+		want    bool
+	}{
+		{name: "exact match", pattern: "message", event: EventMessage, want: true},
+		{name: "exact match with dots", pattern: "message.status", event: EventMessageStatus, want: true},
+		{name: "exact pattern does not match subtypes", pattern: "message", event: EventMessageStatus, want: false},
+		{name: "wildcard matches everything", pattern: "*", event: EventCallOffer, want: true},
+		{name: "prefix wildcard matches the prefix itself", pattern: "message.*", event: EventMessage, want: true},
+		{name: "prefix wildcard matches dotted subtypes", pattern: "message.*", event: EventMessageQueue, want: true},
+		{name: "prefix wildcard matches the category", pattern: "message.*", event: EventUndecryptableMessage, want: true},
+		{name: "category wildcard", pattern: "call.*", event: EventCallTerminate, want: true},
+		{name: "prefix wildcard of another category", pattern: "call.*", event: EventMessage, want: false},
+		{name: "prefix is not a plain string prefix", pattern: "mess.*", event: EventMessage, want: false},
+		{name: "different event", pattern: "presence", event: EventChatPresence, want: false},
+		{name: "empty pattern", pattern: "", event: EventMessage, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchEventPattern(tt.pattern, tt.event); got != tt.want {
+				t.Fatalf("MatchEventPattern(%q, %q) = %v, want %v", tt.pattern, tt.event, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookConfigSubscribes(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []string
+		event  string
+		want   bool
+	}{
+		{name: "empty subscription list receives every event", events: nil, event: "group.participants", want: true},
+		{name: "empty slice receives every event", events: []string{}, event: "message", want: true},
+		{name: "subscribed exact event", events: []string{"presence", "message"}, event: "message", want: true},
+		{name: "subscribed by prefix wildcard", events: []string{"message.*"}, event: "message.status", want: true},
+		{name: "not subscribed", events: []string{"message.*"}, event: "presence", want: false},
+		{name: "wildcard subscription", events: []string{"*"}, event: "presence", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &WebhookConfig{Events: tt.events}
+			if got := config.Subscribes(tt.event); got != tt.want {
+				t.Fatalf("Subscribes(%q) with %v = %v, want %v", tt.event, tt.events, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidEventPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    bool
+	}{
+		{pattern: "*", want: true},
+		{pattern: "message", want: true},
+		{pattern: "group.*", want: true},
+		{pattern: "unknown_event", want: false},
+		{pattern: "unknown.*", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidEventPattern(tt.pattern); got != tt.want {
+			t.Fatalf("IsValidEventPattern(%q) = %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
@@ -45,33 +45,70 @@ type ConnectionManager interface {
 
 // WebhookService define operações para webhooks
 type WebhookService interface {
-	// SendWebhook envia um webhook para uma sessão
+	// SendWebhook envia o evento a cada webhook da sessão que o assina
 	SendWebhook(sessionID uuid.UUID, event string, data map[string]interface{}) error
 
-	// SetWebhookConfig configura webhook para uma sessão
+	// SetWebhookConfig cria ou atualiza um webhook da sessão (identificado por config.ID)
 	SetWebhookConfig(config *WebhookConfig) error
 
-	// GetWebhookConfig retorna a configuração de webhook de uma sessão
-	GetWebhookConfig(sessionID uuid.UUID) (*WebhookConfig, error)
+	// GetWebhookConfig retorna a configuração de um webhook da sessão
+	GetWebhookConfig(sessionID, webhookID uuid.UUID) (*WebhookConfig, error)
 
-	// RemoveWebhookConfig remove a configuração de webhook de uma sessão
-	RemoveWebhookConfig(sessionID uuid.UUID) error
+	// RemoveWebhookConfig remove um webhook da sessão
+	RemoveWebhookConfig(sessionID, webhookID uuid.UUID) error
 
-	// EnableWebhook habilita webhook para uma sessão
-	EnableWebhook(sessionID uuid.UUID) error
+	// EnableWebhook habilita um webhook da sessão
+	EnableWebhook(sessionID, webhookID uuid.UUID) error
 
-	// DisableWebhook desabilita webhook para uma sessão
-	DisableWebhook(sessionID uuid.UUID) error
+	// DisableWebhook desabilita um webhook da sessão
+	DisableWebhook(sessionID, webhookID uuid.UUID) error
+
+	// SendTestWebhook envia um webhook de teste para um webhook da sessão
+	SendTestWebhook(sessionID, webhookID uuid.UUID) error
 }
 
 // WebhookConfig representa a configuração de webhook
 type WebhookConfig struct {
+	ID        uuid.UUID `json:"id"`
 	SessionID uuid.UUID `json:"sessionId"`
 	URL       string    `json:"url"`
 	Secret    string    `json:"secret,omitempty"`
 	Enabled   bool      `json:"enabled"`
 	Retries   int       `json:"retries"`
 	Timeout   int       `json:"timeout"` // em segundos
+	Events    []string  `json:"events,omitempty"`
+
+	// Headers estáticos enviados em cada entrega
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Segredo anterior, ainda aceito durante a janela de rotação
+	PreviousSecret          string     `json:"-"`
+	PreviousSecretExpiresAt *time.Time `json:"previousSecretExpiresAt,omitempty"`
+}
+
+// Subscribes verifica se algum padrão de eventos do webhook aceita o evento; sem padrões, todos são entregues
+func (c *WebhookConfig) Subscribes(event string) bool {
+	if len(c.Events) == 0 {
+		return true
+	}
+	for _, pattern := range c.Events {
+		if MatchEventPattern(pattern, EventType(event)) {
+			return true
+		}
+	}
+	return false
+}
+
+// SigningSecrets retorna os segredos válidos para assinar entregas no instante informado
+func (c *WebhookConfig) SigningSecrets(now time.Time) []string {
+	var secrets []string
+	if c.Secret != "" {
+		secrets = append(secrets, c.Secret)
+	}
+	if c.PreviousSecret != "" && c.PreviousSecretExpiresAt != nil && now.Before(*c.PreviousSecretExpiresAt) {
+		secrets = append(secrets, c.PreviousSecret)
+	}
+	return secrets
 }
 
 // SessionManager define operações para gerenciamento de sessões WhatsApp
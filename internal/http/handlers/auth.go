@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	domainAuth "zmeow/internal/domain/auth"
+	domainSession "zmeow/internal/domain/session"
+	"zmeow/internal/http/responses"
+	"zmeow/internal/usecases/auth"
+	"zmeow/pkg/logger"
+)
+
+// AuthHandler implementa os handlers de gerenciamento de chaves de API
+type AuthHandler struct {
+	createUseCase *auth.CreateAPIKeyUseCase
+	listUseCase   *auth.ListAPIKeysUseCase
+	rotateUseCase *auth.RotateAPIKeyUseCase
+	revokeUseCase *auth.RevokeAPIKeyUseCase
+	logger        logger.Logger
+}
+
+// NewAuthHandler cria uma nova instância do auth handler
+func NewAuthHandler(
+	createUseCase *auth.CreateAPIKeyUseCase,
+	listUseCase *auth.ListAPIKeysUseCase,
+	rotateUseCase *auth.RotateAPIKeyUseCase,
+	revokeUseCase *auth.RevokeAPIKeyUseCase,
+	logger logger.Logger,
+) *AuthHandler {
+	return &AuthHandler{
+		createUseCase: createUseCase,
+		listUseCase:   listUseCase,
+		rotateUseCase: rotateUseCase,
+		revokeUseCase: revokeUseCase,
+		logger:        logger.WithComponent("auth-handler"),
+	}
+}
+
+// CreateKey cria uma nova chave de API
+// @Summary      Criar Chave de API
+// @Description  Cria uma chave de administrador ou de sessão. O segredo é retornado apenas nesta resposta.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        request  body      auth.CreateAPIKeyRequest  true  "Dados da chave"
+// @Success      201      {object}  responses.CreatedResponse  "Chave criada com sucesso"
+// @Failure      400      {object}  responses.ErrorResponse  "Dados inválidos"
+// @Failure      401      {object}  responses.ErrorResponse  "Não autenticado"
+// @Failure      403      {object}  responses.ErrorResponse  "Acesso negado"
+// @Failure      404      {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500      {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /auth/keys [post]
+func (h *AuthHandler) CreateKey(w http.ResponseWriter, r *http.Request) {
+	var req auth.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode create api key request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	key, err := h.createUseCase.Execute(r.Context(), req)
+	if err != nil {
+		var validationErr *domainSession.ValidationError
+		switch {
+		case errors.As(err, &validationErr),
+			errors.Is(err, domainAuth.ErrInvalidKeyRole),
+			errors.Is(err, domainAuth.ErrSessionIDRequired):
+			responses.BadRequest(w, "Invalid request", err.Error())
+		case errors.Is(err, domainSession.ErrSessionNotFound):
+			responses.NotFound(w, "Session not found")
+		default:
+			h.logger.WithError(err).Error().Msg("Failed to create api key")
+			responses.InternalError(w, "Failed to create api key")
+		}
+		return
+	}
+
+	responses.Created(w, "Chave criada com sucesso", key)
+}
+
+// ListKeys lista as chaves de API
+// @Summary      Listar Chaves de API
+// @Description  Lista todas as chaves de API (sem os segredos)
+// @Tags         auth
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Success      200  {object}  responses.SuccessResponse  "Lista de chaves"
+// @Failure      401  {object}  responses.ErrorResponse  "Não autenticado"
+// @Failure      403  {object}  responses.ErrorResponse  "Acesso negado"
+// @Failure      500  {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /auth/keys [get]
+func (h *AuthHandler) ListKeys(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.listUseCase.Execute(r.Context())
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to list api keys")
+		responses.InternalError(w, "Failed to list api keys")
+		return
+	}
+
+	responses.Success(w, "Chaves listadas com sucesso", keys)
+}
+
+// RotateKey gera um novo segredo para uma chave
+// @Summary      Rotacionar Chave de API
+// @Description  Gera um novo segredo para a chave; o segredo anterior deixa de ser aceito
+// @Tags         auth
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        keyID  path      string  true  "ID da chave (UUID)"
+// @Success      200    {object}  responses.SuccessResponse  "Chave rotacionada"
+// @Failure      400    {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404    {object}  responses.ErrorResponse  "Chave não encontrada"
+// @Failure      409    {object}  responses.ErrorResponse  "Chave revogada"
+// @Failure      500    {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /auth/keys/{keyID}/rotate [post]
+func (h *AuthHandler) RotateKey(w http.ResponseWriter, r *http.Request) {
+	keyID, err := uuid.Parse(chi.URLParam(r, "keyID"))
+	if err != nil {
+		responses.BadRequest(w, "Invalid key ID format", err.Error())
+		return
+	}
+
+	key, err := h.rotateUseCase.Execute(r.Context(), keyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainAuth.ErrAPIKeyNotFound):
+			responses.NotFound(w, "API key not found")
+		case errors.Is(err, domainAuth.ErrAPIKeyRevoked):
+			responses.Conflict(w, "API key is revoked", err.Error())
+		default:
+			h.logger.WithError(err).Error().Msg("Failed to rotate api key")
+			responses.InternalError(w, "Failed to rotate api key")
+		}
+		return
+	}
+
+	responses.Success(w, "Chave rotacionada com sucesso", key)
+}
+
+// RevokeKey revoga uma chave de API
+// @Summary      Revogar Chave de API
+// @Description  Revoga uma chave de API; requisições com ela passam a ser rejeitadas
+// @Tags         auth
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        keyID  path      string  true  "ID da chave (UUID)"
+// @Success      200    {object}  responses.SuccessResponse  "Chave revogada"
+// @Failure      400    {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404    {object}  responses.ErrorResponse  "Chave não encontrada"
+// @Failure      500    {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /auth/keys/{keyID} [delete]
+func (h *AuthHandler) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	keyID, err := uuid.Parse(chi.URLParam(r, "keyID"))
+	if err != nil {
+		responses.BadRequest(w, "Invalid key ID format", err.Error())
+		return
+	}
+
+	if err := h.revokeUseCase.Execute(r.Context(), keyID); err != nil {
+		if errors.Is(err, domainAuth.ErrAPIKeyNotFound) {
+			responses.NotFound(w, "API key not found")
+			return
+		}
+		h.logger.WithError(err).Error().Msg("Failed to revoke api key")
+		responses.InternalError(w, "Failed to revoke api key")
+		return
+	}
+
+	responses.Success(w, "Chave revogada com sucesso", nil)
+}
@@ -0,0 +1,438 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/campaign"
+	domainMessage "zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	domainSession "zmeow/internal/domain/session"
+	"zmeow/internal/http/responses"
+	campaignUseCases "zmeow/internal/usecases/campaign"
+	"zmeow/pkg/logger"
+)
+
+// CampaignHandler implementa os handlers das campanhas de envio em massa
+type CampaignHandler struct {
+	createUseCase     *campaignUseCases.CreateCampaignUseCase
+	listUseCase       *campaignUseCases.ListCampaignsUseCase
+	getUseCase        *campaignUseCases.GetCampaignUseCase
+	reportUseCase     *campaignUseCases.GetCampaignReportUseCase
+	recipientsUseCase *campaignUseCases.ListRecipientsUseCase
+	controlUseCase    *campaignUseCases.ControlCampaignUseCase
+	logger            logger.Logger
+}
+
+// NewCampaignHandler cria uma nova instância do campaign handler
+func NewCampaignHandler(
+	createUseCase *campaignUseCases.CreateCampaignUseCase,
+	listUseCase *campaignUseCases.ListCampaignsUseCase,
+	getUseCase *campaignUseCases.GetCampaignUseCase,
+	reportUseCase *campaignUseCases.GetCampaignReportUseCase,
+	recipientsUseCase *campaignUseCases.ListRecipientsUseCase,
+	controlUseCase *campaignUseCases.ControlCampaignUseCase,
+	logger logger.Logger,
+) *CampaignHandler {
+	return &CampaignHandler{
+		createUseCase:     createUseCase,
+		listUseCase:       listUseCase,
+		getUseCase:        getUseCase,
+		reportUseCase:     reportUseCase,
+		recipientsUseCase: recipientsUseCase,
+		controlUseCase:    controlUseCase,
+		logger:            logger.WithComponent("campaign-handler"),
+	}
+}
+
+// CreateCampaign cria uma campanha
+// @Summary Criar campanha
+// @Description Cria uma campanha que envia o mesmo modelo a uma lista de destinatários, no ritmo `ratePerMinute`.
+// @Description `template` é a requisição do endpoint de envio do `kind` (text, media, location, contact, sticker, buttons,
+// @Description list, poll) sem o destino; os textos aceitam variáveis `{{nome}}`, preenchidas com as `variables` de cada
+// @Description destinatário (`{{number}}` é o próprio número). Números repetidos são enviados uma única vez.
+// @Description
+// @Description Os destinatários podem vir no JSON (`recipients`) ou, em multipart/form-data, no arquivo CSV `recipients`
+// @Description com cabeçalho: a coluna `number` é obrigatória e as demais viram variáveis. Os demais campos vão nos campos
+// @Description do formulário `name`, `kind`, `template` (JSON) e `ratePerMinute`.
+// @Description
+// @Description **Exemplo de uso:**
+// @Description ```json
+// @Description {
+// @Description   "name": "Promoção de agosto",
+// @Description   "kind": "text",
+// @Description   "template": {"text": "Olá {{name}}, seu cupom é {{coupon}}"},
+// @Description   "ratePerMinute": 20,
+// @Description   "recipients": [
+// @Description     {"number": "5511999999999", "variables": {"name": "Ana", "coupon": "AGO10"}}
+// @Description   ]
+// @Description }
+// @Description ```
+// @Tags Campanhas
+// @Accept json,mpfd
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param request body campaign.CreateCampaignRequest true "Campanha a criar"
+// @Success 201 {object} responses.SuccessResponse{data=campaign.Campaign} "Campanha criada"
+// @Failure 400 {object} responses.ErrorResponse "Modelo, destinatários ou ritmo inválido"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID} [post]
+func (h *CampaignHandler) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	var req campaign.CreateCampaignRequest
+	var err error
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		req, err = h.parseFormDataCampaign(r)
+	} else {
+		err = json.NewDecoder(r.Body).Decode(&req)
+	}
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode create campaign request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	c, err := h.createUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to create campaign")
+		return
+	}
+
+	responses.Created(w, "Campanha criada com sucesso", c)
+}
+
+// ListCampaigns lista as campanhas da sessão
+// @Summary Listar campanhas
+// @Description Lista as campanhas da sessão, das mais recentes às mais antigas
+// @Tags Campanhas
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param status query string false "Status (running, paused, completed, canceled)"
+// @Param limit query int false "Quantidade máxima de itens (padrão 50, máximo 200)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} responses.SuccessResponse{data=campaign.CampaignListResponse} "Campanhas encontradas"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID} [get]
+func (h *CampaignHandler) ListCampaigns(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset, ok := h.parsePagination(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.listUseCase.Execute(r.Context(), sessionID, campaign.ListCampaignsRequest{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		h.handleError(w, err, "Failed to list campaigns")
+		return
+	}
+
+	responses.Success(w, "Campanhas encontradas", result)
+}
+
+// GetCampaign consulta uma campanha
+// @Summary Consultar campanha
+// @Description Retorna a campanha com o modelo, o ritmo, o status e a quantidade de destinatários
+// @Tags Campanhas
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param campaignID path string true "ID da campanha (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=campaign.Campaign} "Campanha encontrada"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Campanha não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID}/{campaignID} [get]
+func (h *CampaignHandler) GetCampaign(w http.ResponseWriter, r *http.Request) {
+	sessionID, campaignID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.getUseCase.Execute(r.Context(), sessionID, campaignID)
+	if err != nil {
+		h.handleError(w, err, "Failed to get campaign")
+		return
+	}
+
+	responses.Success(w, "Campanha encontrada", c)
+}
+
+// GetCampaignReport consulta o andamento de uma campanha
+// @Summary Relatório da campanha
+// @Description Retorna a quantidade de destinatários por status (queued, sending, sent, delivered, read, failed, canceled),
+// @Description o percentual processado e as taxas de entrega e leitura sobre as mensagens enviadas
+// @Tags Campanhas
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param campaignID path string true "ID da campanha (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=campaign.CampaignReport} "Relatório da campanha"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Campanha não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID}/{campaignID}/report [get]
+func (h *CampaignHandler) GetCampaignReport(w http.ResponseWriter, r *http.Request) {
+	sessionID, campaignID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.reportUseCase.Execute(r.Context(), sessionID, campaignID)
+	if err != nil {
+		h.handleError(w, err, "Failed to get campaign report")
+		return
+	}
+
+	responses.Success(w, "Relatório da campanha", report)
+}
+
+// ListCampaignRecipients lista os destinatários de uma campanha
+// @Summary Listar destinatários da campanha
+// @Description Lista os destinatários na ordem da lista com status, tentativas, último erro e o ID da mensagem enviada
+// @Tags Campanhas
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param campaignID path string true "ID da campanha (UUID)" format(uuid)
+// @Param status query string false "Status (queued, sending, sent, delivered, read, failed, canceled)"
+// @Param limit query int false "Quantidade máxima de itens (padrão 100, máximo 1000)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} responses.SuccessResponse{data=campaign.RecipientListResponse} "Destinatários encontrados"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Campanha não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID}/{campaignID}/recipients [get]
+func (h *CampaignHandler) ListCampaignRecipients(w http.ResponseWriter, r *http.Request) {
+	sessionID, campaignID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset, ok := h.parsePagination(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.recipientsUseCase.Execute(r.Context(), sessionID, campaignID, campaign.ListRecipientsRequest{
+		Status: r.URL.Query().Get("status"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		h.handleError(w, err, "Failed to list campaign recipients")
+		return
+	}
+
+	responses.Success(w, "Destinatários encontrados", result)
+}
+
+// PauseCampaign pausa uma campanha em andamento
+// @Summary Pausar campanha
+// @Description Suspende os envios de uma campanha com status running; o envio em curso, se houver, é concluído
+// @Tags Campanhas
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param campaignID path string true "ID da campanha (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=campaign.Campaign} "Campanha pausada"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Campanha não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Campanha não está em andamento"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID}/{campaignID}/pause [post]
+func (h *CampaignHandler) PauseCampaign(w http.ResponseWriter, r *http.Request) {
+	sessionID, campaignID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.controlUseCase.Pause(r.Context(), sessionID, campaignID)
+	if err != nil {
+		h.handleError(w, err, "Failed to pause campaign")
+		return
+	}
+
+	responses.Success(w, "Campanha pausada", c)
+}
+
+// ResumeCampaign retoma uma campanha pausada
+// @Summary Retomar campanha
+// @Description Retoma os envios de uma campanha com status paused a partir do próximo destinatário na fila
+// @Tags Campanhas
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param campaignID path string true "ID da campanha (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=campaign.Campaign} "Campanha retomada"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Campanha não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Campanha não está pausada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID}/{campaignID}/resume [post]
+func (h *CampaignHandler) ResumeCampaign(w http.ResponseWriter, r *http.Request) {
+	sessionID, campaignID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.controlUseCase.Resume(r.Context(), sessionID, campaignID)
+	if err != nil {
+		h.handleError(w, err, "Failed to resume campaign")
+		return
+	}
+
+	responses.Success(w, "Campanha retomada", c)
+}
+
+// CancelCampaign cancela uma campanha
+// @Summary Cancelar campanha
+// @Description Encerra uma campanha em andamento ou pausada; os destinatários ainda na fila ficam com status canceled
+// @Tags Campanhas
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param campaignID path string true "ID da campanha (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=campaign.Campaign} "Campanha cancelada"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Campanha não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Campanha já encerrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /campaigns/{sessionID}/{campaignID}/cancel [post]
+func (h *CampaignHandler) CancelCampaign(w http.ResponseWriter, r *http.Request) {
+	sessionID, campaignID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.controlUseCase.Cancel(r.Context(), sessionID, campaignID)
+	if err != nil {
+		h.handleError(w, err, "Failed to cancel campaign")
+		return
+	}
+
+	responses.Success(w, "Campanha cancelada", c)
+}
+
+// parseFormDataCampaign processa a criação de campanha em form-data com a lista de destinatários em CSV
+func (h *CampaignHandler) parseFormDataCampaign(r *http.Request) (campaign.CreateCampaignRequest, error) {
+	var req campaign.CreateCampaignRequest
+
+	// Parse multipart form (32MB max)
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		return req, fmt.Errorf("failed to parse multipart form: %w", err)
+	}
+
+	req.Name = r.FormValue("name")
+	req.Kind = outbound.Kind(r.FormValue("kind"))
+	req.Template = json.RawMessage(r.FormValue("template"))
+	if value := r.FormValue("ratePerMinute"); value != "" {
+		rate, err := strconv.Atoi(value)
+		if err != nil {
+			return req, fmt.Errorf("invalid ratePerMinute: %w", err)
+		}
+		req.RatePerMinute = rate
+	}
+
+	file, _, err := r.FormFile("recipients")
+	if err != nil {
+		return req, fmt.Errorf("failed to get recipients file: %w", err)
+	}
+	defer file.Close()
+
+	req.Recipients, err = campaign.ParseRecipientsCSV(file, h.createUseCase.MaxRecipients())
+	if err != nil {
+		return req, err
+	}
+	return req, nil
+}
+
+// parsePagination extrai e valida os parâmetros limit e offset da query
+func (h *CampaignHandler) parsePagination(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	query := r.URL.Query()
+
+	var limit, offset int
+	var err error
+	if value := query.Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid limit", err.Error())
+			return 0, 0, false
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if offset, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid offset", err.Error())
+			return 0, 0, false
+		}
+	}
+	return limit, offset, true
+}
+
+// parseSessionID extrai e valida o sessionID da URL
+func (h *CampaignHandler) parseSessionID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
+
+// parseIDs extrai e valida o sessionID e o campaignID da URL
+func (h *CampaignHandler) parseIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	campaignID, err := uuid.Parse(chi.URLParam(r, "campaignID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid campaign ID format")
+		responses.BadRequest(w, "Invalid campaign ID format", err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	return sessionID, campaignID, true
+}
+
+// handleError mapeia erros de domínio para respostas HTTP
+func (h *CampaignHandler) handleError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.NotFound(w, "Session not found")
+	case errors.Is(err, campaign.ErrCampaignNotFound):
+		responses.NotFound(w, "Campaign not found")
+	case errors.Is(err, campaign.ErrInvalidTransition):
+		responses.Conflict(w, "Campaign status does not allow this operation", err.Error())
+	case errors.Is(err, campaign.ErrInvalidTemplate),
+		errors.Is(err, campaign.ErrMissingVariable),
+		errors.Is(err, campaign.ErrNoRecipients),
+		errors.Is(err, campaign.ErrTooManyRecipients),
+		errors.Is(err, campaign.ErrInvalidRecipient),
+		errors.Is(err, campaign.ErrInvalidRate),
+		errors.Is(err, campaign.ErrInvalidCSV),
+		errors.Is(err, campaign.ErrInvalidStatus),
+		errors.Is(err, outbound.ErrInvalidKind),
+		errors.Is(err, outbound.ErrInvalidPayload),
+		errors.Is(err, domainMessage.ErrInvalidSendRequest):
+		responses.BadRequest(w, "Invalid campaign request", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg(message)
+		responses.InternalError(w, message)
+	}
+}
@@ -1,13 +1,22 @@
 package handlers
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
-	"go.mau.fi/whatsmeow/types"
+	"github.com/google/uuid"
 
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	domainSession "zmeow/internal/domain/session"
 	"zmeow/internal/http/responses"
+	chatUsecases "zmeow/internal/usecases/chat"
 	messageUsecases "zmeow/internal/usecases/message"
 	"zmeow/pkg/logger"
 )
@@ -26,6 +35,16 @@ type ChatHandler struct {
 	editMessageUseCase   *messageUsecases.EditMessageUseCase
 	deleteMessageUseCase *messageUsecases.DeleteMessageUseCase
 	reactMessageUseCase  *messageUsecases.ReactMessageUseCase
+	chatPresenceUseCase  *messageUsecases.SendChatPresenceUseCase
+	markReadUseCase      *messageUsecases.MarkReadUseCase
+	downloadMediaUseCase *messageUsecases.DownloadMediaUseCase
+	listChatsUseCase     *chatUsecases.ListChatsUseCase
+	archiveChatUseCase   *chatUsecases.ArchiveChatUseCase
+	pinChatUseCase       *chatUsecases.PinChatUseCase
+	muteChatUseCase      *chatUsecases.MuteChatUseCase
+	markUnreadUseCase    *chatUsecases.MarkChatUnreadUseCase
+	clearChatUseCase     *chatUsecases.ClearChatUseCase
+	deleteChatUseCase    *chatUsecases.DeleteChatUseCase
 }
 
 // NewChatHandler cria uma nova instância do ChatHandler
@@ -41,6 +60,16 @@ func NewChatHandler(
 	editMessageUseCase *messageUsecases.EditMessageUseCase,
 	deleteMessageUseCase *messageUsecases.DeleteMessageUseCase,
 	reactMessageUseCase *messageUsecases.ReactMessageUseCase,
+	chatPresenceUseCase *messageUsecases.SendChatPresenceUseCase,
+	markReadUseCase *messageUsecases.MarkReadUseCase,
+	downloadMediaUseCase *messageUsecases.DownloadMediaUseCase,
+	listChatsUseCase *chatUsecases.ListChatsUseCase,
+	archiveChatUseCase *chatUsecases.ArchiveChatUseCase,
+	pinChatUseCase *chatUsecases.PinChatUseCase,
+	muteChatUseCase *chatUsecases.MuteChatUseCase,
+	markUnreadUseCase *chatUsecases.MarkChatUnreadUseCase,
+	clearChatUseCase *chatUsecases.ClearChatUseCase,
+	deleteChatUseCase *chatUsecases.DeleteChatUseCase,
 ) *ChatHandler {
 	return &ChatHandler{
 		logger:               logger.WithComponent("chat-handler"),
@@ -55,305 +84,587 @@ func NewChatHandler(
 		editMessageUseCase:   editMessageUseCase,
 		deleteMessageUseCase: deleteMessageUseCase,
 		reactMessageUseCase:  reactMessageUseCase,
+		chatPresenceUseCase:  chatPresenceUseCase,
+		markReadUseCase:      markReadUseCase,
+		downloadMediaUseCase: downloadMediaUseCase,
+		listChatsUseCase:     listChatsUseCase,
+		archiveChatUseCase:   archiveChatUseCase,
+		pinChatUseCase:       pinChatUseCase,
+		muteChatUseCase:      muteChatUseCase,
+		markUnreadUseCase:    markUnreadUseCase,
+		clearChatUseCase:     clearChatUseCase,
+		deleteChatUseCase:    deleteChatUseCase,
 	}
 }
 
-// ChatPresenceRequest representa a requisição para definir presença no chat
-type ChatPresenceRequest struct {
-	Phone string                  `json:"phone" validate:"required"`
-	State string                  `json:"state" validate:"required,oneof=composing recording paused"`
-	Media types.ChatPresenceMedia `json:"media,omitempty"`
-}
-
-// MarkReadRequest representa a requisição para marcar mensagens como lidas
-type MarkReadRequest struct {
-	MessageIDs []string  `json:"message_ids" validate:"required"`
-	Chat       types.JID `json:"chat" validate:"required"`
-	Sender     types.JID `json:"sender,omitempty"`
-}
-
-// DownloadMediaRequest representa a requisição para download de mídia
-type DownloadMediaRequest struct {
-	MessageID string `json:"message_id" validate:"required"`
-	Phone     string `json:"phone" validate:"required"`
-}
-
 // SendChatPresence define a presença no chat (digitando, gravando, etc.)
 // @Summary Definir presença no chat
-// @Description Define o status de presença no chat (digitando, gravando áudio, pausado)
+// @Description Envia o indicador de presença para um contato ou grupo: digitando (composing), gravando áudio (recording) ou pausado (paused)
 // @Tags Chat
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão (UUID)"
-// @Param request body object true "Dados da presença no chat"
-// @Success 200 {object} responses.SuccessResponse "Presença definida com sucesso"
+// @Param request body message.ChatPresenceRequest true "Dados da presença no chat"
+// @Success 200 {object} responses.SuccessResponse{data=message.ChatPresenceResponse} "Presença definida com sucesso"
 // @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
 // @Router /chat/{sessionID}/presence [post]
 func (h *ChatHandler) SendChatPresence(w http.ResponseWriter, r *http.Request) {
-	sessionID := chi.URLParam(r, "sessionID")
-	if sessionID == "" {
-		responses.Error400(w, "Session ID é obrigatório", "SESSION_ID_REQUIRED", "Session ID é obrigatório")
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
 		return
 	}
 
-	var req ChatPresenceRequest
+	var req message.ChatPresenceRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to decode chat presence request")
 		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
 		return
 	}
 
-	// TODO: Implementar lógica de presença no chat
-	// Aqui você implementaria a lógica usando o whatsmeow client
-
-	h.logger.WithFields(map[string]interface{}{
-		"session_id": sessionID,
-		"phone":      req.Phone,
-		"state":      req.State,
-	}).Info().Msg("Chat presence request")
-
-	responses.Success200(w, "Operação realizada com sucesso", map[string]interface{}{
-		"success": true,
-		"message": "Presença no chat definida com sucesso",
-		"data": map[string]interface{}{
-			"session_id": sessionID,
-			"phone":      req.Phone,
-			"state":      req.State,
-		},
-	})
+	response, err := h.chatPresenceUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao definir presença no chat")
+		return
+	}
+
+	responses.Success200(w, "Presença no chat definida com sucesso", response)
 }
 
 // MarkAsRead marca mensagens como lidas
 // @Summary Marcar mensagens como lidas
-// @Description Marca uma ou mais mensagens como lidas em um chat específico
+// @Description Marca uma ou mais mensagens como lidas em um contato ou grupo
+// @Description
+// @Description Em grupos, informe `sender` com o autor das mensagens; se omitido, o autor de cada mensagem é obtido do histórico
 // @Tags Chat
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão (UUID)"
-// @Param request body object true "Dados das mensagens para marcar como lidas"
-// @Success 200 {object} responses.SuccessResponse "Mensagens marcadas como lidas com sucesso"
+// @Param request body message.MarkReadRequest true "Dados das mensagens para marcar como lidas"
+// @Success 200 {object} responses.SuccessResponse{data=message.MarkReadResponse} "Mensagens marcadas como lidas com sucesso"
 // @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
 // @Router /chat/{sessionID}/markread [post]
 func (h *ChatHandler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
-	sessionID := chi.URLParam(r, "sessionID")
-	if sessionID == "" {
-		responses.Error400(w, "Session ID é obrigatório", "SESSION_ID_REQUIRED", "Session ID é obrigatório")
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
 		return
 	}
 
-	var req MarkReadRequest
+	var req message.MarkReadRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to decode mark read request")
 		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
 		return
 	}
 
-	// TODO: Implementar lógica de marcar como lida
-	// Aqui você implementaria a lógica usando o whatsmeow client
-
-	h.logger.WithFields(map[string]interface{}{
-		"session_id":  sessionID,
-		"message_ids": req.MessageIDs,
-		"chat":        req.Chat.String(),
-	}).Info().Msg("Mark messages as read request")
-
-	responses.Success200(w, "Operação realizada com sucesso", map[string]interface{}{
-		"success": true,
-		"message": "Mensagens marcadas como lidas com sucesso",
-		"data": map[string]interface{}{
-			"session_id":  sessionID,
-			"message_ids": req.MessageIDs,
-			"chat":        req.Chat.String(),
-		},
-	})
+	response, err := h.markReadUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao marcar mensagens como lidas")
+		return
+	}
+
+	responses.Success200(w, "Mensagens marcadas como lidas com sucesso", response)
 }
 
-// DownloadImage faz download de uma imagem
-// @Summary Download de imagem
-// @Description Faz download de uma imagem de uma mensagem específica
+// ListChats lista os chats da sessão
+// @Summary Listar chats
+// @Description Lista os chats da sessão: fixados primeiro, depois pela mensagem mais recente.
+// @Description A lista é mantida a partir das mensagens, da sincronização de histórico e dos eventos de app state
+// @Description (arquivar, fixar, silenciar, limpar e apagar) vindos de qualquer dispositivo da conta.
+// @Tags Chat
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param archived query bool false "true apenas arquivados, false apenas não arquivados"
+// @Param unread query bool false "Apenas chats com mensagens não lidas ou marcados como não lidos"
+// @Param type query string false "Tipo do chat (group, individual)"
+// @Param search query string false "Busca no nome ou no JID do chat"
+// @Param limit query int false "Quantidade máxima de chats (padrão 50, máximo 200)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} responses.SuccessResponse{data=chat.ChatListResponse} "Lista de chats"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/list [get]
+func (h *ChatHandler) ListChats(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	req := chat.ListChatsRequest{
+		Type:   query.Get("type"),
+		Search: query.Get("search"),
+	}
+	if value := query.Get("archived"); value != "" {
+		archived, err := strconv.ParseBool(value)
+		if err != nil {
+			responses.Error400(w, "Parâmetro archived inválido", "INVALID_REQUEST", err.Error())
+			return
+		}
+		req.Archived = &archived
+	}
+	if value := query.Get("unread"); value != "" {
+		if req.Unread, err = strconv.ParseBool(value); err != nil {
+			responses.Error400(w, "Parâmetro unread inválido", "INVALID_REQUEST", err.Error())
+			return
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			responses.Error400(w, "Parâmetro limit inválido", "INVALID_REQUEST", err.Error())
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			responses.Error400(w, "Parâmetro offset inválido", "INVALID_REQUEST", err.Error())
+			return
+		}
+	}
+
+	response, err := h.listChatsUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao listar chats")
+		return
+	}
+
+	responses.Success200(w, "Chats listados com sucesso", response)
+}
+
+// ArchiveChat arquiva ou desarquiva um contato ou grupo em todos os dispositivos da conta
+// @Summary Arquivar chat
+// @Description Arquiva ou desarquiva um contato ou grupo em todos os dispositivos da conta
+// @Description
+// @Description Arquivar também desafixa o chat, como no aplicativo
 // @Tags Chat
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão (UUID)"
-// @Param request body object true "Dados para download da imagem"
-// @Success 200 {object} responses.SuccessResponse "Download iniciado com sucesso"
+// @Param request body chat.ArchiveChatRequest true "Chat e operação"
+// @Success 200 {object} responses.SuccessResponse{data=chat.ChatActionResponse} "Chat atualizado com sucesso"
 // @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
-// @Router /chat/{sessionID}/downloadimage [post]
-func (h *ChatHandler) DownloadImage(w http.ResponseWriter, r *http.Request) {
-	sessionID := chi.URLParam(r, "sessionID")
-	if sessionID == "" {
-		responses.Error400(w, "Session ID é obrigatório", "SESSION_ID_REQUIRED", "Session ID é obrigatório")
+// @Router /chat/{sessionID}/archive [post]
+func (h *ChatHandler) ArchiveChat(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
 		return
 	}
 
-	var req DownloadMediaRequest
+	var req chat.ArchiveChatRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.WithError(err).Error().Msg("Failed to decode download image request")
+		h.logger.WithError(err).Error().Msg("Failed to decode archive chat request")
 		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
 		return
 	}
 
-	// TODO: Implementar lógica de download de imagem
-	// Aqui você implementaria a lógica usando o whatsmeow client
-
-	h.logger.WithFields(map[string]interface{}{
-		"session_id": sessionID,
-		"message_id": req.MessageID,
-		"phone":      req.Phone,
-	}).Info().Msg("Download image request")
-
-	responses.Success200(w, "Operação realizada com sucesso", map[string]interface{}{
-		"success": true,
-		"message": "Download de imagem iniciado",
-		"data": map[string]interface{}{
-			"session_id": sessionID,
-			"message_id": req.MessageID,
-			"phone":      req.Phone,
-			"type":       "image",
-		},
-	})
+	response, err := h.archiveChatUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao arquivar chat")
+		return
+	}
+
+	responses.Success200(w, "Chat atualizado com sucesso", response)
 }
 
-// DownloadVideo faz download de um vídeo
-// @Summary Download de vídeo
-// @Description Faz download de um vídeo de uma mensagem específica
+// PinChat fixa ou desafixa um contato ou grupo em todos os dispositivos da conta
+// @Summary Fixar chat
+// @Description Fixa ou desafixa um contato ou grupo em todos os dispositivos da conta
 // @Tags Chat
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão (UUID)"
-// @Param request body object true "Dados para download do vídeo"
-// @Success 200 {object} responses.SuccessResponse "Download iniciado com sucesso"
+// @Param request body chat.PinChatRequest true "Chat e operação"
+// @Success 200 {object} responses.SuccessResponse{data=chat.ChatActionResponse} "Chat atualizado com sucesso"
 // @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
-// @Router /chat/{sessionID}/downloadvideo [post]
-func (h *ChatHandler) DownloadVideo(w http.ResponseWriter, r *http.Request) {
-	sessionID := chi.URLParam(r, "sessionID")
-	if sessionID == "" {
-		responses.Error400(w, "Session ID é obrigatório", "SESSION_ID_REQUIRED", "Session ID é obrigatório")
+// @Router /chat/{sessionID}/pin [post]
+func (h *ChatHandler) PinChat(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
 		return
 	}
 
-	var req DownloadMediaRequest
+	var req chat.PinChatRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.WithError(err).Error().Msg("Failed to decode download video request")
+		h.logger.WithError(err).Error().Msg("Failed to decode pin chat request")
 		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
 		return
 	}
 
-	// TODO: Implementar lógica de download de vídeo
-	// Aqui você implementaria a lógica usando o whatsmeow client
-
-	h.logger.WithFields(map[string]interface{}{
-		"session_id": sessionID,
-		"message_id": req.MessageID,
-		"phone":      req.Phone,
-	}).Info().Msg("Download video request")
-
-	responses.Success200(w, "Operação realizada com sucesso", map[string]interface{}{
-		"success": true,
-		"message": "Download de vídeo iniciado",
-		"data": map[string]interface{}{
-			"session_id": sessionID,
-			"message_id": req.MessageID,
-			"phone":      req.Phone,
-			"type":       "video",
-		},
-	})
+	response, err := h.pinChatUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao fixar chat")
+		return
+	}
+
+	responses.Success200(w, "Chat atualizado com sucesso", response)
 }
 
-// DownloadAudio faz download de um áudio
-// @Summary Download de áudio
-// @Description Faz download de um áudio de uma mensagem específica
+// MuteChat silencia ou reativa as notificações de um contato ou grupo em todos os dispositivos da conta
+// @Summary Silenciar chat
+// @Description Silencia ou reativa as notificações de um contato ou grupo em todos os dispositivos da conta
+// @Description
+// @Description `durationSeconds` define por quanto tempo o chat fica silenciado; 0 silencia para sempre
 // @Tags Chat
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão (UUID)"
-// @Param request body object true "Dados para download do áudio"
-// @Success 200 {object} responses.SuccessResponse "Download iniciado com sucesso"
+// @Param request body chat.MuteChatRequest true "Chat e operação"
+// @Success 200 {object} responses.SuccessResponse{data=chat.ChatActionResponse} "Chat atualizado com sucesso"
 // @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
-// @Router /chat/{sessionID}/downloadaudio [post]
-func (h *ChatHandler) DownloadAudio(w http.ResponseWriter, r *http.Request) {
-	sessionID := chi.URLParam(r, "sessionID")
-	if sessionID == "" {
-		responses.Error400(w, "Session ID é obrigatório", "SESSION_ID_REQUIRED", "Session ID é obrigatório")
+// @Router /chat/{sessionID}/mute [post]
+func (h *ChatHandler) MuteChat(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	var req chat.MuteChatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode mute chat request")
+		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	response, err := h.muteChatUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao silenciar chat")
+		return
+	}
+
+	responses.Success200(w, "Chat atualizado com sucesso", response)
+}
+
+// MarkChatUnread marca um contato ou grupo como não lido, ou novamente como lido, em todos os dispositivos da conta
+// @Summary Marcar chat como não lido
+// @Description Marca um contato ou grupo como não lido, ou novamente como lido, em todos os dispositivos da conta
+// @Tags Chat
+// @Accept json
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param request body chat.MarkChatUnreadRequest true "Chat e operação"
+// @Success 200 {object} responses.SuccessResponse{data=chat.ChatActionResponse} "Chat atualizado com sucesso"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/unread [post]
+func (h *ChatHandler) MarkChatUnread(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
 		return
 	}
 
-	var req DownloadMediaRequest
+	var req chat.MarkChatUnreadRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.WithError(err).Error().Msg("Failed to decode download audio request")
+		h.logger.WithError(err).Error().Msg("Failed to decode mark chat unread request")
 		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
 		return
 	}
 
-	// TODO: Implementar lógica de download de áudio
-	// Aqui você implementaria a lógica usando o whatsmeow client
-
-	h.logger.WithFields(map[string]interface{}{
-		"session_id": sessionID,
-		"message_id": req.MessageID,
-		"phone":      req.Phone,
-	}).Info().Msg("Download audio request")
-
-	responses.Success200(w, "Operação realizada com sucesso", map[string]interface{}{
-		"success": true,
-		"message": "Download de áudio iniciado",
-		"data": map[string]interface{}{
-			"session_id": sessionID,
-			"message_id": req.MessageID,
-			"phone":      req.Phone,
-			"type":       "audio",
-		},
-	})
+	response, err := h.markUnreadUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao marcar chat como não lido")
+		return
+	}
+
+	responses.Success200(w, "Chat atualizado com sucesso", response)
+}
+
+// ClearChat apaga as mensagens de um contato ou grupo em todos os dispositivos da conta e no histórico, mantendo o chat na lista
+// @Summary Limpar chat
+// @Description Apaga as mensagens de um contato ou grupo em todos os dispositivos da conta e no histórico, mantendo o chat na lista
+// @Tags Chat
+// @Accept json
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param request body chat.ChatTargetRequest true "Chat e operação"
+// @Success 200 {object} responses.SuccessResponse{data=chat.ChatActionResponse} "Chat limpo com sucesso"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/clear [post]
+func (h *ChatHandler) ClearChat(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	var req chat.ChatTargetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode clear chat request")
+		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	response, err := h.clearChatUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao limpar chat")
+		return
+	}
+
+	responses.Success200(w, "Chat limpo com sucesso", response)
+}
+
+// DeleteChat apaga um contato ou grupo e suas mensagens em todos os dispositivos da conta, no histórico e na lista de chats
+// @Summary Apagar chat
+// @Description Apaga um contato ou grupo e suas mensagens em todos os dispositivos da conta, no histórico e na lista de chats
+// @Tags Chat
+// @Accept json
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param request body chat.ChatTargetRequest true "Chat e operação"
+// @Success 200 {object} responses.SuccessResponse{data=chat.ChatActionResponse} "Chat apagado com sucesso"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/delete [post]
+func (h *ChatHandler) DeleteChat(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	var req chat.ChatTargetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode delete chat request")
+		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	response, err := h.deleteChatUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao apagar chat")
+		return
+	}
+
+	responses.Success200(w, "Chat apagado com sucesso", response)
+}
+
+// writeChatError converte os erros dos casos de uso de chat na resposta HTTP correspondente
+func (h *ChatHandler) writeChatError(w http.ResponseWriter, err error, failureMessage string) {
+	switch {
+	case errors.Is(err, message.ErrInvalidDestination),
+		errors.Is(err, message.ErrInvalidPresenceState),
+		errors.Is(err, message.ErrMessageIDsRequired),
+		errors.Is(err, message.ErrSenderRequired),
+		errors.Is(err, message.ErrInvalidMediaInfo),
+		errors.Is(err, message.ErrMessageHasNoMedia),
+		errors.Is(err, message.ErrMediaTypeMismatch),
+		errors.Is(err, chat.ErrInvalidChatType),
+		errors.Is(err, chat.ErrInvalidMuteDuration):
+		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.Error404(w, "Sessão não encontrada", "SESSION_NOT_FOUND", err.Error())
+	case errors.Is(err, message.ErrMessageNotFound):
+		responses.Error404(w, "Mensagem não encontrada", "MESSAGE_NOT_FOUND", err.Error())
+	case errors.Is(err, message.ErrMediaExpired):
+		responses.WriteJSON(w, http.StatusGone, false, "Mídia não está mais disponível", nil, &responses.APIError{
+			Code:    "MEDIA_EXPIRED",
+			Details: err.Error(),
+		})
+	case errors.Is(err, domainSession.ErrSessionNotConnected):
+		responses.Error409(w, "Sessão não está conectada", "SESSION_NOT_CONNECTED", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg("Chat operation failed")
+		responses.Error500(w, failureMessage, "INTERNAL_ERROR", err.Error())
+	}
+}
+
+// DownloadImage faz download de uma imagem
+// @Summary Download de imagem
+// @Description Baixa e descriptografa uma imagem a partir dos campos do bloco `media` recebido no webhook
+// @Description (directPath, mediaKey, fileSha256, fileEncSha256, mimeType, fileLength) ou apenas do `messageId` de uma mensagem do histórico.
+// @Description Retorna o binário com o Content-Type da mídia; com `format=base64` retorna um data URL em JSON.
+// @Tags Chat
+// @Accept json
+// @Produce octet-stream,json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param format query string false "Formato da resposta: binary (padrão) ou base64"
+// @Param request body message.DownloadMediaRequest true "Metadados da mídia ou ID da mensagem"
+// @Success 200 {file} binary "Conteúdo da mídia"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão ou mensagem não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 410 {object} responses.ErrorResponse "Mídia expirada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/downloadimage [post]
+func (h *ChatHandler) DownloadImage(w http.ResponseWriter, r *http.Request) {
+	h.downloadMedia(w, r, message.InboundTypeImage)
+}
+
+// DownloadVideo faz download de um vídeo
+// @Summary Download de vídeo
+// @Description Baixa e descriptografa um vídeo a partir dos campos do bloco `media` recebido no webhook
+// @Description (directPath, mediaKey, fileSha256, fileEncSha256, mimeType, fileLength) ou apenas do `messageId` de uma mensagem do histórico.
+// @Description Retorna o binário com o Content-Type da mídia; com `format=base64` retorna um data URL em JSON.
+// @Tags Chat
+// @Accept json
+// @Produce octet-stream,json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param format query string false "Formato da resposta: binary (padrão) ou base64"
+// @Param request body message.DownloadMediaRequest true "Metadados da mídia ou ID da mensagem"
+// @Success 200 {file} binary "Conteúdo da mídia"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão ou mensagem não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 410 {object} responses.ErrorResponse "Mídia expirada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/downloadvideo [post]
+func (h *ChatHandler) DownloadVideo(w http.ResponseWriter, r *http.Request) {
+	h.downloadMedia(w, r, message.InboundTypeVideo)
+}
+
+// DownloadAudio faz download de um áudio
+// @Summary Download de áudio
+// @Description Baixa e descriptografa um áudio a partir dos campos do bloco `media` recebido no webhook
+// @Description (directPath, mediaKey, fileSha256, fileEncSha256, mimeType, fileLength) ou apenas do `messageId` de uma mensagem do histórico.
+// @Description Retorna o binário com o Content-Type da mídia; com `format=base64` retorna um data URL em JSON.
+// @Tags Chat
+// @Accept json
+// @Produce octet-stream,json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param format query string false "Formato da resposta: binary (padrão) ou base64"
+// @Param request body message.DownloadMediaRequest true "Metadados da mídia ou ID da mensagem"
+// @Success 200 {file} binary "Conteúdo da mídia"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão ou mensagem não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 410 {object} responses.ErrorResponse "Mídia expirada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/downloadaudio [post]
+func (h *ChatHandler) DownloadAudio(w http.ResponseWriter, r *http.Request) {
+	h.downloadMedia(w, r, message.InboundTypeAudio)
 }
 
 // DownloadDocument faz download de um documento
 // @Summary Download de documento
-// @Description Faz download de um documento de uma mensagem específica
+// @Description Baixa e descriptografa um documento a partir dos campos do bloco `media` recebido no webhook
+// @Description (directPath, mediaKey, fileSha256, fileEncSha256, mimeType, fileLength) ou apenas do `messageId` de uma mensagem do histórico.
+// @Description Retorna o binário com o Content-Type da mídia; com `format=base64` retorna um data URL em JSON.
 // @Tags Chat
 // @Accept json
-// @Produce json
+// @Produce octet-stream,json
 // @Param sessionID path string true "ID da sessão (UUID)"
-// @Param request body object true "Dados para download do documento"
-// @Success 200 {object} responses.SuccessResponse "Download iniciado com sucesso"
+// @Param format query string false "Formato da resposta: binary (padrão) ou base64"
+// @Param request body message.DownloadMediaRequest true "Metadados da mídia ou ID da mensagem"
+// @Success 200 {file} binary "Conteúdo da mídia"
 // @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão ou mensagem não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 410 {object} responses.ErrorResponse "Mídia expirada"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
 // @Router /chat/{sessionID}/downloaddocument [post]
 func (h *ChatHandler) DownloadDocument(w http.ResponseWriter, r *http.Request) {
-	sessionID := chi.URLParam(r, "sessionID")
-	if sessionID == "" {
-		responses.Error400(w, "Session ID é obrigatório", "SESSION_ID_REQUIRED", "Session ID é obrigatório")
+	h.downloadMedia(w, r, message.InboundTypeDocument)
+}
+
+// DownloadMessageMedia faz download da mídia de uma mensagem do histórico
+// @Summary Download de mídia por ID da mensagem
+// @Description Baixa e descriptografa a mídia de uma mensagem gravada no histórico, de qualquer tipo
+// @Tags Chat
+// @Produce octet-stream,json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param messageID path string true "ID da mensagem"
+// @Param format query string false "Formato da resposta: binary (padrão) ou base64"
+// @Success 200 {file} binary "Conteúdo da mídia"
+// @Failure 400 {object} responses.ErrorResponse "Mensagem sem mídia"
+// @Failure 404 {object} responses.ErrorResponse "Sessão ou mensagem não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 410 {object} responses.ErrorResponse "Mídia expirada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /chat/{sessionID}/download/{messageID} [get]
+func (h *ChatHandler) DownloadMessageMedia(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
 		return
 	}
 
-	var req DownloadMediaRequest
+	req := message.DownloadMediaRequest{MessageID: chi.URLParam(r, "messageID")}
+	h.writeMedia(w, r, sessionID, "", req)
+}
+
+// downloadMedia decodifica a requisição de download e escreve a mídia do tipo informado
+func (h *ChatHandler) downloadMedia(w http.ResponseWriter, r *http.Request, mediaType message.InboundMessageType) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	var req message.DownloadMediaRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.WithError(err).Error().Msg("Failed to decode download document request")
+		h.logger.WithError(err).Error().Msg("Failed to decode download media request")
 		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
 		return
 	}
 
-	// TODO: Implementar lógica de download de documento
-	// Aqui você implementaria a lógica usando o whatsmeow client
-
-	h.logger.WithFields(map[string]interface{}{
-		"session_id": sessionID,
-		"message_id": req.MessageID,
-		"phone":      req.Phone,
-	}).Info().Msg("Download document request")
-
-	responses.Success200(w, "Operação realizada com sucesso", map[string]interface{}{
-		"success": true,
-		"message": "Download de documento iniciado",
-		"data": map[string]interface{}{
-			"session_id": sessionID,
-			"message_id": req.MessageID,
-			"phone":      req.Phone,
-			"type":       "document",
-		},
-	})
+	h.writeMedia(w, r, sessionID, mediaType, req)
+}
+
+// writeMedia baixa a mídia e a envia como binário ou, com format=base64, como data URL
+func (h *ChatHandler) writeMedia(w http.ResponseWriter, r *http.Request, sessionID uuid.UUID, mediaType message.InboundMessageType, req message.DownloadMediaRequest) {
+	content, err := h.downloadMediaUseCase.Execute(r.Context(), sessionID, mediaType, req)
+	if err != nil {
+		h.writeChatError(w, err, "Falha ao baixar mídia")
+		return
+	}
+	defer content.Data.Close()
+
+	mimeType := content.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	if r.URL.Query().Get("format") == "base64" {
+		data, err := io.ReadAll(content.Data)
+		if err != nil {
+			h.writeChatError(w, err, "Falha ao ler mídia")
+			return
+		}
+		responses.Success200(w, "Mídia baixada com sucesso", message.MediaDataURLResponse{
+			MimeType: mimeType,
+			FileName: content.FileName,
+			Size:     content.Size,
+			DataURL:  "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(data),
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", mimeType)
+	w.Header().Set("Content-Length", strconv.FormatInt(content.Size, 10))
+	if content.FileName != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": content.FileName}))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, content.Data); err != nil {
+		h.logger.WithError(err).Warn().Msg("Failed to stream media to client")
+	}
 }
@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/contact"
+	domainSession "zmeow/internal/domain/session"
+	"zmeow/internal/http/responses"
+	contactUsecases "zmeow/internal/usecases/contact"
+	"zmeow/pkg/logger"
+)
+
+// ContactHandler gerencia as consultas de contatos
+type ContactHandler struct {
+	logger                    logger.Logger
+	checkContactsUseCase      *contactUsecases.CheckContactsUseCase
+	listContactsUseCase       *contactUsecases.ListContactsUseCase
+	getContactUseCase         *contactUsecases.GetContactUseCase
+	getProfilePictureUseCase  *contactUsecases.GetProfilePictureUseCase
+	getBusinessProfileUseCase *contactUsecases.GetBusinessProfileUseCase
+}
+
+// NewContactHandler cria uma nova instância do ContactHandler
+func NewContactHandler(
+	checkContactsUseCase *contactUsecases.CheckContactsUseCase,
+	listContactsUseCase *contactUsecases.ListContactsUseCase,
+	getContactUseCase *contactUsecases.GetContactUseCase,
+	getProfilePictureUseCase *contactUsecases.GetProfilePictureUseCase,
+	getBusinessProfileUseCase *contactUsecases.GetBusinessProfileUseCase,
+) *ContactHandler {
+	return &ContactHandler{
+		logger:                    logger.WithComponent("contact-handler"),
+		checkContactsUseCase:      checkContactsUseCase,
+		listContactsUseCase:       listContactsUseCase,
+		getContactUseCase:         getContactUseCase,
+		getProfilePictureUseCase:  getProfilePictureUseCase,
+		getBusinessProfileUseCase: getBusinessProfileUseCase,
+	}
+}
+
+// CheckContacts verifica se números têm conta no WhatsApp
+// @Summary Verificar números no WhatsApp
+// @Description Verifica em lote (até 100 números) se cada número tem conta no WhatsApp, retornando o JID canônico
+// @Description e se a conta é comercial. Use o `jid` retornado no envio: ele pode diferir do número informado.
+// @Description Os resultados ficam em cache por `CONTACTS_CACHE_TTL`.
+// @Tags Contatos
+// @Accept json
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param request body contact.CheckContactsRequest true "Números a verificar"
+// @Success 200 {object} responses.SuccessResponse{data=contact.CheckContactsResponse} "Resultado da verificação"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /contacts/{sessionID}/check [post]
+func (h *ContactHandler) CheckContacts(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	var req contact.CheckContactsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode check contacts request")
+		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	response, err := h.checkContactsUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeContactError(w, err, "Falha ao verificar números")
+		return
+	}
+
+	responses.Success200(w, "Números verificados com sucesso", response)
+}
+
+// ListContacts lista os contatos sincronizados da sessão
+// @Summary Listar contatos
+// @Description Lista os contatos conhecidos pela sessão, ordenados pelo nome. Os nomes vêm da agenda sincronizada do aparelho,
+// @Description do nome público de cada contato e do nome comercial verificado, recebidos nos eventos e na importação do histórico.
+// @Tags Contatos
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param search query string false "Busca nos nomes e no JID do contato"
+// @Param limit query int false "Quantidade máxima de contatos (padrão 100, máximo 500)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} responses.SuccessResponse{data=contact.ContactListResponse} "Lista de contatos"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /contacts/{sessionID}/list [get]
+func (h *ContactHandler) ListContacts(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	req := contact.ListContactsRequest{Search: query.Get("search")}
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			responses.Error400(w, "Parâmetro limit inválido", "INVALID_REQUEST", err.Error())
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			responses.Error400(w, "Parâmetro offset inválido", "INVALID_REQUEST", err.Error())
+			return
+		}
+	}
+
+	response, err := h.listContactsUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeContactError(w, err, "Falha ao listar contatos")
+		return
+	}
+
+	responses.Success200(w, "Contatos listados com sucesso", response)
+}
+
+// GetContact consulta as informações de um contato
+// @Summary Informações do contato
+// @Description Retorna o recado (about), o ID da foto atual, o nome comercial verificado e os dispositivos do contato,
+// @Description junto com os nomes sincronizados na sessão. As consultas ao WhatsApp ficam em cache por `CONTACTS_CACHE_TTL`.
+// @Tags Contatos
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param number query string true "Número ou JID do contato" example("5511999999999")
+// @Success 200 {object} responses.SuccessResponse{data=contact.ContactInfoResponse} "Informações do contato"
+// @Failure 400 {object} responses.ErrorResponse "Número inválido"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou número sem WhatsApp"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /contacts/{sessionID}/info [get]
+func (h *ContactHandler) GetContact(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	response, err := h.getContactUseCase.Execute(r.Context(), sessionID, r.URL.Query().Get("number"))
+	if err != nil {
+		h.writeContactError(w, err, "Falha ao consultar contato")
+		return
+	}
+
+	responses.Success200(w, "Contato consultado com sucesso", response)
+}
+
+// GetProfilePicture obtém a foto de perfil de um contato
+// @Summary Foto de perfil do contato
+// @Description Retorna a URL temporária da foto de perfil, em miniatura (`preview=true`) ou resolução completa
+// @Tags Contatos
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param number query string true "Número ou JID do contato" example("5511999999999")
+// @Param preview query bool false "true retorna a miniatura"
+// @Success 200 {object} responses.SuccessResponse{data=contact.ProfilePictureResponse} "Foto de perfil"
+// @Failure 400 {object} responses.ErrorResponse "Número inválido"
+// @Failure 403 {object} responses.ErrorResponse "Foto oculta para esta conta"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou contato sem foto"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /contacts/{sessionID}/picture [get]
+func (h *ContactHandler) GetProfilePicture(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	preview := false
+	if value := query.Get("preview"); value != "" {
+		if preview, err = strconv.ParseBool(value); err != nil {
+			responses.Error400(w, "Parâmetro preview inválido", "INVALID_REQUEST", err.Error())
+			return
+		}
+	}
+
+	response, err := h.getProfilePictureUseCase.Execute(r.Context(), sessionID, query.Get("number"), preview)
+	if err != nil {
+		h.writeContactError(w, err, "Falha ao obter foto de perfil")
+		return
+	}
+
+	responses.Success200(w, "Foto de perfil obtida com sucesso", response)
+}
+
+// GetBusinessProfile obtém o perfil comercial de um contato
+// @Summary Perfil comercial do contato
+// @Description Retorna endereço, e-mail, categorias e horário de funcionamento de uma conta comercial
+// @Tags Contatos
+// @Produce json
+// @Param sessionID path string true "ID da sessão (UUID)"
+// @Param number query string true "Número ou JID do contato" example("5511999999999")
+// @Success 200 {object} responses.SuccessResponse{data=contact.BusinessProfileResponse} "Perfil comercial"
+// @Failure 400 {object} responses.ErrorResponse "Número inválido"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou contato não é uma conta comercial"
+// @Failure 409 {object} responses.ErrorResponse "Sessão não conectada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /contacts/{sessionID}/business [get]
+func (h *ContactHandler) GetBusinessProfile(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.Error400(w, "Session ID inválido", "INVALID_SESSION_ID", err.Error())
+		return
+	}
+
+	response, err := h.getBusinessProfileUseCase.Execute(r.Context(), sessionID, r.URL.Query().Get("number"))
+	if err != nil {
+		h.writeContactError(w, err, "Falha ao obter perfil comercial")
+		return
+	}
+
+	responses.Success200(w, "Perfil comercial obtido com sucesso", response)
+}
+
+// writeContactError converte os erros dos casos de uso de contatos na resposta HTTP correspondente
+func (h *ContactHandler) writeContactError(w http.ResponseWriter, err error, failureMessage string) {
+	switch {
+	case errors.Is(err, contact.ErrNumbersRequired),
+		errors.Is(err, contact.ErrTooManyNumbers),
+		errors.Is(err, contact.ErrInvalidNumber):
+		responses.Error400(w, "Dados da requisição inválidos", "INVALID_REQUEST", err.Error())
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.Error404(w, "Sessão não encontrada", "SESSION_NOT_FOUND", err.Error())
+	case errors.Is(err, contact.ErrNotOnWhatsApp):
+		responses.Error404(w, "Número não está no WhatsApp", "NOT_ON_WHATSAPP", err.Error())
+	case errors.Is(err, contact.ErrNotBusiness):
+		responses.Error404(w, "Contato não é uma conta comercial", "NOT_BUSINESS", err.Error())
+	case errors.Is(err, contact.ErrProfilePictureNotSet):
+		responses.Error404(w, "Contato não tem foto de perfil", "PROFILE_PICTURE_NOT_SET", err.Error())
+	case errors.Is(err, contact.ErrProfilePictureHidden):
+		responses.WriteJSON(w, http.StatusForbidden, false, "Foto de perfil oculta para esta conta", nil, &responses.APIError{
+			Code:    "PROFILE_PICTURE_HIDDEN",
+			Details: err.Error(),
+		})
+	case errors.Is(err, domainSession.ErrSessionNotConnected):
+		responses.Error409(w, "Sessão não está conectada", "SESSION_NOT_CONNECTED", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg("Contact operation failed")
+		responses.Error500(w, failureMessage, "INTERNAL_ERROR", err.Error())
+	}
+}
@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	domainSession "zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/http/responses"
+	"zmeow/internal/usecases/session"
+	"zmeow/pkg/logger"
+)
+
+// streamWriteTimeout limita o tempo de escrita de cada mensagem nos streams
+const streamWriteTimeout = 10 * time.Second
+
+// EventsHandler implementa os streams de eventos ao vivo das sessões (WebSocket e SSE)
+type EventsHandler struct {
+	subscribeUseCase  *session.SubscribeEventsUseCase
+	heartbeatInterval time.Duration
+	allowedOrigins    map[string]bool
+	upgrader          websocket.Upgrader
+	logger            logger.Logger
+}
+
+// NewEventsHandler cria uma nova instância do events handler.
+// allowedOrigins lista as origens de navegador aceitas além da própria origem; "*" libera todas.
+func NewEventsHandler(
+	subscribeUseCase *session.SubscribeEventsUseCase,
+	heartbeatInterval time.Duration,
+	allowedOrigins []string,
+	logger logger.Logger,
+) *EventsHandler {
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = 25 * time.Second
+	}
+
+	h := &EventsHandler{
+		subscribeUseCase:  subscribeUseCase,
+		heartbeatInterval: heartbeatInterval,
+		allowedOrigins:    make(map[string]bool, len(allowedOrigins)),
+		logger:            logger.WithComponent("events-handler"),
+	}
+	for _, origin := range allowedOrigins {
+		h.allowedOrigins[strings.ToLower(strings.TrimRight(origin, "/"))] = true
+	}
+	h.upgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 4096,
+		CheckOrigin:     h.checkOrigin,
+	}
+
+	return h
+}
+
+// StreamWebSocket transmite os eventos da sessão por WebSocket
+// @Summary      Stream de eventos (WebSocket)
+// @Description  Cada mensagem é um evento em JSON ({id, type, sessionId, timestamp, data}). Heartbeats são enviados como ping. Use lastEventId para retomar após uma reconexão.
+// @Tags         events
+// @Security     ApiKeyAuth
+// @Param        sessionID    path   string  true   "ID da sessão (UUID)"
+// @Param        types        query  string  false  "Tipos de evento separados por vírgula (ex.: message,message.status)"
+// @Param        lastEventId  query  int     false  "ID do último evento recebido"
+// @Param        apiKey       query  string  false  "Chave de API, para clientes que não enviam headers"
+// @Success      101  "Switching Protocols"
+// @Failure      400  {object}  responses.ErrorResponse  "Parâmetros inválidos"
+// @Failure      403  {object}  responses.ErrorResponse  "Origem não permitida"
+// @Failure      404  {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Router       /sessions/{sessionID}/events/ws [get]
+func (h *EventsHandler) StreamWebSocket(w http.ResponseWriter, r *http.Request) {
+	if !h.checkOrigin(r) {
+		responses.Forbidden(w, "Origin not allowed", "Configure EVENTS_ALLOWED_ORIGINS to accept this origin")
+		return
+	}
+
+	subscription, ok := h.subscribe(w, r, r.URL.Query().Get("lastEventId"))
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// O upgrader já respondeu com o erro HTTP
+		h.logger.WithError(err).Warn().Msg("Failed to upgrade event stream to WebSocket")
+		return
+	}
+	defer conn.Close()
+
+	// Leitura apenas para processar pongs e detectar o fechamento pelo cliente
+	closed := make(chan struct{})
+	conn.SetReadLimit(1024)
+	conn.SetReadDeadline(time.Now().Add(2 * h.heartbeatInterval))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(2 * h.heartbeatInterval))
+	})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	var lastID uint64
+	send := func(event whatsapp.Event) error {
+		if event.ID <= lastID {
+			return nil
+		}
+		conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		if err := conn.WriteJSON(event); err != nil {
+			return err
+		}
+		lastID = event.ID
+		return nil
+	}
+
+	for _, event := range subscription.Backlog {
+		if err := send(event); err != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-subscription.Lagged:
+			// O cliente deve reconectar informando lastEventId para recuperar os eventos perdidos
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "event stream lagging behind"),
+				time.Now().Add(streamWriteTimeout))
+			return
+		case event := <-subscription.Events:
+			if err := send(event); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// StreamSSE transmite os eventos da sessão por Server-Sent Events
+// @Summary      Stream de eventos (SSE)
+// @Description  Cada evento é enviado com id, event (tipo) e data (JSON). Heartbeats são enviados como comentários. O header Last-Event-ID retoma o stream após uma reconexão.
+// @Tags         events
+// @Produce      text/event-stream
+// @Security     ApiKeyAuth
+// @Param        sessionID      path    string  true   "ID da sessão (UUID)"
+// @Param        types          query   string  false  "Tipos de evento separados por vírgula (ex.: message,message.status)"
+// @Param        Last-Event-ID  header  int     false  "ID do último evento recebido"
+// @Param        lastEventId    query   int     false  "ID do último evento recebido (alternativa ao header)"
+// @Param        apiKey         query   string  false  "Chave de API, para clientes que não enviam headers"
+// @Success      200  {string}  string  "Stream de eventos"
+// @Failure      400  {object}  responses.ErrorResponse  "Parâmetros inválidos"
+// @Failure      403  {object}  responses.ErrorResponse  "Origem não permitida"
+// @Failure      404  {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Router       /sessions/{sessionID}/events/sse [get]
+func (h *EventsHandler) StreamSSE(w http.ResponseWriter, r *http.Request) {
+	if !h.checkOrigin(r) {
+		responses.Forbidden(w, "Origin not allowed", "Configure EVENTS_ALLOWED_ORIGINS to accept this origin")
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	subscription, ok := h.subscribe(w, r, lastEventID)
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	// O stream não está sujeito ao WriteTimeout do servidor
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	var lastID uint64
+	send := func(event whatsapp.Event) error {
+		if event.ID <= lastID {
+			return nil
+		}
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+			return err
+		}
+		lastID = event.ID
+		return rc.Flush()
+	}
+
+	fmt.Fprintf(w, "retry: %d\n\n", 3000)
+	for _, event := range subscription.Backlog {
+		if err := send(event); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-subscription.Lagged:
+			// Encerrar o stream: o EventSource reconecta com Last-Event-ID e recupera os eventos perdidos
+			return
+		case event := <-subscription.Events:
+			if err := send(event); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// subscribe valida os parâmetros do stream e inscreve a requisição nos eventos da sessão
+func (h *EventsHandler) subscribe(w http.ResponseWriter, r *http.Request, lastEventID string) (*session.EventSubscription, bool) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return nil, false
+	}
+
+	req := session.SubscribeEventsRequest{}
+	for _, t := range strings.Split(r.URL.Query().Get("types"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			req.Types = append(req.Types, whatsapp.EventType(t))
+		}
+	}
+	if lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			responses.BadRequest(w, "Invalid last event ID", err.Error())
+			return nil, false
+		}
+		req.LastEventID = &id
+	}
+
+	subscription, err := h.subscribeUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainSession.ErrSessionNotFound):
+			responses.NotFound(w, "Session not found")
+		case errors.Is(err, whatsapp.ErrInvalidEventType):
+			responses.BadRequest(w, "Invalid event type", err.Error())
+		default:
+			h.logger.WithError(err).Error().Msg("Failed to subscribe to session events")
+			responses.InternalError(w, "Failed to subscribe to session events")
+		}
+		return nil, false
+	}
+
+	return subscription, true
+}
+
+// checkOrigin aceita clientes sem Origin (fora do navegador), a própria origem do zmeow e as origens configuradas.
+// Como os streams aceitam a chave de API na query string, uma página de outra origem não pode abri-los.
+func (h *EventsHandler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || h.allowedOrigins["*"] {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	return h.allowedOrigins[strings.ToLower(strings.TrimRight(origin, "/"))]
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"zmeow/pkg/logger"
+)
+
+func TestEventsHandlerCheckOrigin(t *testing.T) {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+
+	tests := []struct {
+		name    string
+		allowed []string
+		origin  string
+		want    bool
+	}{
+		{name: "no origin header", origin: "", want: true},
+		{name: "same origin", origin: "http://zmeow.test:8080", want: true},
+		{name: "same origin with another scheme", origin: "https://ZMEOW.test:8080", want: true},
+		{name: "foreign origin", origin: "https://evil.example", want: false},
+		{name: "configured origin", allowed: []string{"https://app.example/"}, origin: "https://app.example", want: true},
+		{name: "configured origin is case insensitive", allowed: []string{"https://App.Example"}, origin: "https://app.example", want: true},
+		{name: "origin not in the configured list", allowed: []string{"https://app.example"}, origin: "https://evil.example", want: false},
+		{name: "wildcard", allowed: []string{"*"}, origin: "https://evil.example", want: true},
+		{name: "malformed origin", origin: "://", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewEventsHandler(nil, 0, tt.allowed, log)
+
+			r := httptest.NewRequest(http.MethodGet, "http://zmeow.test:8080/sessions/x/events/ws", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+
+			if got := h.checkOrigin(r); got != tt.want {
+				t.Fatalf("checkOrigin(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamSSERejectsForeignOrigin(t *testing.T) {
+	nop := zerolog.Nop()
+	h := NewEventsHandler(nil, 0, nil, logger.NewZerologLogger(&nop))
+
+	r := httptest.NewRequest(http.MethodGet, "http://zmeow.test/sessions/x/events/sse", nil)
+	r.Header.Set("Origin", "https://evil.example")
+	rec := httptest.NewRecorder()
+	h.StreamSSE(rec, r)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("StreamSSE() status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
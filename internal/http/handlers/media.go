@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	domainMedia "zmeow/internal/domain/media"
+	domainSession "zmeow/internal/domain/session"
+	"zmeow/internal/http/responses"
+	"zmeow/internal/usecases/media"
+	"zmeow/pkg/logger"
+)
+
+// MediaHandler implementa os handlers do download automático e das mídias armazenadas
+type MediaHandler struct {
+	setAutoDownloadUseCase *media.SetAutoDownloadUseCase
+	getStoredMediaUseCase  *media.GetStoredMediaUseCase
+	signer                 *domainMedia.URLSigner
+	logger                 logger.Logger
+}
+
+// NewMediaHandler cria uma nova instância do media handler
+func NewMediaHandler(
+	setAutoDownloadUseCase *media.SetAutoDownloadUseCase,
+	getStoredMediaUseCase *media.GetStoredMediaUseCase,
+	signer *domainMedia.URLSigner,
+	logger logger.Logger,
+) *MediaHandler {
+	return &MediaHandler{
+		setAutoDownloadUseCase: setAutoDownloadUseCase,
+		getStoredMediaUseCase:  getStoredMediaUseCase,
+		signer:                 signer,
+		logger:                 logger.WithComponent("media-handler"),
+	}
+}
+
+// EnableAutoDownload habilita o download automático das mídias recebidas
+// @Summary      Habilitar download automático de mídias
+// @Description  As mídias recebidas passam a ser baixadas para o armazenamento configurado; o webhook "message" traz media.storageKey e media.storedUrl
+// @Tags         media
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Success      200        {object}  responses.SuccessResponse{data=domainMedia.AutoDownloadResponse}  "Download automático habilitado"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      409        {object}  responses.ErrorResponse  "Armazenamento de mídias desabilitado"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/media/autodownload/enable [post]
+func (h *MediaHandler) EnableAutoDownload(w http.ResponseWriter, r *http.Request) {
+	h.setAutoDownload(w, r, true)
+}
+
+// DisableAutoDownload desabilita o download automático das mídias recebidas
+// @Summary      Desabilitar download automático de mídias
+// @Description  Interrompe o download automático; as mídias já armazenadas continuam disponíveis
+// @Tags         media
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Success      200        {object}  responses.SuccessResponse{data=domainMedia.AutoDownloadResponse}  "Download automático desabilitado"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/media/autodownload/disable [post]
+func (h *MediaHandler) DisableAutoDownload(w http.ResponseWriter, r *http.Request) {
+	h.setAutoDownload(w, r, false)
+}
+
+// GetObject serve uma mídia armazenada
+// @Summary      Obter mídia armazenada
+// @Description  Retorna uma mídia baixada automaticamente. URLs assinadas (signature e expires) dispensam a chave de API.
+// @Tags         media
+// @Produce      octet-stream
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true   "ID da sessão (UUID)"
+// @Param        object     path      string  true   "Nome do objeto (<sha256>.<extensão>)"
+// @Param        expires    query     string  false  "Expiração da assinatura (unix)"
+// @Param        signature  query     string  false  "Assinatura da URL"
+// @Success      200        {file}    binary  "Conteúdo da mídia"
+// @Failure      400        {object}  responses.ErrorResponse  "Nome de objeto inválido"
+// @Failure      401        {object}  responses.ErrorResponse  "Chave de API ausente ou inválida"
+// @Failure      404        {object}  responses.ErrorResponse  "Mídia não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /media/{sessionID}/{object} [get]
+func (h *MediaHandler) GetObject(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	data, info, err := h.getStoredMediaUseCase.Execute(r.Context(), sessionID, chi.URLParam(r, "object"))
+	if err != nil {
+		h.handleError(w, err, "Failed to get stored media")
+		return
+	}
+	defer data.Close()
+
+	w.Header().Set("Content-Type", info.ContentType)
+	if info.Size >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	}
+	// O conteúdo é endereçado pelo hash: a mesma URL sempre retorna os mesmos bytes
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, data); err != nil {
+		h.logger.WithError(err).Warn().Msg("Failed to stream stored media")
+	}
+}
+
+// HasValidSignature verifica se a requisição de mídia traz uma assinatura válida, dispensando a chave de API.
+// A assinatura é conferida contra a mesma chave que GetObject lê do armazenamento, de modo que uma URL assinada
+// só libera aquele objeto daquela sessão.
+func (h *MediaHandler) HasValidSignature(r *http.Request) bool {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		return false
+	}
+	key, err := domainMedia.ParseObjectKey(sessionID, chi.URLParam(r, "object"))
+	if err != nil {
+		return false
+	}
+
+	query := r.URL.Query()
+	return h.signer.Verify(key, query.Get("expires"), query.Get("signature")) == nil
+}
+
+// setAutoDownload aplica a configuração de download automático da sessão
+func (h *MediaHandler) setAutoDownload(w http.ResponseWriter, r *http.Request, enabled bool) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.setAutoDownloadUseCase.Execute(r.Context(), sessionID, enabled)
+	if err != nil {
+		h.handleError(w, err, "Failed to update media auto download")
+		return
+	}
+
+	if enabled {
+		responses.Success(w, "Download automático de mídias habilitado", result)
+		return
+	}
+	responses.Success(w, "Download automático de mídias desabilitado", result)
+}
+
+// parseSessionID extrai e valida o sessionID da URL
+func (h *MediaHandler) parseSessionID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
+
+// handleError mapeia erros de domínio para respostas HTTP
+func (h *MediaHandler) handleError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.NotFound(w, "Session not found")
+	case errors.Is(err, domainMedia.ErrObjectNotFound):
+		responses.NotFound(w, "Media not found")
+	case errors.Is(err, domainMedia.ErrInvalidObjectKey):
+		responses.BadRequest(w, "Invalid media object", err.Error())
+	case errors.Is(err, domainMedia.ErrStorageDisabled):
+		responses.Conflict(w, "Media storage is disabled", "Configure MEDIA_STORAGE_DRIVER to enable it")
+	default:
+		h.logger.WithError(err).Error().Msg(message)
+		responses.InternalError(w, message)
+	}
+}
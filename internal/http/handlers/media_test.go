@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	domainMedia "zmeow/internal/domain/media"
+	"zmeow/internal/http/middleware"
+	"zmeow/internal/usecases/auth"
+	"zmeow/internal/usecases/media"
+	"zmeow/pkg/logger"
+)
+
+// memoryStorage é um media.Storage em memória para os testes
+type memoryStorage struct {
+	objects map[string][]byte
+}
+
+func (s *memoryStorage) Put(_ context.Context, key string, data io.Reader, _ int64, _ string) error {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	s.objects[key] = content
+	return nil
+}
+
+func (s *memoryStorage) Get(_ context.Context, key string) (io.ReadCloser, *domainMedia.ObjectInfo, error) {
+	content, ok := s.objects[key]
+	if !ok {
+		return nil, nil, domainMedia.ErrObjectNotFound
+	}
+	info := &domainMedia.ObjectInfo{Key: key, Size: int64(len(content)), ContentType: "image/jpeg"}
+	return io.NopCloser(bytes.NewReader(content)), info, nil
+}
+
+func (s *memoryStorage) Exists(_ context.Context, key string) (bool, error) {
+	_, ok := s.objects[key]
+	return ok, nil
+}
+
+func (s *memoryStorage) Delete(_ context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+// newSignedMediaRouter monta a rota /media como no router da aplicação, com autenticação habilitada
+func newSignedMediaRouter(storage domainMedia.Storage, signer *domainMedia.URLSigner) http.Handler {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+
+	handler := NewMediaHandler(nil, media.NewGetStoredMediaUseCase(storage, log), signer, log)
+	authMiddleware := middleware.NewAuthMiddleware(auth.NewAuthenticateUseCase(nil, "", log), true, log)
+
+	r := chi.NewRouter()
+	r.With(authMiddleware.AllowSigned(handler.HasValidSignature)).
+		Get("/media/{sessionID}/{object}", handler.GetObject)
+	return r
+}
+
+func TestMediaSignedURLSessionScoping(t *testing.T) {
+	sessionA := uuid.New()
+	sessionB := uuid.New()
+	objectName := strings.Repeat("a", 64) + ".jpg"
+	otherObject := strings.Repeat("b", 64) + ".jpg"
+
+	storage := &memoryStorage{objects: map[string][]byte{
+		domainMedia.ObjectKey(sessionA, strings.Repeat("a", 64), ".jpg"): []byte("session-a"),
+		domainMedia.ObjectKey(sessionA, strings.Repeat("b", 64), ".jpg"): []byte("session-a-other"),
+		domainMedia.ObjectKey(sessionB, strings.Repeat("a", 64), ".jpg"): []byte("session-b"),
+	}}
+	signer := domainMedia.NewURLSigner("http://zmeow.test", "media-secret", time.Hour)
+	router := newSignedMediaRouter(storage, signer)
+
+	signed, err := url.Parse(signer.URL(domainMedia.ObjectKey(sessionA, strings.Repeat("a", 64), ".jpg")))
+	if err != nil {
+		t.Fatalf("parsing signed url: %v", err)
+	}
+	query := "?" + signed.RawQuery
+	extended := signed.Query()
+	extended.Set("expires", strconv.FormatInt(time.Now().Add(24*time.Hour).Unix(), 10))
+
+	tests := []struct {
+		name     string
+		url      string
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "signed url for the object",
+			url:      signed.Path + query,
+			wantCode: http.StatusOK,
+			wantBody: "session-a",
+		},
+		{
+			name:     "signed url with the session id in upper case",
+			url:      "/media/" + strings.ToUpper(sessionA.String()) + "/" + objectName + query,
+			wantCode: http.StatusOK,
+			wantBody: "session-a",
+		},
+		{
+			name:     "signature of another session",
+			url:      "/media/" + sessionB.String() + "/" + objectName + query,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "signature of another object",
+			url:      "/media/" + sessionA.String() + "/" + otherObject + query,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "extended expiration",
+			url:      signed.Path + "?" + extended.Encode(),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "tampered signature",
+			url:      signed.Path + query + "00",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "no signature",
+			url:      "/media/" + sessionA.String() + "/" + objectName,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "invalid session id",
+			url:      "/media/not-a-session/" + objectName + query,
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("GET %s = %d, want %d (%s)", tt.url, rec.Code, tt.wantCode, rec.Body.String())
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Fatalf("GET %s body = %q, want %q", tt.url, rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -3,19 +3,25 @@ package handlers
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
 	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	domainSession "zmeow/internal/domain/session"
+	"zmeow/internal/http/middleware"
 	"zmeow/internal/http/responses"
 	messageUseCases "zmeow/internal/usecases/message"
+	outboundUseCases "zmeow/internal/usecases/outbound"
 	"zmeow/pkg/logger"
 )
 
@@ -32,6 +38,12 @@ type MessageHandler struct {
 	editMessageUseCase   *messageUseCases.EditMessageUseCase
 	deleteMessageUseCase *messageUseCases.DeleteMessageUseCase
 	reactMessageUseCase  *messageUseCases.ReactMessageUseCase
+	historyUseCase       *messageUseCases.GetMessageHistoryUseCase
+	statusUseCase        *messageUseCases.GetMessageStatusUseCase
+	enqueueUseCase       *outboundUseCases.EnqueueMessageUseCase
+	listJobsUseCase      *outboundUseCases.ListJobsUseCase
+	getJobUseCase        *outboundUseCases.GetJobUseCase
+	cancelJobUseCase     *outboundUseCases.CancelJobUseCase
 	logger               logger.Logger
 }
 
@@ -48,6 +60,12 @@ func NewMessageHandler(
 	editMessageUseCase *messageUseCases.EditMessageUseCase,
 	deleteMessageUseCase *messageUseCases.DeleteMessageUseCase,
 	reactMessageUseCase *messageUseCases.ReactMessageUseCase,
+	historyUseCase *messageUseCases.GetMessageHistoryUseCase,
+	statusUseCase *messageUseCases.GetMessageStatusUseCase,
+	enqueueUseCase *outboundUseCases.EnqueueMessageUseCase,
+	listJobsUseCase *outboundUseCases.ListJobsUseCase,
+	getJobUseCase *outboundUseCases.GetJobUseCase,
+	cancelJobUseCase *outboundUseCases.CancelJobUseCase,
 	logger logger.Logger,
 ) *MessageHandler {
 	return &MessageHandler{
@@ -62,6 +80,12 @@ func NewMessageHandler(
 		editMessageUseCase:   editMessageUseCase,
 		deleteMessageUseCase: deleteMessageUseCase,
 		reactMessageUseCase:  reactMessageUseCase,
+		historyUseCase:       historyUseCase,
+		statusUseCase:        statusUseCase,
+		enqueueUseCase:       enqueueUseCase,
+		listJobsUseCase:      listJobsUseCase,
+		getJobUseCase:        getJobUseCase,
+		cancelJobUseCase:     cancelJobUseCase,
 		logger:               logger,
 	}
 }
@@ -84,10 +108,13 @@ func NewMessageHandler(
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendTextMessageRequest true "Dados da mensagem de texto"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Mensagem enviada com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos ou campos obrigatórios ausentes"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/text [post]
 func (h *MessageHandler) SendTextMessage(w http.ResponseWriter, r *http.Request) {
@@ -106,10 +133,14 @@ func (h *MessageHandler) SendTextMessage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindText, req) {
+		return
+	}
+
 	response, err := h.sendTextUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send text message")
-		responses.InternalError(w, "Failed to send text message")
+		h.writeSendError(w, err, "Failed to send text message")
 		return
 	}
 
@@ -133,6 +164,7 @@ func (h *MessageHandler) SendTextMessage(w http.ResponseWriter, r *http.Request)
 // @Accept json,multipart/form-data
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendMediaMessageRequest true "Dados da mídia (para JSON)"
 // @Param to formData string false "Número do destinatário ou JID do grupo (obrigatório para form-data)" example("559981769536")
 // @Param mediaType formData string false "Tipo de mídia (obrigatório para form-data)" Enums(image, audio, video, document) example("image")
@@ -140,8 +172,10 @@ func (h *MessageHandler) SendTextMessage(w http.ResponseWriter, r *http.Request)
 // @Param caption formData string false "Legenda da mídia (opcional para form-data)" example("Minha foto")
 // @Param fileName formData string false "Nome do arquivo (obrigatório para documentos)" example("documento.pdf")
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Mídia enviada com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, tipo de mídia não suportado ou arquivo muito grande"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/media [post]
 func (h *MessageHandler) SendMediaMessage(w http.ResponseWriter, r *http.Request) {
@@ -182,10 +216,14 @@ func (h *MessageHandler) SendMediaMessage(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindMedia, req) {
+		return
+	}
+
 	response, err := h.sendMediaUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send media message")
-		responses.InternalError(w, "Failed to send media message")
+		h.writeSendError(w, err, "Failed to send media message")
 		return
 	}
 
@@ -202,10 +240,13 @@ func (h *MessageHandler) SendMediaMessage(w http.ResponseWriter, r *http.Request
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendImageMessageRequest true "Dados da imagem"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Imagem enviada com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, formato não suportado ou imagem muito grande"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/image [post]
 func (h *MessageHandler) SendImageMessage(w http.ResponseWriter, r *http.Request) {
@@ -226,20 +267,25 @@ func (h *MessageHandler) SendImageMessage(w http.ResponseWriter, r *http.Request
 
 	// Converter para SendMediaMessageRequest
 	mediaReq := message.SendMediaMessageRequest{
-		Number:      req.Number,
-		GroupJid:    req.GroupJid,
-		MediaType:   "image",
-		Media:       req.Image,
-		Caption:     req.Caption,
-		MimeType:    req.MimeType,
-		ContextInfo: req.ContextInfo,
-		Metadata:    req.Metadata,
+		Number:          req.Number,
+		GroupJid:        req.GroupJid,
+		MediaType:       "image",
+		Media:           req.Image,
+		Caption:         req.Caption,
+		MimeType:        req.MimeType,
+		ContextInfo:     req.ContextInfo,
+		VerifyRecipient: req.VerifyRecipient,
+		Metadata:        req.Metadata,
+	}
+
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindMedia, mediaReq) {
+		return
 	}
 
 	response, err := h.sendMediaUseCase.Execute(r.Context(), sessionID, mediaReq)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send image message")
-		responses.InternalError(w, "Failed to send image message")
+		h.writeSendError(w, err, "Failed to send image message")
 		return
 	}
 
@@ -257,10 +303,13 @@ func (h *MessageHandler) SendImageMessage(w http.ResponseWriter, r *http.Request
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendAudioMessageRequest true "Dados do áudio"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Áudio enviado com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, formato não suportado ou áudio muito grande"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/audio [post]
 func (h *MessageHandler) SendAudioMessage(w http.ResponseWriter, r *http.Request) {
@@ -281,20 +330,25 @@ func (h *MessageHandler) SendAudioMessage(w http.ResponseWriter, r *http.Request
 
 	// Converter para SendMediaMessageRequest
 	mediaReq := message.SendMediaMessageRequest{
-		Number:      req.Number,
-		GroupJid:    req.GroupJid,
-		MediaType:   "audio",
-		Media:       req.Audio,
-		Caption:     req.Caption,
-		MimeType:    "audio/mpeg", // Default para áudio
-		ContextInfo: req.ContextInfo,
-		Metadata:    req.Metadata,
+		Number:          req.Number,
+		GroupJid:        req.GroupJid,
+		MediaType:       "audio",
+		Media:           req.Audio,
+		Caption:         req.Caption,
+		MimeType:        "audio/mpeg", // Default para áudio
+		ContextInfo:     req.ContextInfo,
+		VerifyRecipient: req.VerifyRecipient,
+		Metadata:        req.Metadata,
+	}
+
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindMedia, mediaReq) {
+		return
 	}
 
 	response, err := h.sendMediaUseCase.Execute(r.Context(), sessionID, mediaReq)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send audio message")
-		responses.InternalError(w, "Failed to send audio message")
+		h.writeSendError(w, err, "Failed to send audio message")
 		return
 	}
 
@@ -311,10 +365,13 @@ func (h *MessageHandler) SendAudioMessage(w http.ResponseWriter, r *http.Request
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendVideoMessageRequest true "Dados do vídeo"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Vídeo enviado com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, formato não suportado ou vídeo muito grande"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/video [post]
 func (h *MessageHandler) SendVideoMessage(w http.ResponseWriter, r *http.Request) {
@@ -335,20 +392,25 @@ func (h *MessageHandler) SendVideoMessage(w http.ResponseWriter, r *http.Request
 
 	// Converter para SendMediaMessageRequest
 	mediaReq := message.SendMediaMessageRequest{
-		Number:      req.Number,
-		GroupJid:    req.GroupJid,
-		MediaType:   "video",
-		Media:       req.Video,
-		Caption:     req.Caption,
-		MimeType:    req.MimeType,
-		ContextInfo: req.ContextInfo,
-		Metadata:    req.Metadata,
+		Number:          req.Number,
+		GroupJid:        req.GroupJid,
+		MediaType:       "video",
+		Media:           req.Video,
+		Caption:         req.Caption,
+		MimeType:        req.MimeType,
+		ContextInfo:     req.ContextInfo,
+		VerifyRecipient: req.VerifyRecipient,
+		Metadata:        req.Metadata,
+	}
+
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindMedia, mediaReq) {
+		return
 	}
 
 	response, err := h.sendMediaUseCase.Execute(r.Context(), sessionID, mediaReq)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send video message")
-		responses.InternalError(w, "Failed to send video message")
+		h.writeSendError(w, err, "Failed to send video message")
 		return
 	}
 
@@ -366,10 +428,13 @@ func (h *MessageHandler) SendVideoMessage(w http.ResponseWriter, r *http.Request
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendDocumentMessageRequest true "Dados do documento"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Documento enviado com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, fileName ausente ou documento muito grande"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/document [post]
 func (h *MessageHandler) SendDocumentMessage(w http.ResponseWriter, r *http.Request) {
@@ -390,21 +455,26 @@ func (h *MessageHandler) SendDocumentMessage(w http.ResponseWriter, r *http.Requ
 
 	// Converter para SendMediaMessageRequest
 	mediaReq := message.SendMediaMessageRequest{
-		Number:      req.Number,
-		GroupJid:    req.GroupJid,
-		MediaType:   "document",
-		Media:       req.Document,
-		Caption:     req.Caption,
-		FileName:    req.FileName,
-		MimeType:    req.MimeType,
-		ContextInfo: req.ContextInfo,
-		Metadata:    req.Metadata,
+		Number:          req.Number,
+		GroupJid:        req.GroupJid,
+		MediaType:       "document",
+		Media:           req.Document,
+		Caption:         req.Caption,
+		FileName:        req.FileName,
+		MimeType:        req.MimeType,
+		ContextInfo:     req.ContextInfo,
+		VerifyRecipient: req.VerifyRecipient,
+		Metadata:        req.Metadata,
+	}
+
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindMedia, mediaReq) {
+		return
 	}
 
 	response, err := h.sendMediaUseCase.Execute(r.Context(), sessionID, mediaReq)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send document message")
-		responses.InternalError(w, "Failed to send document message")
+		h.writeSendError(w, err, "Failed to send document message")
 		return
 	}
 
@@ -422,10 +492,13 @@ func (h *MessageHandler) SendDocumentMessage(w http.ResponseWriter, r *http.Requ
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendLocationMessageRequest true "Dados da localização"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Localização enviada com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos ou coordenadas fora do intervalo válido"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/location [post]
 func (h *MessageHandler) SendLocationMessage(w http.ResponseWriter, r *http.Request) {
@@ -444,10 +517,14 @@ func (h *MessageHandler) SendLocationMessage(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindLocation, req) {
+		return
+	}
+
 	response, err := h.sendLocationUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send location message")
-		responses.InternalError(w, "Failed to send location message")
+		h.writeSendError(w, err, "Failed to send location message")
 		return
 	}
 
@@ -464,10 +541,13 @@ func (h *MessageHandler) SendLocationMessage(w http.ResponseWriter, r *http.Requ
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendContactMessageRequest true "Dados do contato"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Contato enviado com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos ou formato de JID incorreto"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/contact [post]
 func (h *MessageHandler) SendContactMessage(w http.ResponseWriter, r *http.Request) {
@@ -486,10 +566,14 @@ func (h *MessageHandler) SendContactMessage(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindContact, req) {
+		return
+	}
+
 	response, err := h.sendContactUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send contact message")
-		responses.InternalError(w, "Failed to send contact message")
+		h.writeSendError(w, err, "Failed to send contact message")
 		return
 	}
 
@@ -507,10 +591,13 @@ func (h *MessageHandler) SendContactMessage(w http.ResponseWriter, r *http.Reque
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendStickerMessageRequest true "Dados do sticker"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Sticker enviado com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, formato não suportado ou sticker muito grande"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/sticker [post]
 func (h *MessageHandler) SendStickerMessage(w http.ResponseWriter, r *http.Request) {
@@ -529,10 +616,14 @@ func (h *MessageHandler) SendStickerMessage(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindSticker, req) {
+		return
+	}
+
 	response, err := h.sendStickerUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send sticker message")
-		responses.InternalError(w, "Failed to send sticker message")
+		h.writeSendError(w, err, "Failed to send sticker message")
 		return
 	}
 
@@ -550,10 +641,13 @@ func (h *MessageHandler) SendStickerMessage(w http.ResponseWriter, r *http.Reque
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendButtonsMessageRequest true "Dados da mensagem com botões"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Mensagem com botões enviada com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, muitos botões ou IDs duplicados"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/buttons [post]
 func (h *MessageHandler) SendButtonsMessage(w http.ResponseWriter, r *http.Request) {
@@ -572,10 +666,14 @@ func (h *MessageHandler) SendButtonsMessage(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindButtons, req) {
+		return
+	}
+
 	response, err := h.sendButtonsUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send buttons message")
-		responses.InternalError(w, "Failed to send buttons message")
+		h.writeSendError(w, err, "Failed to send buttons message")
 		return
 	}
 
@@ -594,10 +692,13 @@ func (h *MessageHandler) SendButtonsMessage(w http.ResponseWriter, r *http.Reque
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendListMessageRequest true "Dados da mensagem com lista"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Mensagem com lista enviada com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, seções vazias ou IDs duplicados"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/list [post]
 func (h *MessageHandler) SendListMessage(w http.ResponseWriter, r *http.Request) {
@@ -616,10 +717,14 @@ func (h *MessageHandler) SendListMessage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindList, req) {
+		return
+	}
+
 	response, err := h.sendListUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send list message")
-		responses.InternalError(w, "Failed to send list message")
+		h.writeSendError(w, err, "Failed to send list message")
 		return
 	}
 
@@ -637,10 +742,13 @@ func (h *MessageHandler) SendListMessage(w http.ResponseWriter, r *http.Request)
 // @Accept json
 // @Produce json
 // @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param async query bool false "Enfileira o envio e responde 202 com o ID do job; o resultado chega pelo evento message.queue" example(true)
 // @Param request body message.SendPollMessageRequest true "Dados da enquete"
 // @Success 200 {object} responses.SuccessResponse{data=message.SendMessageResponse} "Enquete enviada com sucesso"
+// @Success 202 {object} responses.SuccessResponse{data=outbound.EnqueueResponse} "Mensagem adicionada à fila de envio (async=true)"
 // @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos, poucas/muitas opções ou selectableOptionsCount inválido"
 // @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada ou não conectada"
+// @Failure 422 {object} responses.ErrorResponse "Destinatário não está no WhatsApp (verifyRecipient)"
 // @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor ou falha no envio"
 // @Router /messages/{sessionID}/send/poll [post]
 func (h *MessageHandler) SendPollMessage(w http.ResponseWriter, r *http.Request) {
@@ -659,10 +767,14 @@ func (h *MessageHandler) SendPollMessage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if h.enqueueIfAsync(w, r, sessionID, outbound.KindPoll, req) {
+		return
+	}
+
 	response, err := h.sendPollUseCase.Execute(r.Context(), sessionID, req)
 	if err != nil {
 		h.logger.WithError(err).Error().Msg("Failed to send poll message")
-		responses.InternalError(w, "Failed to send poll message")
+		h.writeSendError(w, err, "Failed to send poll message")
 		return
 	}
 
@@ -800,12 +912,309 @@ func (h *MessageHandler) ReactMessage(w http.ResponseWriter, r *http.Request) {
 	responses.Success(w, "Reação enviada com sucesso", response)
 }
 
+// GetMessageHistory consulta o histórico de mensagens da sessão
+// @Summary Histórico de mensagens
+// @Description Lista as mensagens recebidas e enviadas pela sessão, das mais recentes para as mais antigas
+// @Description
+// @Description **Paginação:** use `before` com o ID da última mensagem da página para buscar as anteriores,
+// @Description ou `after` com o ID da primeira para buscar as mais novas
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param chatId query string false "JID do chat ou número de telefone" example("559981769536@s.whatsapp.net")
+// @Param before query string false "ID da mensagem de referência: retorna as mensagens anteriores"
+// @Param after query string false "ID da mensagem de referência: retorna as mensagens posteriores"
+// @Param type query string false "Tipo da mensagem (text, image, audio, ...)"
+// @Param direction query string false "Direção da mensagem (inbound, outbound)"
+// @Param limit query int false "Quantidade máxima de mensagens (padrão 50, máximo 200)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} responses.SuccessResponse{data=message.MessageHistoryResponse} "Histórico de mensagens"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão ou mensagem de referência não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID} [get]
+func (h *MessageHandler) GetMessageHistory(w http.ResponseWriter, r *http.Request) {
+	sessionIDStr := chi.URLParam(r, "sessionID")
+	sessionID, err := uuid.Parse(sessionIDStr)
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	req := message.MessageHistoryRequest{
+		ChatID:    query.Get("chatId"),
+		Before:    query.Get("before"),
+		After:     query.Get("after"),
+		Type:      query.Get("type"),
+		Direction: query.Get("direction"),
+	}
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid limit", err.Error())
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid offset", err.Error())
+			return
+		}
+	}
+
+	response, err := h.historyUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, message.ErrMessageNotFound):
+			responses.NotFound(w, "Reference message not found")
+		case errors.Is(err, domainSession.ErrSessionNotFound):
+			responses.NotFound(w, "Session not found")
+		case errors.Is(err, message.ErrInvalidDirection),
+			errors.Is(err, message.ErrInvalidMessageType),
+			errors.Is(err, message.ErrConflictingCursors):
+			responses.BadRequest(w, "Invalid history filter", err.Error())
+		default:
+			h.logger.WithError(err).Error().Msg("Failed to get message history")
+			responses.InternalError(w, "Failed to get message history")
+		}
+		return
+	}
+
+	responses.Success(w, "Histórico de mensagens obtido com sucesso", response)
+}
+
+// GetMessageStatus consulta o status de entrega de uma mensagem enviada
+// @Summary Status de entrega da mensagem
+// @Description Retorna o status agregado de uma mensagem enviada (sent, delivered, read, played, server_error, retry)
+// @Description e o status de cada destinatário; em grupos há um item por participante que enviou recibo
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param messageID path string true "ID da mensagem" example("3EB0C767D26A1D8E5A1F")
+// @Success 200 {object} responses.SuccessResponse{data=message.MessageStatusResponse} "Status da mensagem"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão ou mensagem não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/{messageID}/status [get]
+func (h *MessageHandler) GetMessageStatus(w http.ResponseWriter, r *http.Request) {
+	sessionIDStr := chi.URLParam(r, "sessionID")
+	sessionID, err := uuid.Parse(sessionIDStr)
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	messageID := chi.URLParam(r, "messageID")
+	if messageID == "" {
+		responses.BadRequest(w, "Message ID is required", "")
+		return
+	}
+
+	response, err := h.statusUseCase.Execute(r.Context(), sessionID, messageID)
+	if err != nil {
+		switch {
+		case errors.Is(err, message.ErrMessageNotFound):
+			responses.NotFound(w, "Message not found")
+		case errors.Is(err, domainSession.ErrSessionNotFound):
+			responses.NotFound(w, "Session not found")
+		default:
+			h.logger.WithError(err).Error().Msg("Failed to get message status")
+			responses.InternalError(w, "Failed to get message status")
+		}
+		return
+	}
+
+	responses.Success(w, "Status da mensagem obtido com sucesso", response)
+}
+
+// ListQueuedMessages lista a fila de envio assíncrono da sessão
+// @Summary Listar fila de envio
+// @Description Lista os envios feitos com async=true, do mais recente ao mais antigo, com status, tentativas e resultado
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param status query string false "Status do job (queued, sending, sent, failed, canceled)"
+// @Param kind query string false "Tipo de envio (text, media, location, contact, sticker, buttons, list, poll)"
+// @Param limit query int false "Quantidade máxima de itens (padrão 50, máximo 200)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} responses.SuccessResponse{data=outbound.JobListResponse} "Fila de envio"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/queue [get]
+func (h *MessageHandler) ListQueuedMessages(w http.ResponseWriter, r *http.Request) {
+	sessionIDStr := chi.URLParam(r, "sessionID")
+	sessionID, err := uuid.Parse(sessionIDStr)
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	req := outbound.ListJobsRequest{
+		Status: query.Get("status"),
+		Kind:   query.Get("kind"),
+	}
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid limit", err.Error())
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid offset", err.Error())
+			return
+		}
+	}
+
+	response, err := h.listJobsUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.writeQueueError(w, err, "Failed to list queued messages")
+		return
+	}
+
+	responses.Success(w, "Fila de envio obtida com sucesso", response)
+}
+
+// GetQueuedMessage consulta um envio da fila assíncrona
+// @Summary Consultar envio da fila
+// @Description Retorna o job com payload, tentativas, último erro e o ID da mensagem no WhatsApp após o envio
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param jobID path string true "ID do job (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=outbound.Job} "Envio encontrado"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Envio não encontrado"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/queue/{jobID} [get]
+func (h *MessageHandler) GetQueuedMessage(w http.ResponseWriter, r *http.Request) {
+	sessionID, jobID, ok := h.parseJobParams(w, r)
+	if !ok {
+		return
+	}
+
+	job, err := h.getJobUseCase.Execute(r.Context(), sessionID, jobID)
+	if err != nil {
+		h.writeQueueError(w, err, "Failed to get queued message")
+		return
+	}
+
+	responses.Success(w, "Envio encontrado", job)
+}
+
+// CancelQueuedMessage cancela um envio que ainda aguarda na fila
+// @Summary Cancelar envio da fila
+// @Description Cancela um envio com status queued; envios já enviados, falhos ou em andamento não podem ser cancelados
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param jobID path string true "ID do job (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=outbound.Job} "Envio cancelado"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Envio não encontrado"
+// @Failure 409 {object} responses.ErrorResponse "Envio não está mais na fila"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/queue/{jobID}/cancel [post]
+func (h *MessageHandler) CancelQueuedMessage(w http.ResponseWriter, r *http.Request) {
+	sessionID, jobID, ok := h.parseJobParams(w, r)
+	if !ok {
+		return
+	}
+
+	job, err := h.cancelJobUseCase.Execute(r.Context(), sessionID, jobID)
+	if err != nil {
+		h.writeQueueError(w, err, "Failed to cancel queued message")
+		return
+	}
+
+	responses.Success(w, "Envio cancelado", job)
+}
+
+// parseJobParams extrai e valida o sessionID e o jobID da URL
+func (h *MessageHandler) parseJobParams(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	jobID, err := uuid.Parse(chi.URLParam(r, "jobID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid job ID format")
+		responses.BadRequest(w, "Invalid job ID format", err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return sessionID, jobID, true
+}
+
+// enqueueIfAsync coloca o envio na fila da sessão quando a requisição usa ?async=true, respondendo 202 com o ID do job.
+// A requisição é validada antes de entrar na fila; uma requisição inválida responde 400.
+func (h *MessageHandler) enqueueIfAsync(w http.ResponseWriter, r *http.Request, sessionID uuid.UUID, kind outbound.Kind, req interface{}) bool {
+	async, _ := strconv.ParseBool(r.URL.Query().Get("async"))
+	if !async {
+		return false
+	}
+
+	response, err := h.enqueueUseCase.Execute(r.Context(), sessionID, kind, req)
+	if err != nil {
+		h.writeQueueError(w, err, "Failed to enqueue message")
+		return true
+	}
+
+	responses.Accepted(w, "Mensagem adicionada à fila de envio", response)
+	return true
+}
+
+// writeQueueError mapeia os erros da fila de envio para respostas HTTP
+func (h *MessageHandler) writeQueueError(w http.ResponseWriter, err error, failureMessage string) {
+	switch {
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.NotFound(w, "Session not found")
+	case errors.Is(err, outbound.ErrJobNotFound):
+		responses.NotFound(w, "Queued message not found")
+	case errors.Is(err, outbound.ErrJobNotCancelable):
+		responses.Conflict(w, "Message is no longer queued", err.Error())
+	case errors.Is(err, outbound.ErrInvalidStatus), errors.Is(err, outbound.ErrInvalidKind), errors.Is(err, outbound.ErrInvalidPayload):
+		responses.BadRequest(w, "Invalid queue request", err.Error())
+	case errors.Is(err, message.ErrInvalidSendRequest):
+		responses.BadRequest(w, "Invalid request", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg(failureMessage)
+		responses.InternalError(w, failureMessage)
+	}
+}
+
+// writeSendError responde a falha de um envio; requisição inválida responde 400 e destinatário sem WhatsApp e contexto
+// inválido são informados com código próprio
+func (h *MessageHandler) writeSendError(w http.ResponseWriter, err error, failureMessage string) {
+	switch {
+	case errors.Is(err, message.ErrRecipientNotOnWhatsApp):
+		responses.WriteJSON(w, http.StatusUnprocessableEntity, false, "Destinatário não está no WhatsApp", nil, &responses.APIError{
+			Code:    "RECIPIENT_NOT_ON_WHATSAPP",
+			Details: err.Error(),
+		})
+	case errors.Is(err, message.ErrInvalidContextInfo):
+		responses.Error400(w, "Contexto da mensagem inválido", "INVALID_CONTEXT_INFO", err.Error())
+	case errors.Is(err, message.ErrInvalidSendRequest):
+		responses.BadRequest(w, "Invalid request", err.Error())
+	default:
+		responses.InternalError(w, failureMessage)
+	}
+}
+
 // parseFormDataMedia processa form-data para upload direto de arquivos
 func (h *MessageHandler) parseFormDataMedia(r *http.Request) (message.SendMediaMessageRequest, error) {
 	var req message.SendMediaMessageRequest
 
 	// Parse multipart form (32MB max)
-	err := r.ParseMultipartForm(32 << 20)
+	err := r.ParseMultipartForm(middleware.MaxRequestBodySize)
 	if err != nil {
 		return req, fmt.Errorf("failed to parse multipart form: %w", err)
 	}
@@ -819,6 +1228,13 @@ func (h *MessageHandler) parseFormDataMedia(r *http.Request) (message.SendMediaM
 	req.Caption = r.FormValue("caption")
 	req.FileName = r.FormValue("fileName")
 	req.MimeType = r.FormValue("mimeType")
+	req.VerifyRecipient, _ = strconv.ParseBool(r.FormValue("verifyRecipient"))
+	if raw := r.FormValue("contextInfo"); raw != "" {
+		req.ContextInfo = &message.MessageContextInfo{}
+		if err := json.Unmarshal([]byte(raw), req.ContextInfo); err != nil {
+			return req, fmt.Errorf("invalid contextInfo: %w", err)
+		}
+	}
 
 	// Processar arquivo de mídia
 	file, header, err := r.FormFile("media")
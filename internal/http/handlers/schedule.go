@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	domainMessage "zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/schedule"
+	domainSession "zmeow/internal/domain/session"
+	"zmeow/internal/http/responses"
+	scheduleUseCases "zmeow/internal/usecases/schedule"
+	"zmeow/pkg/logger"
+)
+
+// ScheduleHandler implementa os handlers dos envios agendados
+type ScheduleHandler struct {
+	scheduleUseCase *scheduleUseCases.ScheduleMessageUseCase
+	listUseCase     *scheduleUseCases.ListSchedulesUseCase
+	getUseCase      *scheduleUseCases.GetScheduleUseCase
+	updateUseCase   *scheduleUseCases.UpdateScheduleUseCase
+	cancelUseCase   *scheduleUseCases.CancelScheduleUseCase
+	logger          logger.Logger
+}
+
+// NewScheduleHandler cria uma nova instância do schedule handler
+func NewScheduleHandler(
+	scheduleUseCase *scheduleUseCases.ScheduleMessageUseCase,
+	listUseCase *scheduleUseCases.ListSchedulesUseCase,
+	getUseCase *scheduleUseCases.GetScheduleUseCase,
+	updateUseCase *scheduleUseCases.UpdateScheduleUseCase,
+	cancelUseCase *scheduleUseCases.CancelScheduleUseCase,
+	logger logger.Logger,
+) *ScheduleHandler {
+	return &ScheduleHandler{
+		scheduleUseCase: scheduleUseCase,
+		listUseCase:     listUseCase,
+		getUseCase:      getUseCase,
+		updateUseCase:   updateUseCase,
+		cancelUseCase:   cancelUseCase,
+		logger:          logger.WithComponent("schedule-handler"),
+	}
+}
+
+// ScheduleMessage agenda um envio
+// @Summary Agendar mensagem
+// @Description Agenda qualquer tipo de envio para um horário. `payload` é a mesma requisição do endpoint de envio do `kind`
+// @Description (text, media, location, contact, sticker, buttons, list, poll). `sendAt` aceita RFC3339 com offset ou data e
+// @Description hora locais no `timezone` (IANA, padrão UTC). O resultado chega pelo evento `message.scheduled`.
+// @Description
+// @Description **Exemplo de uso:**
+// @Description ```json
+// @Description {
+// @Description   "kind": "text",
+// @Description   "payload": {"number": "5511999999999", "text": "Lembrete: consulta amanhã às 10h"},
+// @Description   "sendAt": "2025-08-01T09:00:00",
+// @Description   "timezone": "America/Sao_Paulo",
+// @Description   "ifDisconnected": "retry"
+// @Description }
+// @Description ```
+// @Tags Mensagens
+// @Accept json
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param request body schedule.ScheduleMessageRequest true "Envio a agendar"
+// @Success 201 {object} responses.SuccessResponse{data=schedule.ScheduledMessage} "Mensagem agendada"
+// @Failure 400 {object} responses.ErrorResponse "Tipo, payload, horário ou fuso inválido"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/schedule [post]
+func (h *ScheduleHandler) ScheduleMessage(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	var req schedule.ScheduleMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode schedule message request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	msg, err := h.scheduleUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to schedule message")
+		return
+	}
+
+	responses.Created(w, "Mensagem agendada com sucesso", msg)
+}
+
+// ListScheduledMessages lista os agendamentos da sessão
+// @Summary Listar mensagens agendadas
+// @Description Lista os agendamentos da sessão pelo horário de envio
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param status query string false "Status (scheduled, sending, sent, failed, skipped, canceled)"
+// @Param limit query int false "Quantidade máxima de itens (padrão 50, máximo 200)"
+// @Param offset query int false "Deslocamento para paginação"
+// @Success 200 {object} responses.SuccessResponse{data=schedule.ScheduleListResponse} "Agendamentos encontrados"
+// @Failure 400 {object} responses.ErrorResponse "Parâmetros inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Sessão não encontrada"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/schedule [get]
+func (h *ScheduleHandler) ListScheduledMessages(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	req := schedule.ListScheduleRequest{
+		Status: query.Get("status"),
+	}
+
+	var err error
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid limit", err.Error())
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid offset", err.Error())
+			return
+		}
+	}
+
+	result, err := h.listUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to list scheduled messages")
+		return
+	}
+
+	responses.Success(w, "Agendamentos encontrados", result)
+}
+
+// GetScheduledMessage consulta um agendamento
+// @Summary Consultar mensagem agendada
+// @Description Retorna o agendamento com payload, tentativas, último erro e o ID da mensagem no WhatsApp após o envio
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param scheduleID path string true "ID do agendamento (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=schedule.ScheduledMessage} "Agendamento encontrado"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Agendamento não encontrado"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/schedule/{scheduleID} [get]
+func (h *ScheduleHandler) GetScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	sessionID, scheduleID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	msg, err := h.getUseCase.Execute(r.Context(), sessionID, scheduleID)
+	if err != nil {
+		h.handleError(w, err, "Failed to get scheduled message")
+		return
+	}
+
+	responses.Success(w, "Agendamento encontrado", msg)
+}
+
+// UpdateScheduledMessage altera um agendamento que ainda não foi enviado
+// @Summary Alterar mensagem agendada
+// @Description Altera tipo, payload, horário, fuso ou política de um agendamento com status scheduled; campos omitidos
+// @Description são mantidos. Para trocar o fuso, informe também o sendAt
+// @Tags Mensagens
+// @Accept json
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param scheduleID path string true "ID do agendamento (UUID)" format(uuid)
+// @Param request body schedule.UpdateScheduleRequest true "Campos a alterar"
+// @Success 200 {object} responses.SuccessResponse{data=schedule.ScheduledMessage} "Agendamento alterado"
+// @Failure 400 {object} responses.ErrorResponse "Dados inválidos"
+// @Failure 404 {object} responses.ErrorResponse "Agendamento não encontrado"
+// @Failure 409 {object} responses.ErrorResponse "Agendamento já processado"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/schedule/{scheduleID} [put]
+func (h *ScheduleHandler) UpdateScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	sessionID, scheduleID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req schedule.UpdateScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode update schedule request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	msg, err := h.updateUseCase.Execute(r.Context(), sessionID, scheduleID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to update scheduled message")
+		return
+	}
+
+	responses.Success(w, "Agendamento alterado", msg)
+}
+
+// CancelScheduledMessage cancela um agendamento que ainda não foi enviado
+// @Summary Cancelar mensagem agendada
+// @Description Cancela um agendamento com status scheduled
+// @Tags Mensagens
+// @Produce json
+// @Param sessionID path string true "ID da sessão WhatsApp (UUID)" format(uuid) example("9a3a24d2-2b2c-4214-8797-7c6571837f53")
+// @Param scheduleID path string true "ID do agendamento (UUID)" format(uuid)
+// @Success 200 {object} responses.SuccessResponse{data=schedule.ScheduledMessage} "Agendamento cancelado"
+// @Failure 400 {object} responses.ErrorResponse "ID inválido"
+// @Failure 404 {object} responses.ErrorResponse "Agendamento não encontrado"
+// @Failure 409 {object} responses.ErrorResponse "Agendamento já processado"
+// @Failure 500 {object} responses.ErrorResponse "Erro interno do servidor"
+// @Router /messages/{sessionID}/schedule/{scheduleID}/cancel [post]
+func (h *ScheduleHandler) CancelScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	sessionID, scheduleID, ok := h.parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	msg, err := h.cancelUseCase.Execute(r.Context(), sessionID, scheduleID)
+	if err != nil {
+		h.handleError(w, err, "Failed to cancel scheduled message")
+		return
+	}
+
+	responses.Success(w, "Agendamento cancelado", msg)
+}
+
+// parseSessionID extrai e valida o sessionID da URL
+func (h *ScheduleHandler) parseSessionID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
+
+// parseIDs extrai e valida o sessionID e o scheduleID da URL
+func (h *ScheduleHandler) parseIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	scheduleID, err := uuid.Parse(chi.URLParam(r, "scheduleID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid schedule ID format")
+		responses.BadRequest(w, "Invalid schedule ID format", err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+	return sessionID, scheduleID, true
+}
+
+// handleError mapeia erros de domínio para respostas HTTP
+func (h *ScheduleHandler) handleError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.NotFound(w, "Session not found")
+	case errors.Is(err, schedule.ErrScheduleNotFound):
+		responses.NotFound(w, "Scheduled message not found")
+	case errors.Is(err, schedule.ErrScheduleNotEditable):
+		responses.Conflict(w, "Scheduled message was already processed", err.Error())
+	case errors.Is(err, schedule.ErrInvalidSendAt),
+		errors.Is(err, schedule.ErrInvalidTimezone),
+		errors.Is(err, schedule.ErrInvalidPolicy),
+		errors.Is(err, schedule.ErrInvalidStatus),
+		errors.Is(err, outbound.ErrInvalidKind),
+		errors.Is(err, outbound.ErrInvalidPayload),
+		errors.Is(err, domainMessage.ErrInvalidSendRequest):
+		responses.BadRequest(w, "Invalid schedule request", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg(message)
+		responses.InternalError(w, message)
+	}
+}
@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	domainSession "zmeow/internal/domain/session"
 	"zmeow/internal/http/responses"
 	"zmeow/internal/usecases/session"
 	"zmeow/pkg/logger"
@@ -24,6 +26,11 @@ type SessionHandler struct {
 	pairUseCase       *session.PairPhoneUseCase
 	proxyUseCase      *session.SetProxyUseCase
 	statusUseCase     *session.GetStatusUseCase
+	statsUseCase      *session.GetStatsUseCase
+	setCallPolicyUC   *session.SetCallPolicyUseCase
+	getCallPolicyUC   *session.GetCallPolicyUseCase
+	setHistorySyncUC  *session.SetHistorySyncUseCase
+	getHistorySyncUC  *session.GetHistorySyncUseCase
 	logger            logger.Logger
 }
 
@@ -39,6 +46,11 @@ func NewSessionHandler(
 	pairUseCase *session.PairPhoneUseCase,
 	proxyUseCase *session.SetProxyUseCase,
 	statusUseCase *session.GetStatusUseCase,
+	statsUseCase *session.GetStatsUseCase,
+	setCallPolicyUC *session.SetCallPolicyUseCase,
+	getCallPolicyUC *session.GetCallPolicyUseCase,
+	setHistorySyncUC *session.SetHistorySyncUseCase,
+	getHistorySyncUC *session.GetHistorySyncUseCase,
 	logger logger.Logger,
 ) *SessionHandler {
 	return &SessionHandler{
@@ -52,6 +64,11 @@ func NewSessionHandler(
 		pairUseCase:       pairUseCase,
 		proxyUseCase:      proxyUseCase,
 		statusUseCase:     statusUseCase,
+		statsUseCase:      statsUseCase,
+		setCallPolicyUC:   setCallPolicyUC,
+		getCallPolicyUC:   getCallPolicyUC,
+		setHistorySyncUC:  setHistorySyncUC,
+		getHistorySyncUC:  getHistorySyncUC,
 		logger:            logger.WithComponent("session-handler"),
 	}
 }
@@ -78,8 +95,16 @@ func (h *SessionHandler) AddSession(w http.ResponseWriter, r *http.Request) {
 
 	sess, err := h.createUseCase.Execute(r.Context(), req)
 	if err != nil {
-		h.logger.WithError(err).Error().Msg("Failed to create session")
-		responses.InternalError(w, "Failed to create session")
+		var validationErr *domainSession.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			responses.BadRequest(w, "Invalid request", err.Error())
+		case errors.Is(err, domainSession.ErrSessionAlreadyExists):
+			responses.Conflict(w, "Session already exists", err.Error())
+		default:
+			h.logger.WithError(err).Error().Msg("Failed to create session")
+			responses.InternalError(w, "Failed to create session")
+		}
 		return
 	}
 
@@ -261,6 +286,196 @@ func (h *SessionHandler) GetSessionStatus(w http.ResponseWriter, r *http.Request
 	responses.Success(w, "Status obtido", status)
 }
 
+// GetSessionStats obtém as estatísticas de eventos de uma sessão
+// @Summary      Estatísticas da Sessão
+// @Description  Retorna mensagens recebidas e enviadas, erros, última atividade e tempo de conexão, contados desde o início do processo
+// @Tags         sessions
+// @Accept       json
+// @Produce      json
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Success      200        {object}  responses.SuccessResponse  "Estatísticas da sessão"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/stats [get]
+func (h *SessionHandler) GetSessionStats(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	stats, err := h.statsUseCase.Execute(r.Context(), sessionID)
+	if err != nil {
+		if errors.Is(err, domainSession.ErrSessionNotFound) {
+			responses.NotFound(w, "Session not found")
+			return
+		}
+		h.logger.WithError(err).Error().Msg("Failed to get session stats")
+		responses.InternalError(w, "Failed to get session stats")
+		return
+	}
+
+	responses.Success(w, "Estatísticas obtidas", stats)
+}
+
+// GetCallPolicy obtém a política de chamadas de uma sessão
+// @Summary      Política de Chamadas
+// @Description  Retorna como a sessão trata as chamadas recebidas: ignore, reject ou reject_reply
+// @Tags         sessions
+// @Produce      json
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Success      200        {object}  responses.SuccessResponse{data=session.CallPolicyResponse}  "Política de chamadas"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/calls/policy [get]
+func (h *SessionHandler) GetCallPolicy(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	result, err := h.getCallPolicyUC.Execute(r.Context(), sessionID)
+	if err != nil {
+		h.handleCallPolicyError(w, err)
+		return
+	}
+
+	responses.Success(w, "Política de chamadas obtida", result)
+}
+
+// SetCallPolicy configura o tratamento das chamadas recebidas pela sessão
+// @Summary      Configurar Política de Chamadas
+// @Description  ignore apenas notifica a chamada; reject a rejeita automaticamente; reject_reply a rejeita e envia replyMessage a quem ligou
+// @Tags         sessions
+// @Accept       json
+// @Produce      json
+// @Param        sessionID  path      string                      true  "ID da sessão (UUID)"
+// @Param        request    body      session.CallPolicyRequest   true  "Política de chamadas"
+// @Success      200        {object}  responses.SuccessResponse{data=session.CallPolicyResponse}  "Política atualizada"
+// @Failure      400        {object}  responses.ErrorResponse  "Dados inválidos"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/calls/policy [post]
+func (h *SessionHandler) SetCallPolicy(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	var req session.CallPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode call policy request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	result, err := h.setCallPolicyUC.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleCallPolicyError(w, err)
+		return
+	}
+
+	responses.Success(w, "Política de chamadas atualizada", result)
+}
+
+// handleCallPolicyError mapeia os erros da política de chamadas para respostas HTTP
+func (h *SessionHandler) handleCallPolicyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.NotFound(w, "Session not found")
+	case errors.Is(err, domainSession.ErrInvalidCallPolicy), errors.Is(err, domainSession.ErrCallReplyRequired):
+		responses.BadRequest(w, "Invalid call policy", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg("Failed to handle call policy")
+		responses.InternalError(w, "Failed to handle call policy")
+	}
+}
+
+// GetHistorySync obtém a configuração de importação do histórico de uma sessão
+// @Summary      Importação do Histórico
+// @Description  Retorna quantos dias de histórico são importados quando o dispositivo é pareado
+// @Tags         sessions
+// @Produce      json
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Success      200        {object}  responses.SuccessResponse{data=session.HistorySyncResponse}  "Configuração do histórico"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/history/sync [get]
+func (h *SessionHandler) GetHistorySync(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	result, err := h.getHistorySyncUC.Execute(r.Context(), sessionID)
+	if err != nil {
+		h.handleHistorySyncError(w, err)
+		return
+	}
+
+	responses.Success(w, "Configuração do histórico obtida", result)
+}
+
+// SetHistorySync configura a importação do histórico da sessão
+// @Summary      Configurar Importação do Histórico
+// @Description  Define quantos dias de mensagens são importados da sincronização de histórico (0 a 3650; 0 desabilita a importação)
+// @Tags         sessions
+// @Accept       json
+// @Produce      json
+// @Param        sessionID  path      string                      true  "ID da sessão (UUID)"
+// @Param        request    body      session.HistorySyncRequest  true  "Janela de histórico"
+// @Success      200        {object}  responses.SuccessResponse{data=session.HistorySyncResponse}  "Configuração atualizada"
+// @Failure      400        {object}  responses.ErrorResponse  "Dados inválidos"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/history/sync [post]
+func (h *SessionHandler) SetHistorySync(w http.ResponseWriter, r *http.Request) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return
+	}
+
+	var req session.HistorySyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode history sync request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	result, err := h.setHistorySyncUC.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleHistorySyncError(w, err)
+		return
+	}
+
+	responses.Success(w, "Configuração do histórico atualizada", result)
+}
+
+// handleHistorySyncError mapeia os erros da configuração do histórico para respostas HTTP
+func (h *SessionHandler) handleHistorySyncError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.NotFound(w, "Session not found")
+	case errors.Is(err, domainSession.ErrInvalidHistorySyncDays):
+		responses.BadRequest(w, "Invalid history sync settings", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg("Failed to handle history sync settings")
+		responses.InternalError(w, "Failed to handle history sync settings")
+	}
+}
+
 // GetQRCode obtém o QR code de uma sessão
 // @Summary      QR Code da Sessão
 // @Description  Obtém o QR Code para autenticação da sessão WhatsApp
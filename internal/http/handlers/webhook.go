@@ -0,0 +1,619 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	domainSession "zmeow/internal/domain/session"
+	domainWebhook "zmeow/internal/domain/webhook"
+	"zmeow/internal/http/responses"
+	"zmeow/internal/usecases/webhook"
+	"zmeow/pkg/logger"
+)
+
+// WebhookHandler implementa os handlers de configuração de webhook das sessões
+type WebhookHandler struct {
+	listUseCase    *webhook.ListWebhooksUseCase
+	createUseCase  *webhook.CreateWebhookUseCase
+	getUseCase     *webhook.GetWebhookUseCase
+	setUseCase     *webhook.SetWebhookUseCase
+	deleteUseCase  *webhook.DeleteWebhookUseCase
+	enableUseCase  *webhook.EnableWebhookUseCase
+	disableUseCase *webhook.DisableWebhookUseCase
+	testUseCase    *webhook.TestWebhookUseCase
+	rotateUseCase  *webhook.RotateWebhookSecretUseCase
+
+	listDeliveriesUseCase   *webhook.ListDeliveriesUseCase
+	getDeliveryUseCase      *webhook.GetDeliveryUseCase
+	replayDeliveryUseCase   *webhook.ReplayDeliveryUseCase
+	replayDeliveriesUseCase *webhook.ReplayDeliveriesUseCase
+
+	logger logger.Logger
+}
+
+// NewWebhookHandler cria uma nova instância do webhook handler
+func NewWebhookHandler(
+	listUseCase *webhook.ListWebhooksUseCase,
+	createUseCase *webhook.CreateWebhookUseCase,
+	getUseCase *webhook.GetWebhookUseCase,
+	setUseCase *webhook.SetWebhookUseCase,
+	deleteUseCase *webhook.DeleteWebhookUseCase,
+	enableUseCase *webhook.EnableWebhookUseCase,
+	disableUseCase *webhook.DisableWebhookUseCase,
+	testUseCase *webhook.TestWebhookUseCase,
+	rotateUseCase *webhook.RotateWebhookSecretUseCase,
+	listDeliveriesUseCase *webhook.ListDeliveriesUseCase,
+	getDeliveryUseCase *webhook.GetDeliveryUseCase,
+	replayDeliveryUseCase *webhook.ReplayDeliveryUseCase,
+	replayDeliveriesUseCase *webhook.ReplayDeliveriesUseCase,
+	logger logger.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		listUseCase:    listUseCase,
+		createUseCase:  createUseCase,
+		getUseCase:     getUseCase,
+		setUseCase:     setUseCase,
+		deleteUseCase:  deleteUseCase,
+		enableUseCase:  enableUseCase,
+		disableUseCase: disableUseCase,
+		testUseCase:    testUseCase,
+		rotateUseCase:  rotateUseCase,
+
+		listDeliveriesUseCase:   listDeliveriesUseCase,
+		getDeliveryUseCase:      getDeliveryUseCase,
+		replayDeliveryUseCase:   replayDeliveryUseCase,
+		replayDeliveriesUseCase: replayDeliveriesUseCase,
+
+		logger: logger.WithComponent("webhook-handler"),
+	}
+}
+
+// ListWebhooks lista os webhooks de uma sessão
+// @Summary      Listar Webhooks
+// @Description  Lista os webhooks da sessão, do principal (o mais antigo) ao mais recente
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Success      200        {object}  responses.SuccessResponse  "Webhooks encontrados"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.listUseCase.Execute(r.Context(), sessionID)
+	if err != nil {
+		h.handleError(w, err, "Failed to list webhooks")
+		return
+	}
+
+	responses.Success(w, "Webhooks encontrados", webhooks)
+}
+
+// CreateWebhook adiciona um webhook a uma sessão
+// @Summary      Criar Webhook
+// @Description  Adiciona um endpoint de webhook à sessão, com eventos e headers próprios (máximo de 10 por sessão).
+// @Description  Sem `secret`, um segredo de assinatura é gerado e retornado no campo `secret` apenas nesta resposta
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string                     true  "ID da sessão (UUID)"
+// @Param        request    body      webhook.SetWebhookRequest  true  "Configuração do webhook"
+// @Success      201        {object}  responses.SuccessResponse{data=webhook.WebhookResponse}  "Webhook criado"
+// @Failure      400        {object}  responses.ErrorResponse  "Dados inválidos"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      409        {object}  responses.ErrorResponse  "Limite de webhooks atingido"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	var req webhook.SetWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode create webhook request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	wh, err := h.createUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to create webhook")
+		return
+	}
+
+	responses.Created(w, "Webhook criado com sucesso", wh)
+}
+
+// GetWebhook obtém um webhook de uma sessão
+// @Summary      Obter Webhook
+// @Description  Obtém a configuração de um webhook da sessão
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Param        webhookID  path      string  false  "ID do webhook (UUID); nas rotas /webhook é o webhook principal"
+// @Success      200        {object}  responses.SuccessResponse  "Webhook encontrado"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão ou webhook não encontrado"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook [get]
+// @Router       /sessions/{sessionID}/webhooks/{webhookID} [get]
+func (h *WebhookHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	webhookID, ok := h.parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	wh, err := h.getUseCase.Execute(r.Context(), sessionID, webhookID)
+	if err != nil {
+		h.handleError(w, err, "Failed to get webhook")
+		return
+	}
+
+	responses.Success(w, "Webhook encontrado", wh)
+}
+
+// SetWebhook atualiza um webhook de uma sessão
+// @Summary      Configurar Webhook
+// @Description  Substitui a configuração de um webhook da sessão. Em /webhook atua no webhook principal, criando-o se a sessão não tiver nenhum.
+// @Description  Omitido, `secret` mantém o segredo atual; ao criar o webhook principal sem `secret`, o segredo gerado é retornado apenas nesta resposta
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string                     true  "ID da sessão (UUID)"
+// @Param        webhookID  path      string  false  "ID do webhook (UUID); nas rotas /webhook é o webhook principal"
+// @Param        request    body      webhook.SetWebhookRequest  true  "Configuração do webhook"
+// @Success      200        {object}  responses.SuccessResponse{data=webhook.WebhookResponse}  "Webhook configurado"
+// @Failure      400        {object}  responses.ErrorResponse  "Dados inválidos"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook [put]
+// @Router       /sessions/{sessionID}/webhooks/{webhookID} [put]
+func (h *WebhookHandler) SetWebhook(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	webhookID, ok := h.parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	var req webhook.SetWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithError(err).Error().Msg("Failed to decode set webhook request")
+		responses.BadRequest(w, "Invalid request body", err.Error())
+		return
+	}
+
+	wh, err := h.setUseCase.Execute(r.Context(), sessionID, webhookID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to set webhook")
+		return
+	}
+
+	responses.Success(w, "Webhook configurado com sucesso", wh)
+}
+
+// DeleteWebhook remove um webhook de uma sessão
+// @Summary      Remover Webhook
+// @Description  Remove um webhook da sessão
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Param        webhookID  path      string  false  "ID do webhook (UUID); nas rotas /webhook é o webhook principal"
+// @Success      200        {object}  responses.SuccessResponse  "Webhook removido"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Webhook não encontrado"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook [delete]
+// @Router       /sessions/{sessionID}/webhooks/{webhookID} [delete]
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	webhookID, ok := h.parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteUseCase.Execute(r.Context(), sessionID, webhookID); err != nil {
+		h.handleError(w, err, "Failed to delete webhook")
+		return
+	}
+
+	responses.Success(w, "Webhook removido com sucesso", nil)
+}
+
+// EnableWebhook habilita um webhook de uma sessão
+// @Summary      Habilitar Webhook
+// @Description  Habilita o envio de eventos para o webhook da sessão
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Param        webhookID  path      string  false  "ID do webhook (UUID); nas rotas /webhook é o webhook principal"
+// @Success      200        {object}  responses.SuccessResponse  "Webhook habilitado"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Webhook não encontrado"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/enable [post]
+// @Router       /sessions/{sessionID}/webhooks/{webhookID}/enable [post]
+func (h *WebhookHandler) EnableWebhook(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	webhookID, ok := h.parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.enableUseCase.Execute(r.Context(), sessionID, webhookID); err != nil {
+		h.handleError(w, err, "Failed to enable webhook")
+		return
+	}
+
+	responses.Success(w, "Webhook habilitado com sucesso", nil)
+}
+
+// DisableWebhook desabilita um webhook de uma sessão
+// @Summary      Desabilitar Webhook
+// @Description  Interrompe o envio de eventos para o webhook da sessão sem remover a configuração
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Param        webhookID  path      string  false  "ID do webhook (UUID); nas rotas /webhook é o webhook principal"
+// @Success      200        {object}  responses.SuccessResponse  "Webhook desabilitado"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Webhook não encontrado"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/disable [post]
+// @Router       /sessions/{sessionID}/webhooks/{webhookID}/disable [post]
+func (h *WebhookHandler) DisableWebhook(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	webhookID, ok := h.parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.disableUseCase.Execute(r.Context(), sessionID, webhookID); err != nil {
+		h.handleError(w, err, "Failed to disable webhook")
+		return
+	}
+
+	responses.Success(w, "Webhook desabilitado com sucesso", nil)
+}
+
+// TestWebhook envia um evento de teste para um webhook de uma sessão
+// @Summary      Testar Webhook
+// @Description  Envia um evento "test" para o webhook, independente dos eventos que ele assina
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true  "ID da sessão (UUID)"
+// @Param        webhookID  path      string  false  "ID do webhook (UUID); nas rotas /webhook é o webhook principal"
+// @Success      200        {object}  responses.SuccessResponse  "Webhook de teste enviado"
+// @Failure      400        {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404        {object}  responses.ErrorResponse  "Webhook não encontrado"
+// @Failure      409        {object}  responses.ErrorResponse  "Webhook desabilitado"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/test [post]
+// @Router       /sessions/{sessionID}/webhooks/{webhookID}/test [post]
+func (h *WebhookHandler) TestWebhook(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	webhookID, ok := h.parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.testUseCase.Execute(r.Context(), sessionID, webhookID); err != nil {
+		h.handleError(w, err, "Failed to send test webhook")
+		return
+	}
+
+	responses.Success(w, "Webhook de teste enviado", nil)
+}
+
+// RotateSecret rotaciona o segredo de assinatura do webhook
+// @Summary      Rotacionar Segredo do Webhook
+// @Description  Define um novo segredo (gerado se omitido). O segredo anterior continua assinando as entregas durante overlapSeconds (padrão 24h).
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string                              true   "ID da sessão (UUID)"
+// @Param        webhookID  path      string  false  "ID do webhook (UUID); nas rotas /webhook é o webhook principal"
+// @Param        request    body      webhook.RotateWebhookSecretRequest  false  "Novo segredo e janela de sobreposição"
+// @Success      200        {object}  responses.SuccessResponse  "Segredo rotacionado"
+// @Failure      400        {object}  responses.ErrorResponse  "Dados inválidos"
+// @Failure      404        {object}  responses.ErrorResponse  "Webhook não encontrado"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/secret/rotate [post]
+// @Router       /sessions/{sessionID}/webhooks/{webhookID}/secret/rotate [post]
+func (h *WebhookHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	webhookID, ok := h.parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	var req webhook.RotateWebhookSecretRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.logger.WithError(err).Error().Msg("Failed to decode rotate webhook secret request")
+			responses.BadRequest(w, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	result, err := h.rotateUseCase.Execute(r.Context(), sessionID, webhookID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to rotate webhook secret")
+		return
+	}
+
+	responses.Success(w, "Segredo do webhook rotacionado com sucesso", result)
+}
+
+// ListDeliveries lista as entregas do outbox de webhook de uma sessão
+// @Summary      Listar Entregas de Webhook
+// @Description  Lista as entregas de webhook da sessão, mais recentes primeiro. Use status=dead para ver as entregas que esgotaram as tentativas
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string  true   "ID da sessão (UUID)"
+// @Param        webhookId  query     string  false  "ID do webhook (UUID)"
+// @Param        status     query     string  false  "Status da entrega (pending, delivered, dead)"
+// @Param        event      query     string  false  "Tipo de evento"
+// @Param        limit      query     int     false  "Quantidade máxima de itens (padrão 50, máximo 200)"
+// @Param        offset     query     int     false  "Deslocamento para paginação"
+// @Success      200        {object}  responses.SuccessResponse{data=webhook.ListDeliveriesResponse}  "Entregas encontradas"
+// @Failure      400        {object}  responses.ErrorResponse  "Parâmetros inválidos"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	req := webhook.ListDeliveriesRequest{
+		Status: query.Get("status"),
+		Event:  query.Get("event"),
+	}
+
+	var err error
+	if value := query.Get("webhookId"); value != "" {
+		if req.WebhookID, err = uuid.Parse(value); err != nil {
+			responses.BadRequest(w, "Invalid webhook ID format", err.Error())
+			return
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid limit", err.Error())
+			return
+		}
+	}
+	if value := query.Get("offset"); value != "" {
+		if req.Offset, err = strconv.Atoi(value); err != nil {
+			responses.BadRequest(w, "Invalid offset", err.Error())
+			return
+		}
+	}
+
+	result, err := h.listDeliveriesUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to list webhook deliveries")
+		return
+	}
+
+	responses.Success(w, "Entregas encontradas", result)
+}
+
+// GetDelivery obtém uma entrega de webhook com payload e histórico de tentativas
+// @Summary      Inspecionar Entrega de Webhook
+// @Description  Retorna o payload, os códigos de resposta e o histórico de tentativas de uma entrega
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID   path      string  true  "ID da sessão (UUID)"
+// @Param        deliveryID  path      string  true  "ID da entrega (UUID)"
+// @Success      200         {object}  responses.SuccessResponse  "Entrega encontrada"
+// @Failure      400         {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404         {object}  responses.ErrorResponse  "Entrega não encontrada"
+// @Failure      500         {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/deliveries/{deliveryID} [get]
+func (h *WebhookHandler) GetDelivery(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	deliveryID, ok := h.parseDeliveryID(w, r)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.getDeliveryUseCase.Execute(r.Context(), sessionID, deliveryID)
+	if err != nil {
+		h.handleError(w, err, "Failed to get webhook delivery")
+		return
+	}
+
+	responses.Success(w, "Entrega encontrada", delivery)
+}
+
+// ReplayDelivery reenvia uma entrega de webhook
+// @Summary      Reenviar Entrega de Webhook
+// @Description  Recoloca uma entrega concluída ou em dead-letter na fila, com as tentativas zeradas
+// @Tags         webhooks
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID   path      string  true  "ID da sessão (UUID)"
+// @Param        deliveryID  path      string  true  "ID da entrega (UUID)"
+// @Success      200         {object}  responses.SuccessResponse  "Entrega recolocada na fila"
+// @Failure      400         {object}  responses.ErrorResponse  "ID inválido"
+// @Failure      404         {object}  responses.ErrorResponse  "Entrega não encontrada"
+// @Failure      409         {object}  responses.ErrorResponse  "Entrega ainda pendente"
+// @Failure      500         {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/deliveries/{deliveryID}/replay [post]
+func (h *WebhookHandler) ReplayDelivery(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+	deliveryID, ok := h.parseDeliveryID(w, r)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.replayDeliveryUseCase.Execute(r.Context(), sessionID, deliveryID)
+	if err != nil {
+		h.handleError(w, err, "Failed to replay webhook delivery")
+		return
+	}
+
+	responses.Success(w, "Entrega recolocada na fila", delivery)
+}
+
+// ReplayDeliveries reenvia entregas de webhook em lote
+// @Summary      Reenviar Entregas de Webhook em Lote
+// @Description  Recoloca na fila as entregas informadas em ids ou, sem ids, todas as entregas da sessão com o status informado (padrão: dead)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        sessionID  path      string                            true   "ID da sessão (UUID)"
+// @Param        request    body      webhook.ReplayDeliveriesRequest  false  "Entregas a reenviar"
+// @Success      200        {object}  responses.SuccessResponse{data=webhook.ReplayDeliveriesResponse}  "Entregas recolocadas na fila"
+// @Failure      400        {object}  responses.ErrorResponse  "Dados inválidos"
+// @Failure      404        {object}  responses.ErrorResponse  "Sessão não encontrada"
+// @Failure      500        {object}  responses.ErrorResponse  "Erro interno"
+// @Router       /sessions/{sessionID}/webhook/deliveries/replay [post]
+func (h *WebhookHandler) ReplayDeliveries(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := h.parseSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	var req webhook.ReplayDeliveriesRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.logger.WithError(err).Error().Msg("Failed to decode replay webhook deliveries request")
+			responses.BadRequest(w, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	result, err := h.replayDeliveriesUseCase.Execute(r.Context(), sessionID, req)
+	if err != nil {
+		h.handleError(w, err, "Failed to replay webhook deliveries")
+		return
+	}
+
+	responses.Success(w, "Entregas recolocadas na fila", result)
+}
+
+// parseDeliveryID extrai e valida o deliveryID da URL
+func (h *WebhookHandler) parseDeliveryID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	deliveryID, err := uuid.Parse(chi.URLParam(r, "deliveryID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid delivery ID format")
+		responses.BadRequest(w, "Invalid delivery ID format", err.Error())
+		return uuid.Nil, false
+	}
+	return deliveryID, true
+}
+
+// parseWebhookID extrai e valida o webhookID da URL; nas rotas /webhook, sem o parâmetro,
+// retorna uuid.Nil para selecionar o webhook principal
+func (h *WebhookHandler) parseWebhookID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	value := chi.URLParam(r, "webhookID")
+	if value == "" {
+		return uuid.Nil, true
+	}
+
+	webhookID, err := uuid.Parse(value)
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid webhook ID format")
+		responses.BadRequest(w, "Invalid webhook ID format", err.Error())
+		return uuid.Nil, false
+	}
+	return webhookID, true
+}
+
+// parseSessionID extrai e valida o sessionID da URL
+func (h *WebhookHandler) parseSessionID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+	if err != nil {
+		h.logger.WithError(err).Error().Msg("Invalid session ID format")
+		responses.BadRequest(w, "Invalid session ID format", err.Error())
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
+
+// handleError mapeia erros de domínio para respostas HTTP
+func (h *WebhookHandler) handleError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, domainSession.ErrSessionNotFound):
+		responses.NotFound(w, "Session not found")
+	case errors.Is(err, domainWebhook.ErrWebhookNotFound):
+		responses.NotFound(w, "Webhook not found")
+	case errors.Is(err, domainWebhook.ErrInvalidWebhookURL):
+		responses.BadRequest(w, "Invalid webhook URL", err.Error())
+	case errors.Is(err, domainWebhook.ErrInvalidWebhookEvent):
+		responses.BadRequest(w, "Invalid webhook event type", err.Error())
+	case errors.Is(err, domainWebhook.ErrInvalidWebhookHeader):
+		responses.BadRequest(w, "Invalid webhook header", err.Error())
+	case errors.Is(err, domainWebhook.ErrWebhookLimitReached):
+		responses.Conflict(w, "Webhook limit reached", err.Error())
+	case errors.Is(err, domainWebhook.ErrInvalidSecretOverlap):
+		responses.BadRequest(w, "Invalid secret overlap", err.Error())
+	case errors.Is(err, domainWebhook.ErrDeliveryNotFound):
+		responses.NotFound(w, "Webhook delivery not found")
+	case errors.Is(err, domainWebhook.ErrInvalidDeliveryStatus):
+		responses.BadRequest(w, "Invalid delivery status", err.Error())
+	case errors.Is(err, domainWebhook.ErrDeliveryNotReplayable):
+		responses.Conflict(w, "Webhook delivery is still pending", err.Error())
+	case errors.Is(err, domainWebhook.ErrWebhookDisabled):
+		responses.Conflict(w, "Webhook is disabled", err.Error())
+	default:
+		h.logger.WithError(err).Error().Msg(message)
+		responses.InternalError(w, message)
+	}
+}
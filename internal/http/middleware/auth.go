@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/auth"
+	"zmeow/internal/http/responses"
+	authUseCases "zmeow/internal/usecases/auth"
+	"zmeow/pkg/logger"
+)
+
+// apiKeyContextKey é a chave usada para armazenar a chave autenticada no contexto
+type apiKeyContextKey struct{}
+
+// AuthMiddleware valida chaves de API e aplica o escopo de cada chave
+type AuthMiddleware struct {
+	authenticateUseCase *authUseCases.AuthenticateUseCase
+	enabled             bool
+	logger              logger.Logger
+}
+
+// NewAuthMiddleware cria uma nova instância do middleware de autenticação
+func NewAuthMiddleware(authenticateUseCase *authUseCases.AuthenticateUseCase, enabled bool, log logger.Logger) *AuthMiddleware {
+	return &AuthMiddleware{
+		authenticateUseCase: authenticateUseCase,
+		enabled:             enabled,
+		logger:              log.WithComponent("auth-middleware"),
+	}
+}
+
+// Authenticate exige uma chave válida e a armazena no contexto da requisição
+func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, err := m.authenticateUseCase.Execute(r.Context(), extractAPIKey(r))
+		if err != nil {
+			switch err {
+			case auth.ErrAPIKeyMissing:
+				responses.Unauthorized(w, "Chave de API não informada", "Use o header Authorization: Bearer <key> ou X-API-Key")
+			case auth.ErrAPIKeyInvalid, auth.ErrAPIKeyRevoked:
+				responses.Unauthorized(w, "Chave de API inválida", err.Error())
+			default:
+				m.logger.WithError(err).Error().Msg("Failed to authenticate request")
+				responses.InternalError(w, "Failed to authenticate request")
+			}
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), apiKeyContextKey{}, key)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequireAdmin permite apenas chaves com papel de administrador
+func (m *AuthMiddleware) RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, ok := APIKeyFromContext(r.Context())
+		if !ok || !key.IsAdmin() {
+			responses.Forbidden(w, "Acesso negado", "Esta rota requer uma chave de administrador")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RequireSessionAccess permite apenas chaves com acesso à sessão da URL ({sessionID})
+func (m *AuthMiddleware) RequireSessionAccess(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, ok := APIKeyFromContext(r.Context())
+		if !ok {
+			responses.Forbidden(w, "Acesso negado", auth.ErrForbidden.Error())
+			return
+		}
+
+		if key.IsAdmin() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+		if err != nil || !key.CanAccessSession(sessionID) {
+			m.logger.WithFields(map[string]interface{}{
+				"keyId": key.ID,
+				"path":  r.URL.Path,
+			}).Warn().Msg("API key denied access to session")
+			responses.Forbidden(w, "Acesso negado", auth.ErrForbidden.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// APIKeyFromContext retorna a chave autenticada armazenada no contexto
+func APIKeyFromContext(ctx context.Context) (*auth.APIKey, bool) {
+	key, ok := ctx.Value(apiKeyContextKey{}).(*auth.APIKey)
+	return key, ok
+}
+
+// extractAPIKey lê a chave dos headers Authorization (Bearer) ou X-API-Key.
+// Somente nos streams de eventos (upgrade em /events/ws e /events/sse) também aceita o parâmetro apiKey,
+// já que WebSocket e EventSource dos navegadores não permitem enviar headers.
+func extractAPIKey(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		if token, found := strings.CutPrefix(header, "Bearer "); found {
+			return strings.TrimSpace(token)
+		}
+	}
+	if key := strings.TrimSpace(r.Header.Get("X-API-Key")); key != "" {
+		return key
+	}
+	if IsStreamRequest(r) {
+		return strings.TrimSpace(r.URL.Query().Get("apiKey"))
+	}
+	return ""
+}
+
+// AllowSigned dispensa a chave de API quando verify aceita a requisição (ex.: URL assinada);
+// caso contrário exige uma chave válida com acesso à sessão da URL
+func (m *AuthMiddleware) AllowSigned(verify func(r *http.Request) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		protected := m.Authenticate(m.RequireSessionAccess(next))
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if verify(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			protected.ServeHTTP(w, r)
+		})
+	}
+}
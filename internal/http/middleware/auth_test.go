@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/auth"
+	"zmeow/internal/domain/media"
+	authUseCases "zmeow/internal/usecases/auth"
+	"zmeow/pkg/logger"
+)
+
+const (
+	testBootstrapKey = "bootstrap-admin-key"
+	testMediaSecret  = "media-secret"
+)
+
+// memoryKeyRepository guarda as chaves pelo hash do segredo
+type memoryKeyRepository struct {
+	auth.APIKeyRepository
+	keys map[string]*auth.APIKey
+}
+
+func (r *memoryKeyRepository) GetByHash(ctx context.Context, keyHash string) (*auth.APIKey, error) {
+	key, ok := r.keys[keyHash]
+	if !ok {
+		return nil, auth.ErrAPIKeyNotFound
+	}
+	return key, nil
+}
+
+func (r *memoryKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+// newAuthTestRouter monta rotas de sessão, de administração e de mídia protegidas como no router da aplicação
+func newAuthTestRouter(keys map[string]*auth.APIKey, signer *media.URLSigner) http.Handler {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+
+	repo := &memoryKeyRepository{keys: make(map[string]*auth.APIKey)}
+	for raw, key := range keys {
+		repo.keys[auth.HashKey(raw)] = key
+	}
+	m := NewAuthMiddleware(authUseCases.NewAuthenticateUseCase(repo, testBootstrapKey, log), true, log)
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	hasValidSignature := func(r *http.Request) bool {
+		query := r.URL.Query()
+		key := chi.URLParam(r, "sessionID") + "/" + chi.URLParam(r, "object")
+		return signer.Verify(key, query.Get("expires"), query.Get("signature")) == nil
+	}
+
+	r := chi.NewRouter()
+	r.With(m.AllowSigned(hasValidSignature)).Get("/media/{sessionID}/{object}", ok)
+	r.Group(func(protected chi.Router) {
+		protected.Use(m.Authenticate)
+		protected.With(m.RequireAdmin).Get("/sessions/list", ok)
+		protected.Route("/sessions/{sessionID}", func(session chi.Router) {
+			session.Use(m.RequireSessionAccess)
+			session.Get("/status", ok)
+			session.Get("/events/sse", ok)
+		})
+	})
+	return r
+}
+
+// signMediaKey assina a chave como o URLSigner, permitindo montar URLs já expiradas
+func signMediaKey(key, expires string) string {
+	mac := hmac.New(sha256.New, []byte(testMediaSecret))
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthMiddlewareAuthorization(t *testing.T) {
+	sessionA, sessionB := uuid.New(), uuid.New()
+	revokedAt := time.Now().Add(-time.Hour)
+	keys := map[string]*auth.APIKey{
+		"admin-key":    {ID: uuid.New(), Name: "admin", Role: auth.KeyRoleAdmin, IsActive: true},
+		"session-a":    {ID: uuid.New(), Name: "a", Role: auth.KeyRoleSession, SessionID: &sessionA, IsActive: true},
+		"revoked-key":  {ID: uuid.New(), Name: "revoked", Role: auth.KeyRoleAdmin, IsActive: false, RevokedAt: &revokedAt},
+		"inactive-key": {ID: uuid.New(), Name: "inactive", Role: auth.KeyRoleSession, SessionID: &sessionA, IsActive: false},
+	}
+	signer := media.NewURLSigner("http://zmeow.test", testMediaSecret, time.Hour)
+	router := newAuthTestRouter(keys, signer)
+
+	object := strings.Repeat("a", 64) + ".jpg"
+	mediaPath := "/media/" + sessionA.String() + "/" + object
+	signed, err := url.Parse(signer.URL(sessionA.String() + "/" + object))
+	if err != nil {
+		t.Fatalf("parsing signed url: %v", err)
+	}
+	expires := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+	expired := url.Values{"expires": {expires}, "signature": {signMediaKey(sessionA.String()+"/"+object, expires)}}
+	tampered := signed.Query()
+	tampered.Set("signature", strings.Repeat("0", len(tampered.Get("signature"))))
+
+	tests := []struct {
+		name     string
+		target   string
+		apiKey   string
+		wantCode int
+	}{
+		{name: "session key on its own session", target: "/sessions/" + sessionA.String() + "/status", apiKey: "session-a", wantCode: http.StatusOK},
+		{name: "session key on another session", target: "/sessions/" + sessionB.String() + "/status", apiKey: "session-a", wantCode: http.StatusForbidden},
+		{name: "session key with an invalid session id", target: "/sessions/not-a-uuid/status", apiKey: "session-a", wantCode: http.StatusForbidden},
+		{name: "admin key on any session", target: "/sessions/" + sessionB.String() + "/status", apiKey: "admin-key", wantCode: http.StatusOK},
+		{name: "admin key on admin route", target: "/sessions/list", apiKey: "admin-key", wantCode: http.StatusOK},
+		{name: "bootstrap key on admin route", target: "/sessions/list", apiKey: testBootstrapKey, wantCode: http.StatusOK},
+		{name: "session key on admin route", target: "/sessions/list", apiKey: "session-a", wantCode: http.StatusForbidden},
+		{name: "revoked key", target: "/sessions/list", apiKey: "revoked-key", wantCode: http.StatusUnauthorized},
+		{name: "inactive key", target: "/sessions/" + sessionA.String() + "/status", apiKey: "inactive-key", wantCode: http.StatusUnauthorized},
+		{name: "unknown key", target: "/sessions/list", apiKey: "unknown-key", wantCode: http.StatusUnauthorized},
+		{name: "missing key", target: "/sessions/list", wantCode: http.StatusUnauthorized},
+		{name: "query key outside streams", target: "/sessions/" + sessionA.String() + "/status?apiKey=session-a", wantCode: http.StatusUnauthorized},
+		{name: "query key on admin route", target: "/sessions/list?apiKey=admin-key", wantCode: http.StatusUnauthorized},
+		{name: "query key on event stream", target: "/sessions/" + sessionA.String() + "/events/sse?apiKey=session-a", wantCode: http.StatusOK},
+		{name: "query key on another session stream", target: "/sessions/" + sessionB.String() + "/events/sse?apiKey=session-a", wantCode: http.StatusForbidden},
+		{name: "signed media url", target: mediaPath + "?" + signed.RawQuery, wantCode: http.StatusOK},
+		{name: "expired media signature", target: mediaPath + "?" + expired.Encode(), wantCode: http.StatusUnauthorized},
+		{name: "tampered media signature", target: mediaPath + "?" + tampered.Encode(), wantCode: http.StatusUnauthorized},
+		{name: "media signature of another object", target: "/media/" + sessionA.String() + "/" + strings.Repeat("b", 64) + ".jpg?" + signed.RawQuery, wantCode: http.StatusUnauthorized},
+		{name: "unsigned media with session key", target: mediaPath, apiKey: "session-a", wantCode: http.StatusOK},
+		{name: "unsigned media with another session key", target: "/media/" + sessionB.String() + "/" + object, apiKey: "session-a", wantCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.apiKey != "" {
+				req.Header.Set("X-API-Key", tt.apiKey)
+			}
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("GET %s status = %d, want %d (body %s)", tt.target, rec.Code, tt.wantCode, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareBearerHeader(t *testing.T) {
+	sessionA := uuid.New()
+	router := newAuthTestRouter(map[string]*auth.APIKey{
+		"session-a": {ID: uuid.New(), Role: auth.KeyRoleSession, SessionID: &sessionA, IsActive: true},
+	}, media.NewURLSigner("http://zmeow.test", testMediaSecret, 0))
+
+	req := httptest.NewRequest(http.MethodGet, "/sessions/"+sessionA.String()+"/status", nil)
+	req.Header.Set("Authorization", "Bearer session-a")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
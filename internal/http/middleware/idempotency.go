@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/idempotency"
+	"zmeow/internal/http/responses"
+	idempotencyUseCases "zmeow/internal/usecases/idempotency"
+	"zmeow/pkg/logger"
+)
+
+const (
+	// IdempotencyKeyHeader é o header com a chave de idempotência informada pelo cliente
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marca as respostas repetidas a partir da resposta gravada
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// MaxRequestBodySize é o maior corpo aceito nas rotas de envio, o mesmo do upload de mídia (32MB)
+	MaxRequestBodySize = 32 << 20
+)
+
+// IdempotencyMiddleware repete a resposta original das requisições refeitas com o mesmo header Idempotency-Key,
+// evitando que um timeout seguido de nova tentativa do cliente execute o envio duas vezes
+type IdempotencyMiddleware struct {
+	idempotencyUseCase *idempotencyUseCases.IdempotencyUseCase
+	logger             logger.Logger
+}
+
+// NewIdempotencyMiddleware cria uma nova instância do middleware de idempotência
+func NewIdempotencyMiddleware(idempotencyUseCase *idempotencyUseCases.IdempotencyUseCase, log logger.Logger) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		idempotencyUseCase: idempotencyUseCase,
+		logger:             log.WithComponent("idempotency-middleware"),
+	}
+}
+
+// Handle aplica a chave de idempotência às requisições de escrita das rotas /{sessionID} que informam o header.
+// Respostas com erro do servidor (5xx) não são gravadas e liberam a chave para uma nova tentativa.
+func (m *IdempotencyMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || !m.idempotencyUseCase.Enabled() || !isWriteMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		sessionID, err := uuid.Parse(chi.URLParam(r, "sessionID"))
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// O corpo é lido por inteiro para compor o hash, então o tamanho é limitado antes da leitura
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBodySize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				responses.WriteJSON(w, http.StatusRequestEntityTooLarge, false, "Corpo da requisição muito grande", nil, &responses.APIError{
+					Code:    "REQUEST_BODY_TOO_LARGE",
+					Details: fmt.Sprintf("the request body must have at most %d bytes", tooLarge.Limit),
+				})
+				return
+			}
+			responses.BadRequest(w, "Invalid request body", err.Error())
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		record, err := m.idempotencyUseCase.Begin(r.Context(), sessionID, key, requestHash(r, body), r.Method, r.URL.Path)
+		switch {
+		case errors.Is(err, idempotency.ErrInvalidKey):
+			responses.Error400(w, "Idempotency-Key inválida", "INVALID_IDEMPOTENCY_KEY", fmt.Sprintf("the key must have between 1 and %d characters", idempotency.MaxKeyLength))
+			return
+		case errors.Is(err, idempotency.ErrKeyReused):
+			responses.WriteJSON(w, http.StatusConflict, false, "Idempotency-Key já usada com outra requisição", nil, &responses.APIError{
+				Code:    "IDEMPOTENCY_KEY_REUSED",
+				Details: err.Error(),
+			})
+			return
+		case errors.Is(err, idempotency.ErrRequestInProgress):
+			responses.WriteJSON(w, http.StatusConflict, false, "Requisição com esta Idempotency-Key ainda em andamento", nil, &responses.APIError{
+				Code:    "IDEMPOTENCY_REQUEST_IN_PROGRESS",
+				Details: err.Error(),
+			})
+			return
+		case err != nil:
+			responses.InternalError(w, "Failed to check idempotency key")
+			return
+		case record != nil:
+			replay(w, record)
+			return
+		}
+
+		var recorded bytes.Buffer
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		ww.Tee(&recorded)
+
+		completed := false
+		defer func() {
+			// A requisição do cliente pode ter sido encerrada (timeout); a chave é gravada ou liberada mesmo assim
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			status := ww.Status()
+			if !completed || status == 0 || status >= http.StatusInternalServerError {
+				m.idempotencyUseCase.Release(ctx, sessionID, key)
+				return
+			}
+			m.idempotencyUseCase.Complete(ctx, sessionID, key, status, ww.Header().Get("Content-Type"), recorded.Bytes())
+		}()
+
+		next.ServeHTTP(ww, r)
+		completed = true
+	})
+}
+
+// replay escreve a resposta gravada da requisição original
+func replay(w http.ResponseWriter, record *idempotency.Record) {
+	if record.ContentType != "" {
+		w.Header().Set("Content-Type", record.ContentType)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(record.StatusCode)
+	w.Write(record.Response)
+}
+
+// requestHash identifica a requisição pelo método, rota, query e corpo
+func requestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	io.WriteString(hash, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// isWriteMethod verifica se o método HTTP altera estado
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
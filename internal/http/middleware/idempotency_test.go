@@ -0,0 +1,228 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/idempotency"
+	idempotencyUseCases "zmeow/internal/usecases/idempotency"
+	"zmeow/pkg/logger"
+)
+
+// memoryIdempotencyRepository guarda as chaves em memória, com a mesma semântica do repositório do banco
+type memoryIdempotencyRepository struct {
+	mutex   sync.Mutex
+	records map[string]*idempotency.Record
+}
+
+func newMemoryIdempotencyRepository() *memoryIdempotencyRepository {
+	return &memoryIdempotencyRepository{records: make(map[string]*idempotency.Record)}
+}
+
+func (r *memoryIdempotencyRepository) Reserve(ctx context.Context, record *idempotency.Record) (*idempotency.Record, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	id := record.SessionID.String() + "/" + record.Key
+	if existing, ok := r.records[id]; ok && !existing.IsExpired(time.Now()) {
+		copied := *existing
+		return &copied, nil
+	}
+	reserved := *record
+	reserved.Status = idempotency.StatusProcessing
+	r.records[id] = &reserved
+	return nil, nil
+}
+
+func (r *memoryIdempotencyRepository) Complete(ctx context.Context, record *idempotency.Record) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if existing, ok := r.records[record.SessionID.String()+"/"+record.Key]; ok {
+		existing.Status = idempotency.StatusCompleted
+		existing.StatusCode = record.StatusCode
+		existing.ContentType = record.ContentType
+		existing.Response = record.Response
+	}
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) Release(ctx context.Context, sessionID uuid.UUID, key string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.records, sessionID.String()+"/"+key)
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
+
+// newIdempotencyTestRouter monta uma rota POST /{sessionID}/send com o middleware e o handler informado
+func newIdempotencyTestRouter(handler http.HandlerFunc) http.Handler {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+	useCase := idempotencyUseCases.NewIdempotencyUseCase(newMemoryIdempotencyRepository(), time.Hour, log)
+
+	router := chi.NewRouter()
+	router.With(NewIdempotencyMiddleware(useCase, log).Handle).Post("/{sessionID}/send", handler)
+	return router
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	target := "/" + uuid.New().String() + "/send"
+
+	send := func(router http.Handler, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("replays the same request", func(t *testing.T) {
+		calls := 0
+		router := newIdempotencyTestRouter(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write(body)
+		})
+
+		first := send(router, "key-1", `{"text":"oi"}`)
+		second := send(router, "key-1", `{"text":"oi"}`)
+
+		if calls != 1 {
+			t.Fatalf("handler calls = %d, want 1", calls)
+		}
+		if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+			t.Fatalf("replay = %d %q, want %d %q", second.Code, second.Body.String(), first.Code, first.Body.String())
+		}
+		if second.Header().Get(IdempotentReplayedHeader) != "true" || first.Header().Get(IdempotentReplayedHeader) != "" {
+			t.Fatalf("%s header = %q on the replay and %q on the original, want only on the replay",
+				IdempotentReplayedHeader, second.Header().Get(IdempotentReplayedHeader), first.Header().Get(IdempotentReplayedHeader))
+		}
+		if got := second.Header().Get("Content-Type"); got != "application/json" {
+			t.Fatalf("replay Content-Type = %q, want application/json", got)
+		}
+	})
+
+	t.Run("requests without the header are not recorded", func(t *testing.T) {
+		calls := 0
+		router := newIdempotencyTestRouter(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusOK)
+		})
+
+		send(router, "", `{"text":"oi"}`)
+		send(router, "", `{"text":"oi"}`)
+
+		if calls != 2 {
+			t.Fatalf("handler calls = %d, want 2", calls)
+		}
+	})
+
+	t.Run("rejects the key with another body", func(t *testing.T) {
+		calls := 0
+		router := newIdempotencyTestRouter(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusOK)
+		})
+
+		send(router, "key-1", `{"text":"oi"}`)
+		rec := send(router, "key-1", `{"text":"tchau"}`)
+
+		if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_KEY_REUSED") {
+			t.Fatalf("response = %d %s, want 409 with IDEMPOTENCY_KEY_REUSED", rec.Code, rec.Body.String())
+		}
+		if calls != 1 {
+			t.Fatalf("handler calls = %d, want 1", calls)
+		}
+	})
+
+	t.Run("rejects the key while the original is in progress", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		router := newIdempotencyTestRouter(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusOK)
+		})
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- send(router, "key-1", `{"text":"oi"}`) }()
+		<-started
+
+		rec := send(router, "key-1", `{"text":"oi"}`)
+		close(release)
+		<-done
+
+		if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_REQUEST_IN_PROGRESS") {
+			t.Fatalf("response = %d %s, want 409 with IDEMPOTENCY_REQUEST_IN_PROGRESS", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("server errors release the key", func(t *testing.T) {
+		calls := 0
+		router := newIdempotencyTestRouter(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+
+		first := send(router, "key-1", `{"text":"oi"}`)
+		second := send(router, "key-1", `{"text":"oi"}`)
+
+		if first.Code != http.StatusBadGateway || second.Code != http.StatusOK {
+			t.Fatalf("responses = %d and %d, want %d and %d", first.Code, second.Code, http.StatusBadGateway, http.StatusOK)
+		}
+		if calls != 2 {
+			t.Fatalf("handler calls = %d, want 2", calls)
+		}
+		if second.Header().Get(IdempotentReplayedHeader) != "" {
+			t.Fatal("retry after a server error was replayed, want a new execution")
+		}
+	})
+
+	t.Run("rejects bodies over the send limit", func(t *testing.T) {
+		calls := 0
+		var received string
+		router := newIdempotencyTestRouter(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			body, _ := io.ReadAll(r.Body)
+			received = string(body)
+		})
+
+		oversized := send(router, "key-1", strings.Repeat("a", MaxRequestBodySize+1))
+		if oversized.Code != http.StatusRequestEntityTooLarge || !strings.Contains(oversized.Body.String(), "REQUEST_BODY_TOO_LARGE") {
+			t.Fatalf("response = %d %s, want 413 with REQUEST_BODY_TOO_LARGE", oversized.Code, oversized.Body.String())
+		}
+		if calls != 0 {
+			t.Fatalf("handler calls = %d, want 0", calls)
+		}
+
+		// A chave não fica reservada pela requisição rejeitada
+		rec := send(router, "key-1", `{"text":"oi"}`)
+		if rec.Code != http.StatusOK || calls != 1 || received != `{"text":"oi"}` {
+			t.Fatalf("response = %d with %d calls and body %q, want the request executed", rec.Code, calls, received)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/gorilla/websocket"
+)
+
+// IsStreamRequest identifica as conexões de longa duração dos streams de eventos: o upgrade para WebSocket
+// em /events/ws e o GET de /events/sse. A decisão depende só do caminho e do upgrade, nunca do header Accept,
+// já que ela também libera a chave de API na query string.
+func IsStreamRequest(r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		return false
+	}
+	if strings.HasSuffix(r.URL.Path, "/events/ws") {
+		return websocket.IsWebSocketUpgrade(r)
+	}
+	return strings.HasSuffix(r.URL.Path, "/events/sse")
+}
+
+// NewTimeout aplica o timeout do chi às requisições, exceto aos streams de eventos
+func NewTimeout(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		withTimeout := middleware.Timeout(timeout)(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if IsStreamRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			withTimeout.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractAPIKeyQueryOnlyOnStreams(t *testing.T) {
+	const session = "/sessions/0b6f1c52-8d1f-4a39-9a57-2c1f1d0e7a10"
+
+	websocketUpgrade := map[string]string{
+		"Connection":            "Upgrade",
+		"Upgrade":               "websocket",
+		"Sec-WebSocket-Version": "13",
+		"Sec-WebSocket-Key":     "dGhlIHNhbXBsZSBub25jZQ==",
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		headers    map[string]string
+		wantKey    string
+		wantStream bool
+	}{
+		{
+			name:       "websocket upgrade on events ws",
+			method:     http.MethodGet,
+			target:     session + "/events/ws?apiKey=query-key",
+			headers:    websocketUpgrade,
+			wantKey:    "query-key",
+			wantStream: true,
+		},
+		{
+			name:   "events ws without upgrade",
+			method: http.MethodGet,
+			target: session + "/events/ws?apiKey=query-key",
+		},
+		{
+			name:       "events sse",
+			method:     http.MethodGet,
+			target:     session + "/events/sse?apiKey=query-key",
+			wantKey:    "query-key",
+			wantStream: true,
+		},
+		{
+			name:    "event-stream accept header on another route",
+			method:  http.MethodGet,
+			target:  session + "/webhooks?apiKey=query-key",
+			headers: map[string]string{"Accept": "text/event-stream"},
+		},
+		{
+			name:    "websocket upgrade on another route",
+			method:  http.MethodGet,
+			target:  "/sessions/list?apiKey=query-key",
+			headers: websocketUpgrade,
+		},
+		{
+			name:   "post to events sse",
+			method: http.MethodPost,
+			target: session + "/events/sse?apiKey=query-key",
+		},
+		{
+			name:       "header key wins over query key",
+			method:     http.MethodGet,
+			target:     session + "/events/sse?apiKey=query-key",
+			headers:    map[string]string{"X-API-Key": "header-key"},
+			wantKey:    "header-key",
+			wantStream: true,
+		},
+		{
+			name:    "bearer token on a regular route",
+			method:  http.MethodGet,
+			target:  session + "/webhooks",
+			headers: map[string]string{"Authorization": "Bearer bearer-key"},
+			wantKey: "bearer-key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.target, nil)
+			for name, value := range tt.headers {
+				r.Header.Set(name, value)
+			}
+
+			if got := IsStreamRequest(r); got != tt.wantStream {
+				t.Fatalf("IsStreamRequest() = %v, want %v", got, tt.wantStream)
+			}
+			if got := extractAPIKey(r); got != tt.wantKey {
+				t.Fatalf("extractAPIKey() = %q, want %q", got, tt.wantKey)
+			}
+		})
+	}
+}
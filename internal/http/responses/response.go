@@ -65,6 +65,11 @@ func Created(w http.ResponseWriter, message string, data interface{}) {
 	WriteJSON(w, http.StatusCreated, true, message, data, nil)
 }
 
+// Accepted escreve uma resposta de requisição aceita para processamento assíncrono
+func Accepted(w http.ResponseWriter, message string, data interface{}) {
+	WriteJSON(w, http.StatusAccepted, true, message, data, nil)
+}
+
 // BadRequest escreve uma resposta de erro de requisição inválida
 func BadRequest(w http.ResponseWriter, message string, details string) {
 	WriteJSON(w, http.StatusBadRequest, false, message, nil, &APIError{
@@ -73,6 +78,22 @@ func BadRequest(w http.ResponseWriter, message string, details string) {
 	})
 }
 
+// Unauthorized escreve uma resposta de requisição não autenticada
+func Unauthorized(w http.ResponseWriter, message string, details string) {
+	WriteJSON(w, http.StatusUnauthorized, false, message, nil, &APIError{
+		Code:    "UNAUTHORIZED",
+		Details: details,
+	})
+}
+
+// Forbidden escreve uma resposta de acesso negado
+func Forbidden(w http.ResponseWriter, message string, details string) {
+	WriteJSON(w, http.StatusForbidden, false, message, nil, &APIError{
+		Code:    "FORBIDDEN",
+		Details: details,
+	})
+}
+
 // NotFound escreve uma resposta de recurso não encontrado
 func NotFound(w http.ResponseWriter, message string) {
 	WriteJSON(w, http.StatusNotFound, false, message, nil, &APIError{
@@ -8,7 +8,6 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 
-	_ "zmeow/docs" // Swagger docs
 	"zmeow/internal/app/config"
 	"zmeow/internal/http/handlers"
 	appMiddleware "zmeow/internal/http/middleware"
@@ -18,27 +17,45 @@ import (
 // Router representa o roteador principal da aplicação
 type Router struct {
 	*chi.Mux
-	config         *config.Config
-	logger         logger.Logger
-	sessionHandler *handlers.SessionHandler
-	healthHandler  *handlers.HealthHandler
-	messageHandler *handlers.MessageHandler
-	chatHandler    *handlers.ChatHandler
-	groupHandler   *handlers.GroupHandler
+	config          *config.Config
+	logger          logger.Logger
+	sessionHandler  *handlers.SessionHandler
+	healthHandler   *handlers.HealthHandler
+	messageHandler  *handlers.MessageHandler
+	chatHandler     *handlers.ChatHandler
+	groupHandler    *handlers.GroupHandler
+	contactHandler  *handlers.ContactHandler
+	authHandler     *handlers.AuthHandler
+	webhookHandler  *handlers.WebhookHandler
+	mediaHandler    *handlers.MediaHandler
+	eventsHandler   *handlers.EventsHandler
+	scheduleHandler *handlers.ScheduleHandler
+	campaignHandler *handlers.CampaignHandler
+	authMiddleware  *appMiddleware.AuthMiddleware
+	idempotency     *appMiddleware.IdempotencyMiddleware
 }
 
 // NewRouter cria uma nova instância do router sem config (para compatibilidade)
-func NewRouter(sessionHandler *handlers.SessionHandler, healthHandler *handlers.HealthHandler, messageHandler *handlers.MessageHandler, chatHandler *handlers.ChatHandler, groupHandler *handlers.GroupHandler) *Router {
+func NewRouter(sessionHandler *handlers.SessionHandler, healthHandler *handlers.HealthHandler, messageHandler *handlers.MessageHandler, chatHandler *handlers.ChatHandler, groupHandler *handlers.GroupHandler, contactHandler *handlers.ContactHandler, authHandler *handlers.AuthHandler, webhookHandler *handlers.WebhookHandler, mediaHandler *handlers.MediaHandler, eventsHandler *handlers.EventsHandler, scheduleHandler *handlers.ScheduleHandler, campaignHandler *handlers.CampaignHandler, authMiddleware *appMiddleware.AuthMiddleware, idempotencyMiddleware *appMiddleware.IdempotencyMiddleware) *Router {
 	log := logger.WithComponent("router")
 
 	r := &Router{
-		Mux:            chi.NewRouter(),
-		logger:         log,
-		sessionHandler: sessionHandler,
-		healthHandler:  healthHandler,
-		messageHandler: messageHandler,
-		chatHandler:    chatHandler,
-		groupHandler:   groupHandler,
+		Mux:             chi.NewRouter(),
+		logger:          log,
+		sessionHandler:  sessionHandler,
+		healthHandler:   healthHandler,
+		messageHandler:  messageHandler,
+		chatHandler:     chatHandler,
+		groupHandler:    groupHandler,
+		contactHandler:  contactHandler,
+		authHandler:     authHandler,
+		webhookHandler:  webhookHandler,
+		mediaHandler:    mediaHandler,
+		eventsHandler:   eventsHandler,
+		scheduleHandler: scheduleHandler,
+		campaignHandler: campaignHandler,
+		authMiddleware:  authMiddleware,
+		idempotency:     idempotencyMiddleware,
 	}
 
 	r.setupMiddlewares()
@@ -56,16 +73,34 @@ func New(
 	messageHandler *handlers.MessageHandler,
 	chatHandler *handlers.ChatHandler,
 	groupHandler *handlers.GroupHandler,
+	contactHandler *handlers.ContactHandler,
+	authHandler *handlers.AuthHandler,
+	webhookHandler *handlers.WebhookHandler,
+	mediaHandler *handlers.MediaHandler,
+	eventsHandler *handlers.EventsHandler,
+	scheduleHandler *handlers.ScheduleHandler,
+	campaignHandler *handlers.CampaignHandler,
+	authMiddleware *appMiddleware.AuthMiddleware,
+	idempotencyMiddleware *appMiddleware.IdempotencyMiddleware,
 ) *Router {
 	r := &Router{
-		Mux:            chi.NewRouter(),
-		config:         cfg,
-		logger:         log.WithComponent("router"),
-		sessionHandler: sessionHandler,
-		healthHandler:  healthHandler,
-		messageHandler: messageHandler,
-		chatHandler:    chatHandler,
-		groupHandler:   groupHandler,
+		Mux:             chi.NewRouter(),
+		config:          cfg,
+		logger:          log.WithComponent("router"),
+		sessionHandler:  sessionHandler,
+		healthHandler:   healthHandler,
+		messageHandler:  messageHandler,
+		chatHandler:     chatHandler,
+		groupHandler:    groupHandler,
+		contactHandler:  contactHandler,
+		authHandler:     authHandler,
+		webhookHandler:  webhookHandler,
+		mediaHandler:    mediaHandler,
+		eventsHandler:   eventsHandler,
+		scheduleHandler: scheduleHandler,
+		campaignHandler: campaignHandler,
+		authMiddleware:  authMiddleware,
+		idempotency:     idempotencyMiddleware,
 	}
 
 	r.setupMiddlewares()
@@ -81,8 +116,8 @@ func (r *Router) setupMiddlewares() {
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Recoverer)
 
-	// Timeout global
-	r.Use(middleware.Timeout(60 * time.Second))
+	// Timeout global (os streams de eventos são mantidos abertos)
+	r.Use(appMiddleware.NewTimeout(60 * time.Second))
 
 	// Middlewares customizados
 	r.Use(appMiddleware.NewCORS())
@@ -100,30 +135,111 @@ func (r *Router) setupRoutes() {
 	// Health check
 	r.Get("/health", r.healthHandler.Health)
 
+	// Mídias armazenadas: URLs assinadas dispensam a chave de API
+	r.With(r.authMiddleware.AllowSigned(r.mediaHandler.HasValidSignature)).
+		Get("/media/{sessionID}/{object}", r.mediaHandler.GetObject)
+
+	// Rotas protegidas por chave de API
+	r.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authenticate)
+		r.setupProtectedRoutes(protected)
+	})
+
+	// Rota catch-all para 404
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(404)
+		w.Write([]byte(`{
+			"success": false,
+			"message": "Endpoint não encontrado",
+			"error": {
+				"code": "NOT_FOUND",
+				"details": "O endpoint solicitado não existe"
+			}
+		}`))
+	})
+}
+
+// setupProtectedRoutes configura as rotas que exigem autenticação.
+// Chaves de administrador acessam tudo; chaves de sessão apenas as rotas /{sessionID} da própria sessão.
+func (r *Router) setupProtectedRoutes(rt chi.Router) {
+	// Gerenciamento de chaves de API (apenas administradores)
+	rt.Route("/auth/keys", func(rt chi.Router) {
+		rt.Use(r.authMiddleware.RequireAdmin)
+		rt.Post("/", r.authHandler.CreateKey)
+		rt.Get("/", r.authHandler.ListKeys)
+		rt.Post("/{keyID}/rotate", r.authHandler.RotateKey)
+		rt.Delete("/{keyID}", r.authHandler.RevokeKey)
+	})
+
 	// Rotas de sessões (sem prefixo api/v1)
-	r.Route("/sessions", func(rt chi.Router) {
-		rt.Post("/add", r.sessionHandler.AddSession)
-		rt.Get("/list", r.sessionHandler.ListSessions)
+	rt.Route("/sessions", func(rt chi.Router) {
+		rt.With(r.authMiddleware.RequireAdmin).Post("/add", r.sessionHandler.AddSession)
+		rt.With(r.authMiddleware.RequireAdmin).Get("/list", r.sessionHandler.ListSessions)
 
 		// Rotas que requerem sessionID
 		rt.Route("/{sessionID}", func(rt chi.Router) {
+			rt.Use(r.authMiddleware.RequireSessionAccess)
 			rt.Get("/", r.sessionHandler.GetSession)
 			rt.Delete("/", r.sessionHandler.DeleteSession)
 			rt.Post("/connect", r.sessionHandler.ConnectSession)
 			rt.Post("/logout", r.sessionHandler.LogoutSession)
 			rt.Get("/status", r.sessionHandler.GetSessionStatus)
+			rt.Get("/stats", r.sessionHandler.GetSessionStats)
+			rt.Get("/calls/policy", r.sessionHandler.GetCallPolicy)
+			rt.Post("/calls/policy", r.sessionHandler.SetCallPolicy)
+			rt.Get("/history/sync", r.sessionHandler.GetHistorySync)
+			rt.Post("/history/sync", r.sessionHandler.SetHistorySync)
 			rt.Get("/qr", r.sessionHandler.GetQRCode)
 			rt.Post("/pairphone", r.sessionHandler.PairPhone)
 			rt.Post("/proxy/set", r.sessionHandler.SetProxy)
+
+			// Webhook da sessão
+			rt.Get("/webhook", r.webhookHandler.GetWebhook)
+			rt.Put("/webhook", r.webhookHandler.SetWebhook)
+			rt.Delete("/webhook", r.webhookHandler.DeleteWebhook)
+			rt.Post("/webhook/enable", r.webhookHandler.EnableWebhook)
+			rt.Post("/webhook/disable", r.webhookHandler.DisableWebhook)
+			rt.Post("/webhook/test", r.webhookHandler.TestWebhook)
+			rt.Post("/webhook/secret/rotate", r.webhookHandler.RotateSecret)
+
+			// Múltiplos webhooks da sessão (as rotas /webhook atuam no principal)
+			rt.Get("/webhooks", r.webhookHandler.ListWebhooks)
+			rt.Post("/webhooks", r.webhookHandler.CreateWebhook)
+			rt.Get("/webhooks/{webhookID}", r.webhookHandler.GetWebhook)
+			rt.Put("/webhooks/{webhookID}", r.webhookHandler.SetWebhook)
+			rt.Delete("/webhooks/{webhookID}", r.webhookHandler.DeleteWebhook)
+			rt.Post("/webhooks/{webhookID}/enable", r.webhookHandler.EnableWebhook)
+			rt.Post("/webhooks/{webhookID}/disable", r.webhookHandler.DisableWebhook)
+			rt.Post("/webhooks/{webhookID}/test", r.webhookHandler.TestWebhook)
+			rt.Post("/webhooks/{webhookID}/secret/rotate", r.webhookHandler.RotateSecret)
+
+			// Outbox de entregas do webhook
+			rt.Get("/webhook/deliveries", r.webhookHandler.ListDeliveries)
+			rt.Post("/webhook/deliveries/replay", r.webhookHandler.ReplayDeliveries)
+			rt.Get("/webhook/deliveries/{deliveryID}", r.webhookHandler.GetDelivery)
+			rt.Post("/webhook/deliveries/{deliveryID}/replay", r.webhookHandler.ReplayDelivery)
+
+			// Eventos ao vivo
+			rt.Get("/events/ws", r.eventsHandler.StreamWebSocket)
+			rt.Get("/events/sse", r.eventsHandler.StreamSSE)
+
+			// Download automático de mídias
+			rt.Post("/media/autodownload/enable", r.mediaHandler.EnableAutoDownload)
+			rt.Post("/media/autodownload/disable", r.mediaHandler.DisableAutoDownload)
 		})
 	})
 
 	// Rotas de mensagens
-	r.Route("/messages", func(rt chi.Router) {
+	rt.Route("/messages", func(rt chi.Router) {
 		// Rotas que requerem sessionID
 		rt.Route("/{sessionID}", func(rt chi.Router) {
+			rt.Use(r.authMiddleware.RequireSessionAccess)
+
 			// Rotas de envio
 			rt.Route("/send", func(rt chi.Router) {
+				rt.Use(r.idempotency.Handle)
+
 				rt.Post("/text", r.messageHandler.SendTextMessage)
 				rt.Post("/media", r.messageHandler.SendMediaMessage)
 				rt.Post("/image", r.messageHandler.SendImageMessage)
@@ -140,72 +256,122 @@ func (r *Router) setupRoutes() {
 			})
 
 			// Outras operações de mensagem
-			rt.Post("/delete", r.messageHandler.DeleteMessage)
-			rt.Post("/react", r.messageHandler.ReactMessage)
+			rt.With(r.idempotency.Handle).Post("/delete", r.messageHandler.DeleteMessage)
+			rt.With(r.idempotency.Handle).Post("/react", r.messageHandler.ReactMessage)
+
+			// Fila de envio assíncrono (?async=true)
+			rt.Route("/queue", func(rt chi.Router) {
+				rt.Get("/", r.messageHandler.ListQueuedMessages)
+				rt.Get("/{jobID}", r.messageHandler.GetQueuedMessage)
+				rt.Post("/{jobID}/cancel", r.messageHandler.CancelQueuedMessage)
+			})
+
+			// Envios agendados
+			rt.Route("/schedule", func(rt chi.Router) {
+				rt.With(r.idempotency.Handle).Post("/", r.scheduleHandler.ScheduleMessage)
+				rt.Get("/", r.scheduleHandler.ListScheduledMessages)
+				rt.Get("/{scheduleID}", r.scheduleHandler.GetScheduledMessage)
+				rt.Put("/{scheduleID}", r.scheduleHandler.UpdateScheduledMessage)
+				rt.Post("/{scheduleID}/cancel", r.scheduleHandler.CancelScheduledMessage)
+			})
+
+			// Histórico de mensagens
+			rt.Get("/", r.messageHandler.GetMessageHistory)
+			rt.Get("/{messageID}/status", r.messageHandler.GetMessageStatus)
+		})
+	})
 
-			// TODO: Implementar histórico de mensagens
-			// rt.Get("/", r.messageHandler.GetMessageHistory)
+	// Rotas de campanhas de envio em massa
+	rt.Route("/campaigns", func(rt chi.Router) {
+		rt.Route("/{sessionID}", func(rt chi.Router) {
+			rt.Use(r.authMiddleware.RequireSessionAccess)
+
+			rt.With(r.idempotency.Handle).Post("/", r.campaignHandler.CreateCampaign)
+			rt.Get("/", r.campaignHandler.ListCampaigns)
+			rt.Get("/{campaignID}", r.campaignHandler.GetCampaign)
+			rt.Get("/{campaignID}/report", r.campaignHandler.GetCampaignReport)
+			rt.Get("/{campaignID}/recipients", r.campaignHandler.ListCampaignRecipients)
+			rt.Post("/{campaignID}/pause", r.campaignHandler.PauseCampaign)
+			rt.Post("/{campaignID}/resume", r.campaignHandler.ResumeCampaign)
+			rt.Post("/{campaignID}/cancel", r.campaignHandler.CancelCampaign)
 		})
 	})
 
 	// Rotas de chat (funcionalidades específicas de gerenciamento de chat)
-	r.Route("/chat", func(rt chi.Router) {
+	rt.Route("/chat", func(rt chi.Router) {
 		// Rotas que requerem sessionID
 		rt.Route("/{sessionID}", func(rt chi.Router) {
+			rt.Use(r.authMiddleware.RequireSessionAccess)
+
 			// Operações específicas de chat (não duplicadas)
 			rt.Post("/presence", r.chatHandler.SendChatPresence)
 			rt.Post("/markread", r.chatHandler.MarkAsRead)
 
+			// Lista e gerenciamento de chats
+			rt.Get("/list", r.chatHandler.ListChats)
+			rt.Post("/archive", r.chatHandler.ArchiveChat)
+			rt.Post("/pin", r.chatHandler.PinChat)
+			rt.Post("/mute", r.chatHandler.MuteChat)
+			rt.Post("/unread", r.chatHandler.MarkChatUnread)
+			rt.Post("/clear", r.chatHandler.ClearChat)
+			rt.Post("/delete", r.chatHandler.DeleteChat)
+
 			// Downloads de mídia
 			rt.Post("/downloadimage", r.chatHandler.DownloadImage)
 			rt.Post("/downloadvideo", r.chatHandler.DownloadVideo)
 			rt.Post("/downloadaudio", r.chatHandler.DownloadAudio)
 			rt.Post("/downloaddocument", r.chatHandler.DownloadDocument)
+			rt.Get("/download/{messageID}", r.chatHandler.DownloadMessageMedia)
+		})
+	})
+
+	// Rotas de contatos
+	rt.Route("/contacts", func(rt chi.Router) {
+		rt.Route("/{sessionID}", func(rt chi.Router) {
+			rt.Use(r.authMiddleware.RequireSessionAccess)
+
+			rt.Get("/list", r.contactHandler.ListContacts)
+			rt.Post("/check", r.contactHandler.CheckContacts)
+			rt.Get("/info", r.contactHandler.GetContact)
+			rt.Get("/picture", r.contactHandler.GetProfilePicture)
+			rt.Get("/business", r.contactHandler.GetBusinessProfile)
 		})
 	})
 
 	// Rotas de grupos
-	r.Route("/groups", func(rt chi.Router) {
+	rt.Route("/groups", func(rt chi.Router) {
 		// Rotas que requerem sessionID
 		rt.Route("/{sessionID}", func(rt chi.Router) {
+			rt.Use(r.authMiddleware.RequireSessionAccess)
+
 			// Operações básicas de grupos
-			rt.Post("/create", r.groupHandler.CreateGroup)
+			rt.With(r.idempotency.Handle).Post("/create", r.groupHandler.CreateGroup)
 			rt.Get("/list", r.groupHandler.ListGroups)
 			rt.Get("/info", r.groupHandler.GetGroupInfo)
 
 			// Gerenciamento de participantes
-			rt.Post("/leave", r.groupHandler.LeaveGroup)
-			rt.Post("/participants/update", r.groupHandler.UpdateParticipants)
+			rt.With(r.idempotency.Handle).Post("/leave", r.groupHandler.LeaveGroup)
+			rt.With(r.idempotency.Handle).Post("/participants/update", r.groupHandler.UpdateParticipants)
 
 			// Configurações do grupo
-			rt.Post("/settings/name", r.groupHandler.SetGroupName)
-			rt.Post("/settings/topic", r.groupHandler.SetGroupTopic)
-			rt.Post("/settings/photo", r.groupHandler.SetGroupPhoto)
-			rt.Delete("/settings/photo", r.groupHandler.RemoveGroupPhoto)
-			rt.Post("/settings/announce", r.groupHandler.SetGroupAnnounce)
-			rt.Post("/settings/locked", r.groupHandler.SetGroupLocked)
-			rt.Post("/settings/disappearing", r.groupHandler.SetDisappearingTimer)
+			rt.Route("/settings", func(rt chi.Router) {
+				rt.Use(r.idempotency.Handle)
+
+				rt.Post("/name", r.groupHandler.SetGroupName)
+				rt.Post("/topic", r.groupHandler.SetGroupTopic)
+				rt.Post("/photo", r.groupHandler.SetGroupPhoto)
+				rt.Delete("/photo", r.groupHandler.RemoveGroupPhoto)
+				rt.Post("/announce", r.groupHandler.SetGroupAnnounce)
+				rt.Post("/locked", r.groupHandler.SetGroupLocked)
+				rt.Post("/disappearing", r.groupHandler.SetDisappearingTimer)
+			})
 
 			// Convites de grupo
 			rt.Get("/invite/link", r.groupHandler.GetGroupInviteLink)
-			rt.Post("/invite/join", r.groupHandler.JoinGroupWithLink)
+			rt.With(r.idempotency.Handle).Post("/invite/join", r.groupHandler.JoinGroupWithLink)
 			rt.Post("/invite/info", r.groupHandler.GetGroupInviteInfo)
 		})
 	})
-
-	// Rota catch-all para 404
-	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(404)
-		w.Write([]byte(`{
-			"success": false,
-			"message": "Endpoint não encontrado",
-			"error": {
-				"code": "NOT_FOUND",
-				"details": "O endpoint solicitado não existe"
-			}
-		}`))
-	})
 }
 
 // swaggerDocHandler serve o JSON do Swagger
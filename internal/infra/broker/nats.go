@@ -0,0 +1,96 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// natsConnection publica os eventos no NATS JetStream.
+// Cada publicação aguarda o PubAck do JetStream, que confirma a gravação do evento na stream.
+type natsConnection struct {
+	url        string
+	prefix     string
+	streamName string
+
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+// newNATSConnection valida a URL nats://[usuário:senha@|token@]host:porta (tls:// para TLS)
+func newNATSConnection(opts Options) (*natsConnection, error) {
+	raw := opts.URL
+	if raw == "" {
+		raw = nats.DefaultURL
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nats URL: %w", err)
+	}
+	if u.Scheme != "nats" && u.Scheme != "tls" {
+		return nil, fmt.Errorf("invalid nats URL scheme %q", u.Scheme)
+	}
+	if opts.NATSStream == "" {
+		return nil, fmt.Errorf("nats stream name is required")
+	}
+
+	return &natsConnection{
+		url:        raw,
+		prefix:     opts.Topic,
+		streamName: opts.NATSStream,
+	}, nil
+}
+
+// dial conecta ao servidor e cria a stream que recebe os subjects <prefixo>.>, caso ainda não exista.
+// Quedas depois da conexão são tratadas pela reconexão do próprio cliente NATS.
+func (c *natsConnection) dial(ctx context.Context) error {
+	conn, err := nats.Connect(c.url,
+		nats.Name("zmeow"),
+		nats.Timeout(time.Until(deadline(ctx))),
+	)
+	if err != nil {
+		return err
+	}
+	c.conn = conn
+
+	if c.js, err = jetstream.New(conn); err != nil {
+		return err
+	}
+
+	_, err = c.js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:     c.streamName,
+		Subjects: []string{c.prefix + ".>"},
+		Storage:  jetstream.FileStorage,
+	})
+	if err != nil && !errors.Is(err, jetstream.ErrStreamNameAlreadyInUse) {
+		return fmt.Errorf("failed to create JetStream stream: %w", err)
+	}
+	return nil
+}
+
+// publish envia o evento ao subject <prefixo>.<sessionID>.<tipo> e aguarda o PubAck
+func (c *natsConnection) publish(ctx context.Context, msg Message) error {
+	_, err := c.js.Publish(ctx, c.prefix+"."+msg.RoutingKey(), msg.Body)
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *jetstream.APIError
+	if errors.As(err, &apiErr) || errors.Is(err, jetstream.ErrNoStreamResponse) {
+		return fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+	return err
+}
+
+func (c *natsConnection) close() {
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+		c.js = nil
+	}
+}
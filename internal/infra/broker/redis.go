@@ -0,0 +1,75 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisConnection publica os eventos em uma Redis Stream com XADD.
+// A resposta do XADD, com o ID da entrada, é a confirmação de que o evento foi gravado.
+type redisConnection struct {
+	options *redis.Options
+	stream  string
+	maxLen  int
+
+	client *redis.Client
+}
+
+// newRedisConnection interpreta a URL redis://[usuário:senha@]host:porta[/banco] (rediss:// para TLS)
+func newRedisConnection(opts Options) (*redisConnection, error) {
+	raw := opts.URL
+	if raw == "" {
+		raw = "redis://localhost:6379/0"
+	}
+	options, err := redis.ParseURL(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	// Os prazos das publicações vêm do contexto, que inclui o tempo da confirmação
+	options.ContextTimeoutEnabled = true
+	options.ClientName = "zmeow"
+
+	return &redisConnection{
+		options: options,
+		stream:  opts.Topic,
+		maxLen:  opts.RedisMaxLen,
+	}, nil
+}
+
+// dial cria o cliente e confirma com PING que o servidor aceita as credenciais e o banco
+func (c *redisConnection) dial(ctx context.Context) error {
+	c.client = redis.NewClient(c.options)
+	return c.client.Ping(ctx).Err()
+}
+
+// publish adiciona o evento à stream com os campos event, sessionId, routingKey e payload
+func (c *redisConnection) publish(ctx context.Context, msg Message) error {
+	err := c.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: c.stream,
+		MaxLen: int64(c.maxLen),
+		Approx: c.maxLen > 0,
+		Values: []interface{}{
+			"event", msg.EventType,
+			"sessionId", msg.SessionID.String(),
+			"routingKey", msg.RoutingKey(),
+			"payload", msg.Body,
+		},
+	}).Err()
+
+	// Respostas de erro do Redis indicam que o comando foi recusado, não que a conexão caiu
+	var redisErr redis.Error
+	if errors.As(err, &redisErr) {
+		return fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+	return err
+}
+
+func (c *redisConnection) close() {
+	if c.client != nil {
+		_ = c.client.Close()
+		c.client = nil
+	}
+}
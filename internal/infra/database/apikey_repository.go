@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/auth"
+)
+
+// apiKeyRepository implementa a interface APIKeyRepository
+type apiKeyRepository struct {
+	db *bun.DB
+}
+
+// NewAPIKeyRepository cria uma nova instância do repositório de chaves de API
+func NewAPIKeyRepository(db *bun.DB) auth.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+// Create cria uma nova chave no banco de dados
+func (r *apiKeyRepository) Create(ctx context.Context, key *auth.APIKey) error {
+	if key.ID == uuid.Nil {
+		key.ID = uuid.New()
+	}
+	key.CreatedAt = time.Now()
+	key.UpdatedAt = time.Now()
+	key.IsActive = true
+
+	_, err := r.db.NewInsert().Model(key).Exec(ctx)
+	return err
+}
+
+// GetByID busca uma chave pelo ID
+func (r *apiKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*auth.APIKey, error) {
+	key := new(auth.APIKey)
+	err := r.db.NewSelect().Model(key).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, auth.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+// GetByHash busca uma chave pelo hash do segredo
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*auth.APIKey, error) {
+	key := new(auth.APIKey)
+	err := r.db.NewSelect().Model(key).Where("\"keyHash\" = ?", keyHash).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, auth.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+// List retorna todas as chaves
+func (r *apiKeyRepository) List(ctx context.Context) ([]*auth.APIKey, error) {
+	var keys []*auth.APIKey
+	err := r.db.NewSelect().Model(&keys).Order("createdAt DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Update atualiza uma chave existente
+func (r *apiKeyRepository) Update(ctx context.Context, key *auth.APIKey) error {
+	key.UpdatedAt = time.Now()
+
+	_, err := r.db.NewUpdate().
+		Model(key).
+		Where("id = ?", key.ID).
+		Exec(ctx)
+
+	return err
+}
+
+// UpdateLastUsed atualiza a data do último uso de uma chave
+func (r *apiKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.NewUpdate().
+		Model((*auth.APIKey)(nil)).
+		Set("\"lastUsedAt\" = ?", time.Now()).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	return err
+}
@@ -0,0 +1,357 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/campaign"
+)
+
+// recipientInsertBatch limita a quantidade de destinatários inseridos por comando
+const recipientInsertBatch = 1000
+
+// campaignRepository implementa a interface Repository das campanhas
+type campaignRepository struct {
+	db *bun.DB
+}
+
+// NewCampaignRepository cria uma nova instância do repositório de campanhas
+func NewCampaignRepository(db *bun.DB) campaign.Repository {
+	return &campaignRepository{db: db}
+}
+
+// Create insere a campanha e seus destinatários em uma única transação
+func (r *campaignRepository) Create(ctx context.Context, c *campaign.Campaign, recipients []*campaign.Recipient) error {
+	now := time.Now()
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	if c.Status == "" {
+		c.Status = campaign.StatusRunning
+	}
+	c.Recipients = len(recipients)
+	c.CreatedAt = now
+	c.UpdatedAt = now
+
+	for i, recipient := range recipients {
+		recipient.ID = uuid.New()
+		recipient.CampaignID = c.ID
+		recipient.SessionID = c.SessionID
+		recipient.Seq = i + 1
+		recipient.Status = campaign.RecipientQueued
+		recipient.UpdatedAt = now
+	}
+
+	return r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(c).Exec(ctx); err != nil {
+			return err
+		}
+		for start := 0; start < len(recipients); start += recipientInsertBatch {
+			end := start + recipientInsertBatch
+			if end > len(recipients) {
+				end = len(recipients)
+			}
+			batch := recipients[start:end]
+			if _, err := tx.NewInsert().Model(&batch).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetByID busca uma campanha de uma sessão pelo ID
+func (r *campaignRepository) GetByID(ctx context.Context, sessionID, id uuid.UUID) (*campaign.Campaign, error) {
+	c := new(campaign.Campaign)
+	err := r.db.NewSelect().
+		Model(c).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, campaign.ErrCampaignNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// List retorna as campanhas que atendem ao filtro e o total encontrado
+func (r *campaignRepository) List(ctx context.Context, filter campaign.Filter) ([]*campaign.Campaign, int, error) {
+	var campaigns []*campaign.Campaign
+	query := r.db.NewSelect().
+		Model(&campaigns).
+		Where("\"sessionId\" = ?", filter.SessionID)
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	total, err := query.Order("createdAt DESC").ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return campaigns, total, nil
+}
+
+// Transition altera o status da campanha se o status atual permitir
+func (r *campaignRepository) Transition(ctx context.Context, sessionID, id uuid.UUID, from []campaign.Status, to campaign.Status) (*campaign.Campaign, error) {
+	c := new(campaign.Campaign)
+	var affected int64
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		now := time.Now()
+		query := tx.NewUpdate().
+			Model(c).
+			Set("status = ?", to).
+			Set("\"updatedAt\" = ?", now).
+			Where("id = ?", id).
+			Where("\"sessionId\" = ?", sessionID).
+			Where("status IN (?)", bun.In(from)).
+			Returning("*")
+		if to == campaign.StatusCompleted || to == campaign.StatusCanceled {
+			query = query.Set("\"completedAt\" = ?", now)
+		}
+
+		res, err := query.Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if affected, err = res.RowsAffected(); err != nil || affected == 0 {
+			return err
+		}
+
+		if to == campaign.StatusCanceled {
+			_, err = tx.NewUpdate().
+				Model((*campaign.Recipient)(nil)).
+				Set("status = ?", campaign.RecipientCanceled).
+				Set("\"updatedAt\" = ?", now).
+				Where("\"campaignId\" = ?", id).
+				Where("status = ?", campaign.RecipientQueued).
+				Exec(ctx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		// Diferenciar campanha inexistente de campanha em status incompatível
+		if _, err := r.GetByID(ctx, sessionID, id); err != nil {
+			return nil, err
+		}
+		return nil, campaign.ErrInvalidTransition
+	}
+	return c, nil
+}
+
+// CountRecipients retorna a quantidade de destinatários da campanha por status
+func (r *campaignRepository) CountRecipients(ctx context.Context, campaignID uuid.UUID) (map[campaign.RecipientStatus]int, error) {
+	var rows []struct {
+		Status campaign.RecipientStatus `bun:"status"`
+		Count  int                      `bun:"count"`
+	}
+	err := r.db.NewSelect().
+		Model((*campaign.Recipient)(nil)).
+		Column("status").
+		ColumnExpr("COUNT(*) AS count").
+		Where("\"campaignId\" = ?", campaignID).
+		Group("status").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[campaign.RecipientStatus]int, len(campaign.RecipientStatuses))
+	for _, status := range campaign.RecipientStatuses {
+		counts[status] = 0
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// ListRecipients retorna os destinatários que atendem ao filtro e o total encontrado
+func (r *campaignRepository) ListRecipients(ctx context.Context, filter campaign.RecipientFilter) ([]*campaign.Recipient, int, error) {
+	var recipients []*campaign.Recipient
+	query := r.db.NewSelect().
+		Model(&recipients).
+		Where("\"campaignId\" = ?", filter.CampaignID)
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	total, err := query.Order("seq ASC").ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return recipients, total, nil
+}
+
+// ListActiveSessions retorna as sessões com campanhas em andamento
+func (r *campaignRepository) ListActiveSessions(ctx context.Context) ([]uuid.UUID, error) {
+	var sessionIDs []uuid.UUID
+	err := r.db.NewSelect().
+		Model((*campaign.Campaign)(nil)).
+		ColumnExpr("DISTINCT \"sessionId\"").
+		Where("status = ?", campaign.StatusRunning).
+		Scan(ctx, &sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return sessionIDs, nil
+}
+
+// NextRecipient retorna o próximo destinatário na fila das campanhas em andamento da sessão, da campanha mais antiga à mais recente
+func (r *campaignRepository) NextRecipient(ctx context.Context, sessionID uuid.UUID) (*campaign.Recipient, error) {
+	recipient := new(campaign.Recipient)
+	err := r.db.NewSelect().
+		Model(recipient).
+		Join("JOIN zapcore_campaigns AS cp ON cp.id = cr.\"campaignId\"").
+		Where("cr.\"sessionId\" = ?", sessionID).
+		Where("cr.status = ?", campaign.RecipientQueued).
+		Where("cp.status = ?", campaign.StatusRunning).
+		OrderExpr("cp.\"createdAt\" ASC, cr.seq ASC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return recipient, nil
+}
+
+// ClaimRecipient marca o destinatário como em envio se ele ainda estiver na fila e a campanha em andamento
+func (r *campaignRepository) ClaimRecipient(ctx context.Context, recipient *campaign.Recipient) (bool, error) {
+	res, err := r.db.NewUpdate().
+		Model(recipient).
+		Set("status = ?", campaign.RecipientSending).
+		Set("attempts = attempts + 1").
+		Set("\"updatedAt\" = ?", time.Now()).
+		WherePK().
+		Where("status = ?", campaign.RecipientQueued).
+		Where("EXISTS (SELECT 1 FROM zapcore_campaigns AS cp WHERE cp.id = ?TableAlias.\"campaignId\" AND cp.status = ?)", campaign.StatusRunning).
+		Returning("status, attempts, \"updatedAt\"").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// UpdateRecipient persiste o resultado do envio para o destinatário
+func (r *campaignRepository) UpdateRecipient(ctx context.Context, recipient *campaign.Recipient) error {
+	recipient.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(recipient).
+		Column("status", "attempts", "messageId", "lastError", "sentAt", "updatedAt").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// ApplyDeliveryStatus avança para delivered ou read os destinatários da mensagem, sem regredir o status
+func (r *campaignRepository) ApplyDeliveryStatus(ctx context.Context, sessionID uuid.UUID, messageID string, status campaign.RecipientStatus) (int, error) {
+	now := time.Now()
+	query := r.db.NewUpdate().
+		Model((*campaign.Recipient)(nil)).
+		Set("status = ?", status).
+		Set("\"updatedAt\" = ?", now).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("\"messageId\" = ?", messageID)
+
+	switch status {
+	case campaign.RecipientDelivered:
+		query = query.
+			Set("\"deliveredAt\" = ?", now).
+			Where("status = ?", campaign.RecipientSent)
+	case campaign.RecipientRead:
+		query = query.
+			Set("\"deliveredAt\" = COALESCE(\"deliveredAt\", ?)", now).
+			Set("\"readAt\" = ?", now).
+			Where("status IN (?)", bun.In([]campaign.RecipientStatus{campaign.RecipientSent, campaign.RecipientDelivered}))
+	default:
+		return 0, nil
+	}
+
+	res, err := query.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+// CompleteFinished marca como concluídas as campanhas em andamento sem destinatários pendentes
+func (r *campaignRepository) CompleteFinished(ctx context.Context) (int, error) {
+	now := time.Now()
+	res, err := r.db.NewUpdate().
+		Model((*campaign.Campaign)(nil)).
+		Set("status = ?", campaign.StatusCompleted).
+		Set("\"completedAt\" = ?", now).
+		Set("\"updatedAt\" = ?", now).
+		Where("status = ?", campaign.StatusRunning).
+		Where("NOT EXISTS (SELECT 1 FROM zapcore_campaign_recipients AS cr WHERE cr.\"campaignId\" = ?TableAlias.id AND cr.status IN (?))",
+			bun.In([]campaign.RecipientStatus{campaign.RecipientQueued, campaign.RecipientSending})).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+// FailInterrupted marca como falhos os destinatários que estavam em envio quando o processo foi encerrado
+func (r *campaignRepository) FailInterrupted(ctx context.Context, reason string) (int, error) {
+	res, err := r.db.NewUpdate().
+		Model((*campaign.Recipient)(nil)).
+		Set("status = ?", campaign.RecipientFailed).
+		Set("\"lastError\" = ?", reason).
+		Set("\"updatedAt\" = ?", time.Now()).
+		Where("status = ?", campaign.RecipientSending).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/chat"
+)
+
+// chatRepository implementa a interface ChatRepository
+type chatRepository struct {
+	db *bun.DB
+}
+
+// NewChatRepository cria uma nova instância do repositório de chats
+func NewChatRepository(db *bun.DB) chat.ChatRepository {
+	return &chatRepository{db: db}
+}
+
+// Upsert cria o chat ou atualiza o existente com o mesmo JID na sessão
+func (r *chatRepository) Upsert(ctx context.Context, c *chat.Chat) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	now := time.Now()
+	if c.CreatedAt.IsZero() {
+		c.CreatedAt = now
+	}
+	c.UpdatedAt = now
+
+	_, err := r.db.NewInsert().
+		Model(c).
+		On("CONFLICT (\"sessionId\", jid) DO UPDATE").
+		Set("name = COALESCE(NULLIF(EXCLUDED.name, ''), c.name)").
+		Set("\"isGroup\" = EXCLUDED.\"isGroup\"").
+		Set("\"unreadCount\" = EXCLUDED.\"unreadCount\"").
+		Set("archived = EXCLUDED.archived").
+		Set("pinned = EXCLUDED.pinned").
+		Set("\"muteEndTime\" = EXCLUDED.\"muteEndTime\"").
+		Set("\"markedUnread\" = EXCLUDED.\"markedUnread\"").
+		Set("\"lastMessageAt\" = GREATEST(c.\"lastMessageAt\", EXCLUDED.\"lastMessageAt\")").
+		Set("\"updatedAt\" = EXCLUDED.\"updatedAt\"").
+		Exec(ctx)
+	return err
+}
+
+// GetByJID busca um chat da sessão pelo JID
+func (r *chatRepository) GetByJID(ctx context.Context, sessionID uuid.UUID, jid string) (*chat.Chat, error) {
+	c := new(chat.Chat)
+	err := r.db.NewSelect().
+		Model(c).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("jid = ?", jid).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, chat.ErrChatNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// List retorna os chats que atendem ao filtro: fixados primeiro, depois pela mensagem mais recente
+func (r *chatRepository) List(ctx context.Context, filter chat.ChatFilter) ([]*chat.Chat, error) {
+	var chats []*chat.Chat
+	query := r.db.NewSelect().
+		Model(&chats).
+		Where("\"sessionId\" = ?", filter.SessionID)
+
+	if filter.Archived != nil {
+		query = query.Where("archived = ?", *filter.Archived)
+	}
+	if filter.Unread {
+		query = query.Where("(\"unreadCount\" > 0 OR \"markedUnread\")")
+	}
+	if filter.IsGroup != nil {
+		query = query.Where("\"isGroup\" = ?", *filter.IsGroup)
+	}
+	if filter.Search != "" {
+		pattern := "%" + filter.Search + "%"
+		query = query.Where("(name ILIKE ? OR jid ILIKE ?)", pattern, pattern)
+	}
+
+	query = query.OrderExpr("pinned DESC, \"lastMessageAt\" DESC NULLS LAST, jid ASC")
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	return chats, nil
+}
+
+// RecordMessage registra uma mensagem no chat, criando-o se necessário
+func (r *chatRepository) RecordMessage(ctx context.Context, sessionID uuid.UUID, jid string, isGroup, fromMe bool, at time.Time) error {
+	c := newChat(sessionID, jid)
+	c.IsGroup = isGroup
+	c.LastMessageAt = &at
+	if !fromMe {
+		c.UnreadCount = 1
+	}
+
+	_, err := r.db.NewInsert().
+		Model(c).
+		On("CONFLICT (\"sessionId\", jid) DO UPDATE").
+		Set("\"lastMessageAt\" = GREATEST(c.\"lastMessageAt\", EXCLUDED.\"lastMessageAt\")").
+		Set("\"unreadCount\" = CASE WHEN ? THEN 0 ELSE c.\"unreadCount\" + 1 END", fromMe).
+		Set("\"markedUnread\" = c.\"markedUnread\" AND NOT ?", fromMe).
+		Set("\"updatedAt\" = EXCLUDED.\"updatedAt\"").
+		Exec(ctx)
+	return err
+}
+
+// SetArchived arquiva ou desarquiva o chat; arquivar também desafixa, como no WhatsApp
+func (r *chatRepository) SetArchived(ctx context.Context, sessionID uuid.UUID, jid string, archived bool) error {
+	c := newChat(sessionID, jid)
+	c.Archived = archived
+	return r.upsertColumns(ctx, c,
+		"archived = EXCLUDED.archived",
+		"pinned = c.pinned AND NOT EXCLUDED.archived",
+	)
+}
+
+// SetPinned fixa ou desafixa o chat
+func (r *chatRepository) SetPinned(ctx context.Context, sessionID uuid.UUID, jid string, pinned bool) error {
+	c := newChat(sessionID, jid)
+	c.Pinned = pinned
+	return r.upsertColumns(ctx, c, "pinned = EXCLUDED.pinned")
+}
+
+// SetMuteEndTime silencia o chat até o horário informado (nil remove o silenciamento)
+func (r *chatRepository) SetMuteEndTime(ctx context.Context, sessionID uuid.UUID, jid string, muteEndTime *time.Time) error {
+	c := newChat(sessionID, jid)
+	c.MuteEndTime = muteEndTime
+	return r.upsertColumns(ctx, c, "\"muteEndTime\" = EXCLUDED.\"muteEndTime\"")
+}
+
+// SetRead marca o chat como lido (zerando as não lidas) ou como não lido
+func (r *chatRepository) SetRead(ctx context.Context, sessionID uuid.UUID, jid string, read bool) error {
+	c := newChat(sessionID, jid)
+	c.MarkedUnread = !read
+	if read {
+		return r.upsertColumns(ctx, c, "\"unreadCount\" = 0", "\"markedUnread\" = false")
+	}
+	return r.upsertColumns(ctx, c, "\"markedUnread\" = true")
+}
+
+// Clear zera as não lidas do chat após a limpeza das mensagens
+func (r *chatRepository) Clear(ctx context.Context, sessionID uuid.UUID, jid string) error {
+	return r.upsertColumns(ctx, newChat(sessionID, jid), "\"unreadCount\" = 0", "\"markedUnread\" = false")
+}
+
+// Delete remove o chat da sessão
+func (r *chatRepository) Delete(ctx context.Context, sessionID uuid.UUID, jid string) error {
+	_, err := r.db.NewDelete().
+		Model((*chat.Chat)(nil)).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("jid = ?", jid).
+		Exec(ctx)
+	return err
+}
+
+// upsertColumns cria o chat com os valores informados ou, se já existir, aplica apenas as atribuições em sets
+func (r *chatRepository) upsertColumns(ctx context.Context, c *chat.Chat, sets ...string) error {
+	query := r.db.NewInsert().
+		Model(c).
+		On("CONFLICT (\"sessionId\", jid) DO UPDATE")
+	for _, set := range sets {
+		query = query.Set(set)
+	}
+	_, err := query.Set("\"updatedAt\" = EXCLUDED.\"updatedAt\"").Exec(ctx)
+	return err
+}
+
+// newChat monta o registro mínimo de um chat ainda desconhecido
+func newChat(sessionID uuid.UUID, jid string) *chat.Chat {
+	now := time.Now()
+	return &chat.Chat{
+		ID:        uuid.New(),
+		SessionID: sessionID,
+		JID:       jid,
+		IsGroup:   strings.HasSuffix(jid, "@g.us"),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
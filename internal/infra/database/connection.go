@@ -9,7 +9,16 @@ import (
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
 
+	"zmeow/internal/domain/auth"
+	"zmeow/internal/domain/campaign"
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/idempotency"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/schedule"
 	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
 	"zmeow/pkg/logger"
 )
 
@@ -48,5 +57,353 @@ func RunMigrations(db *bun.DB) error {
 		return fmt.Errorf("failed to create sessions table: %w", err)
 	}
 
+	// Colunas adicionadas após a criação inicial da tabela de sessões
+	if err := addColumnIfNotExists(db, "zapcore_sessions", "autoDownloadMedia", "boolean NOT NULL DEFAULT false"); err != nil {
+		return err
+	}
+	if err := addColumnIfNotExists(db, "zapcore_sessions", "callPolicy", "varchar(20) NOT NULL DEFAULT 'ignore'"); err != nil {
+		return err
+	}
+	if err := addColumnIfNotExists(db, "zapcore_sessions", "callReplyMessage", "text"); err != nil {
+		return err
+	}
+	if err := addColumnIfNotExists(db, "zapcore_sessions", "historySyncDays", "integer NOT NULL DEFAULT 30"); err != nil {
+		return err
+	}
+
+	// Criar tabela de chaves de API se não existir
+	_, err = db.NewCreateTable().
+		Model((*auth.APIKey)(nil)).
+		IfNotExists().
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create api keys table: %w", err)
+	}
+
+	// Criar tabela de webhooks se não existir
+	_, err = db.NewCreateTable().
+		Model((*webhook.Webhook)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhooks table: %w", err)
+	}
+
+	// Colunas adicionadas após a criação inicial da tabela de webhooks
+	if err := addColumnIfNotExists(db, "zapcore_webhooks", "previousSecret", "varchar(255)"); err != nil {
+		return err
+	}
+	if err := addColumnIfNotExists(db, "zapcore_webhooks", "previousSecretExpiresAt", "timestamptz"); err != nil {
+		return err
+	}
+	if err := addColumnIfNotExists(db, "zapcore_webhooks", "events", "jsonb"); err != nil {
+		return err
+	}
+	if err := addColumnIfNotExists(db, "zapcore_webhooks", "headers", "jsonb"); err != nil {
+		return err
+	}
+
+	// Uma sessão pode ter vários webhooks: remover a unicidade de sessionId das tabelas antigas
+	if err := dropWebhookSessionUnique(db); err != nil {
+		return err
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*webhook.Webhook)(nil)).
+		Index("idx_webhooks_session").
+		IfNotExists().
+		Column("sessionId", "createdAt").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhooks index: %w", err)
+	}
+
+	// Criar tabela do outbox de entregas de webhook se não existir
+	_, err = db.NewCreateTable().
+		Model((*webhook.Delivery)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhook deliveries table: %w", err)
+	}
+
+	// Índice usado pelos workers para localizar a próxima entrega pendente de cada sessão
+	_, err = db.NewCreateIndex().
+		Model((*webhook.Delivery)(nil)).
+		Index("idx_webhook_deliveries_pending").
+		IfNotExists().
+		Column("sessionId", "seq").
+		Where("status = 'pending'").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhook deliveries index: %w", err)
+	}
+
+	// Entregas passam a pertencer a um webhook; as existentes são atribuídas ao webhook da sessão
+	if err := addColumnIfNotExists(db, "zapcore_webhook_deliveries", "webhookId", "uuid"); err != nil {
+		return err
+	}
+	_, err = db.ExecContext(context.Background(), `
+		UPDATE zapcore_webhook_deliveries AS d SET "webhookId" = w.id
+		FROM zapcore_webhooks AS w
+		WHERE d."webhookId" IS NULL AND d."sessionId" = w."sessionId"
+		AND w.id = (SELECT id FROM zapcore_webhooks WHERE "sessionId" = d."sessionId" ORDER BY "createdAt" ASC LIMIT 1)`)
+	if err != nil {
+		return fmt.Errorf("failed to assign webhook deliveries to webhooks: %w", err)
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*webhook.Delivery)(nil)).
+		Index("idx_webhook_deliveries_pending_webhook").
+		IfNotExists().
+		Column("webhookId", "seq").
+		Where("status = 'pending'").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create webhook deliveries index: %w", err)
+	}
+
+	// Criar tabela de histórico de mensagens se não existir
+	_, err = db.NewCreateTable().
+		Model((*message.Message)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create messages table: %w", err)
+	}
+
+	// Índice usado na consulta do histórico por chat e na paginação por cursor
+	_, err = db.NewCreateIndex().
+		Model((*message.Message)(nil)).
+		Index("idx_messages_session_chat_timestamp").
+		IfNotExists().
+		Column("sessionId", "chatJid", "timestamp", "seq").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create messages index: %w", err)
+	}
+
+	// Colunas de status de entrega adicionadas após a criação inicial da tabela de mensagens
+	if err := addColumnIfNotExists(db, "zapcore_messages", "status", "varchar(20)"); err != nil {
+		return err
+	}
+	if err := addColumnIfNotExists(db, "zapcore_messages", "statusUpdatedAt", "timestamptz"); err != nil {
+		return err
+	}
+
+	// Criar tabela de recibos por destinatário se não existir
+	_, err = db.NewCreateTable().
+		Model((*message.MessageReceipt)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create message receipts table: %w", err)
+	}
+
+	// Criar tabela de chats se não existir
+	_, err = db.NewCreateTable().
+		Model((*chat.Chat)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create chats table: %w", err)
+	}
+
+	// Colunas adicionadas após a criação inicial da tabela de chats
+	if err := addColumnIfNotExists(db, "zapcore_chats", "markedUnread", "boolean NOT NULL DEFAULT false"); err != nil {
+		return err
+	}
+
+	// Índice usado na listagem de chats de cada sessão
+	_, err = db.NewCreateIndex().
+		Model((*chat.Chat)(nil)).
+		Index("idx_chats_session_last_message").
+		IfNotExists().
+		Column("sessionId", "lastMessageAt").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create chats index: %w", err)
+	}
+
+	// Criar tabela de contatos se não existir
+	_, err = db.NewCreateTable().
+		Model((*contact.Contact)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create contacts table: %w", err)
+	}
+
+	// Criar tabela da fila de envio assíncrono se não existir
+	_, err = db.NewCreateTable().
+		Model((*outbound.Job)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create outbound messages table: %w", err)
+	}
+
+	// Índice usado pelos workers para localizar o próximo envio de cada sessão
+	_, err = db.NewCreateIndex().
+		Model((*outbound.Job)(nil)).
+		Index("idx_outbound_messages_queued").
+		IfNotExists().
+		Column("sessionId", "seq").
+		Where("status = 'queued'").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create outbound messages index: %w", err)
+	}
+
+	// Criar tabela dos envios agendados se não existir
+	_, err = db.NewCreateTable().
+		Model((*schedule.ScheduledMessage)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled messages table: %w", err)
+	}
+
+	// Índice usado pelo scheduler para localizar os agendamentos vencidos
+	_, err = db.NewCreateIndex().
+		Model((*schedule.ScheduledMessage)(nil)).
+		Index("idx_scheduled_messages_due").
+		IfNotExists().
+		Column("nextAttemptAt").
+		Where("status = 'scheduled'").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled messages index: %w", err)
+	}
+
+	// Criar tabelas das campanhas e de seus destinatários se não existirem
+	_, err = db.NewCreateTable().
+		Model((*campaign.Campaign)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create campaigns table: %w", err)
+	}
+
+	_, err = db.NewCreateTable().
+		Model((*campaign.Recipient)(nil)).
+		IfNotExists().
+		ForeignKey(`("campaignId") REFERENCES zapcore_campaigns (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create campaign recipients table: %w", err)
+	}
+
+	// Índice usado pelos workers para localizar o próximo destinatário de cada campanha
+	_, err = db.NewCreateIndex().
+		Model((*campaign.Recipient)(nil)).
+		Index("idx_campaign_recipients_queued").
+		IfNotExists().
+		Column("sessionId", "campaignId", "seq").
+		Where("status = 'queued'").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create campaign recipients queue index: %w", err)
+	}
+
+	// Índice usado para aplicar os recibos de entrega e leitura aos destinatários
+	_, err = db.NewCreateIndex().
+		Model((*campaign.Recipient)(nil)).
+		Index("idx_campaign_recipients_message").
+		IfNotExists().
+		Column("sessionId", "messageId").
+		Where("\"messageId\" <> ''").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create campaign recipients message index: %w", err)
+	}
+
+	// Criar tabela das chaves de idempotência se não existir
+	_, err = db.NewCreateTable().
+		Model((*idempotency.Record)(nil)).
+		IfNotExists().
+		ForeignKey(`("sessionId") REFERENCES zapcore_sessions (id) ON DELETE CASCADE`).
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create idempotency keys table: %w", err)
+	}
+
+	// Índice usado na remoção das chaves vencidas
+	_, err = db.NewCreateIndex().
+		Model((*idempotency.Record)(nil)).
+		Index("idx_idempotency_keys_expires").
+		IfNotExists().
+		Column("expiresAt").
+		Exec(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to create idempotency keys index: %w", err)
+	}
+
+	return nil
+}
+
+// addColumnIfNotExists adiciona uma coluna a uma tabela existente, caso ainda não exista
+func addColumnIfNotExists(db *bun.DB, table, column, columnType string) error {
+	_, err := db.ExecContext(context.Background(),
+		fmt.Sprintf("ALTER TABLE ? ADD COLUMN IF NOT EXISTS ? %s", columnType),
+		bun.Ident(table), bun.Ident(column),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add column %s to %s: %w", column, table, err)
+	}
+	return nil
+}
+
+// dropWebhookSessionUnique remove a restrição de unicidade de sessionId criada nas versões com um webhook por sessão
+func dropWebhookSessionUnique(db *bun.DB) error {
+	_, err := db.ExecContext(context.Background(), `
+		DO $$
+		DECLARE constraint_name text;
+		BEGIN
+			SELECT con.conname INTO constraint_name
+			FROM pg_constraint con
+			JOIN pg_attribute att ON att.attrelid = con.conrelid AND att.attnum = con.conkey[1]
+			WHERE con.conrelid = 'zapcore_webhooks'::regclass
+				AND con.contype = 'u'
+				AND array_length(con.conkey, 1) = 1
+				AND att.attname = 'sessionId';
+			IF constraint_name IS NOT NULL THEN
+				EXECUTE format('ALTER TABLE zapcore_webhooks DROP CONSTRAINT %I', constraint_name);
+			END IF;
+		END $$`)
+	if err != nil {
+		return fmt.Errorf("failed to drop webhooks sessionId unique constraint: %w", err)
+	}
 	return nil
 }
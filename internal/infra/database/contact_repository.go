@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/contact"
+)
+
+// contactRepository implementa a interface ContactRepository
+type contactRepository struct {
+	db *bun.DB
+}
+
+// NewContactRepository cria uma nova instância do repositório de contatos
+func NewContactRepository(db *bun.DB) contact.ContactRepository {
+	return &contactRepository{db: db}
+}
+
+// Upsert cria o contato ou atualiza o existente com o mesmo JID na sessão
+func (r *contactRepository) Upsert(ctx context.Context, c *contact.Contact) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	now := time.Now()
+	if c.CreatedAt.IsZero() {
+		c.CreatedAt = now
+	}
+	c.UpdatedAt = now
+
+	_, err := r.db.NewInsert().
+		Model(c).
+		On("CONFLICT (\"sessionId\", jid) DO UPDATE").
+		Set("\"pushName\" = COALESCE(NULLIF(EXCLUDED.\"pushName\", ''), ct.\"pushName\")").
+		Set("\"fullName\" = COALESCE(NULLIF(EXCLUDED.\"fullName\", ''), ct.\"fullName\")").
+		Set("\"businessName\" = COALESCE(NULLIF(EXCLUDED.\"businessName\", ''), ct.\"businessName\")").
+		Set("\"updatedAt\" = EXCLUDED.\"updatedAt\"").
+		Exec(ctx)
+	return err
+}
+
+// GetByJID busca um contato da sessão pelo JID
+func (r *contactRepository) GetByJID(ctx context.Context, sessionID uuid.UUID, jid string) (*contact.Contact, error) {
+	c := new(contact.Contact)
+	err := r.db.NewSelect().
+		Model(c).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("jid = ?", jid).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, contact.ErrContactNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// List retorna os contatos que atendem ao filtro, ordenados pelo nome
+func (r *contactRepository) List(ctx context.Context, filter contact.ContactFilter) ([]*contact.Contact, error) {
+	var contacts []*contact.Contact
+	query := r.db.NewSelect().
+		Model(&contacts).
+		Where("\"sessionId\" = ?", filter.SessionID)
+
+	if filter.Search != "" {
+		pattern := "%" + filter.Search + "%"
+		query = query.Where("(\"fullName\" ILIKE ? OR \"pushName\" ILIKE ? OR \"businessName\" ILIKE ? OR jid ILIKE ?)",
+			pattern, pattern, pattern, pattern)
+	}
+
+	query = query.OrderExpr("COALESCE(NULLIF(\"fullName\", ''), NULLIF(\"businessName\", ''), NULLIF(\"pushName\", ''), jid) ASC, jid ASC")
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/idempotency"
+)
+
+// idempotencyRepository implementa a interface Repository das chaves de idempotência
+type idempotencyRepository struct {
+	db *bun.DB
+}
+
+// NewIdempotencyRepository cria uma nova instância do repositório de chaves de idempotência
+func NewIdempotencyRepository(db *bun.DB) idempotency.Repository {
+	return &idempotencyRepository{db: db}
+}
+
+// Reserve grava a chave como em processamento, substituindo uma chave expirada; retorna o registro existente se a chave ainda for válida
+func (r *idempotencyRepository) Reserve(ctx context.Context, record *idempotency.Record) (*idempotency.Record, error) {
+	record.Status = idempotency.StatusProcessing
+	record.StatusCode = 0
+	record.ContentType = ""
+	record.Response = nil
+
+	res, err := r.db.NewInsert().
+		Model(record).
+		On("CONFLICT (\"sessionId\", key) DO UPDATE").
+		Set("\"requestHash\" = EXCLUDED.\"requestHash\"").
+		Set("method = EXCLUDED.method").
+		Set("path = EXCLUDED.path").
+		Set("status = EXCLUDED.status").
+		Set("\"statusCode\" = EXCLUDED.\"statusCode\"").
+		Set("\"contentType\" = EXCLUDED.\"contentType\"").
+		Set("response = EXCLUDED.response").
+		Set("\"createdAt\" = EXCLUDED.\"createdAt\"").
+		Set("\"expiresAt\" = EXCLUDED.\"expiresAt\"").
+		Where("ik.\"expiresAt\" <= ?", record.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected > 0 {
+		return nil, nil
+	}
+
+	existing := new(idempotency.Record)
+	err = r.db.NewSelect().
+		Model(existing).
+		Where("\"sessionId\" = ?", record.SessionID).
+		Where("key = ?", record.Key).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// A chave foi liberada entre as duas consultas; a nova tentativa do cliente a reservará
+			return nil, idempotency.ErrRequestInProgress
+		}
+		return nil, err
+	}
+	return existing, nil
+}
+
+// Complete grava a resposta da requisição original da chave
+func (r *idempotencyRepository) Complete(ctx context.Context, record *idempotency.Record) error {
+	record.Status = idempotency.StatusCompleted
+	_, err := r.db.NewUpdate().
+		Model(record).
+		Column("status", "statusCode", "contentType", "response").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Release remove a chave, permitindo que a requisição seja refeita
+func (r *idempotencyRepository) Release(ctx context.Context, sessionID uuid.UUID, key string) error {
+	_, err := r.db.NewDelete().
+		Model((*idempotency.Record)(nil)).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("key = ?", key).
+		Exec(ctx)
+	return err
+}
+
+// DeleteExpired remove as chaves vencidas
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	res, err := r.db.NewDelete().
+		Model((*idempotency.Record)(nil)).
+		Where("\"expiresAt\" <= ?", now).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
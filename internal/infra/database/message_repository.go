@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/message"
+)
+
+// messageRepository implementa a interface MessageRepository
+type messageRepository struct {
+	db *bun.DB
+}
+
+// NewMessageRepository cria uma nova instância do repositório de histórico de mensagens
+func NewMessageRepository(db *bun.DB) message.MessageRepository {
+	return &messageRepository{db: db}
+}
+
+// Save registra uma mensagem, ignorando duplicatas do mesmo ID na sessão
+func (r *messageRepository) Save(ctx context.Context, msg *message.Message) error {
+	if msg.ID == uuid.Nil {
+		msg.ID = uuid.New()
+	}
+
+	_, err := r.db.NewInsert().
+		Model(msg).
+		On("CONFLICT (\"sessionId\", \"messageId\") DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+// SaveBatch registra várias mensagens em uma única operação, ignorando duplicatas
+func (r *messageRepository) SaveBatch(ctx context.Context, msgs []*message.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	for _, msg := range msgs {
+		if msg.ID == uuid.Nil {
+			msg.ID = uuid.New()
+		}
+	}
+
+	_, err := r.db.NewInsert().
+		Model(&msgs).
+		On("CONFLICT (\"sessionId\", \"messageId\") DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+// GetByMessageID busca uma mensagem da sessão pelo ID do WhatsApp
+func (r *messageRepository) GetByMessageID(ctx context.Context, sessionID uuid.UUID, messageID string) (*message.Message, error) {
+	msg := new(message.Message)
+	err := r.db.NewSelect().
+		Model(msg).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("\"messageId\" = ?", messageID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, message.ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return msg, nil
+}
+
+// List retorna as mensagens que atendem ao filtro, das mais recentes para as mais antigas.
+// A ordenação usa (timestamp, seq) para que os cursores sejam estáveis entre mensagens do mesmo segundo.
+func (r *messageRepository) List(ctx context.Context, filter message.MessageFilter) ([]*message.Message, error) {
+	var messages []*message.Message
+	query := r.db.NewSelect().
+		Model(&messages).
+		Where("\"sessionId\" = ?", filter.SessionID)
+
+	if filter.ChatJID != "" {
+		query = query.Where("\"chatJid\" = ?", filter.ChatJID)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.Direction != "" {
+		query = query.Where("direction = ?", filter.Direction)
+	}
+
+	// Com after, buscamos as mais antigas posteriores ao cursor e invertemos para manter a ordem da resposta
+	ascending := false
+	switch {
+	case filter.Before != nil:
+		query = query.Where("(\"timestamp\", seq) < (?, ?)", filter.Before.Timestamp, filter.Before.Seq)
+	case filter.After != nil:
+		query = query.Where("(\"timestamp\", seq) > (?, ?)", filter.After.Timestamp, filter.After.Seq)
+		ascending = true
+	}
+
+	if ascending {
+		query = query.OrderExpr("\"timestamp\" ASC, seq ASC")
+	} else {
+		query = query.OrderExpr("\"timestamp\" DESC, seq DESC")
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if ascending {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+	return messages, nil
+}
+
+// DeleteByChat remove do histórico as mensagens de um chat da sessão
+func (r *messageRepository) DeleteByChat(ctx context.Context, sessionID uuid.UUID, chatJID string) error {
+	_, err := r.db.NewDelete().
+		Model((*message.Message)(nil)).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("\"chatJid\" = ?", chatJID).
+		Exec(ctx)
+	return err
+}
+
+// UpdateStatus atualiza o status agregado de entrega da mensagem
+func (r *messageRepository) UpdateStatus(ctx context.Context, sessionID uuid.UUID, messageID string, status message.Status, updatedAt time.Time) error {
+	result, err := r.db.NewUpdate().
+		Model((*message.Message)(nil)).
+		Set("status = ?", status).
+		Set("\"statusUpdatedAt\" = ?", updatedAt).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("\"messageId\" = ?", messageID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return message.ErrMessageNotFound
+	}
+	return nil
+}
+
+// UpdateContent substitui o conteúdo normalizado da mensagem
+func (r *messageRepository) UpdateContent(ctx context.Context, sessionID uuid.UUID, messageID string, content *message.InboundMessage) error {
+	result, err := r.db.NewUpdate().
+		Model((*message.Message)(nil)).
+		Set("content = ?", content).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("\"messageId\" = ?", messageID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return message.ErrMessageNotFound
+	}
+	return nil
+}
+
+// GetReceipt busca o status da mensagem para um destinatário
+func (r *messageRepository) GetReceipt(ctx context.Context, sessionID uuid.UUID, messageID, recipientJID string) (*message.MessageReceipt, error) {
+	receipt := new(message.MessageReceipt)
+	err := r.db.NewSelect().
+		Model(receipt).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("\"messageId\" = ?", messageID).
+		Where("\"recipientJid\" = ?", recipientJID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, message.ErrReceiptNotFound
+		}
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// SaveReceipt cria ou atualiza o status da mensagem para um destinatário
+func (r *messageRepository) SaveReceipt(ctx context.Context, receipt *message.MessageReceipt) error {
+	if receipt.ID == uuid.Nil {
+		receipt.ID = uuid.New()
+	}
+
+	_, err := r.db.NewInsert().
+		Model(receipt).
+		On("CONFLICT (\"sessionId\", \"messageId\", \"recipientJid\") DO UPDATE").
+		Set("status = EXCLUDED.status").
+		Set("\"updatedAt\" = EXCLUDED.\"updatedAt\"").
+		Exec(ctx)
+	return err
+}
+
+// ListReceipts retorna o status da mensagem para cada destinatário
+func (r *messageRepository) ListReceipts(ctx context.Context, sessionID uuid.UUID, messageID string) ([]*message.MessageReceipt, error) {
+	var receipts []*message.MessageReceipt
+	err := r.db.NewSelect().
+		Model(&receipts).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("\"messageId\" = ?", messageID).
+		Order("recipientJid").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
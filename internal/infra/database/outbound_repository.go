@@ -0,0 +1,181 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/outbound"
+)
+
+// outboundRepository implementa a interface JobRepository
+type outboundRepository struct {
+	db *bun.DB
+}
+
+// NewOutboundRepository cria uma nova instância do repositório da fila de envio
+func NewOutboundRepository(db *bun.DB) outbound.JobRepository {
+	return &outboundRepository{db: db}
+}
+
+// Enqueue insere um novo job na fila
+func (r *outboundRepository) Enqueue(ctx context.Context, job *outbound.Job) error {
+	now := time.Now()
+	if job.ID == uuid.Nil {
+		job.ID = uuid.New()
+	}
+	if job.Status == "" {
+		job.Status = outbound.JobStatusQueued
+	}
+	if job.NextAttemptAt.IsZero() {
+		job.NextAttemptAt = now
+	}
+	job.CreatedAt = now
+	job.UpdatedAt = now
+
+	_, err := r.db.NewInsert().Model(job).Returning("seq").Exec(ctx)
+	return err
+}
+
+// GetByID busca um job de uma sessão pelo ID
+func (r *outboundRepository) GetByID(ctx context.Context, sessionID, id uuid.UUID) (*outbound.Job, error) {
+	job := new(outbound.Job)
+	err := r.db.NewSelect().
+		Model(job).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, outbound.ErrJobNotFound
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// List retorna os jobs que atendem ao filtro e o total encontrado
+func (r *outboundRepository) List(ctx context.Context, filter outbound.JobFilter) ([]*outbound.Job, int, error) {
+	var jobs []*outbound.Job
+	query := r.db.NewSelect().
+		Model(&jobs).
+		Where("\"sessionId\" = ?", filter.SessionID)
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Kind != "" {
+		query = query.Where("kind = ?", filter.Kind)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	total, err := query.Order("seq DESC").ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+// ListDueSessions retorna as sessões com jobs na fila cujo horário de envio já chegou
+func (r *outboundRepository) ListDueSessions(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	var sessionIDs []uuid.UUID
+	err := r.db.NewSelect().
+		Model((*outbound.Job)(nil)).
+		ColumnExpr("DISTINCT \"sessionId\"").
+		Where("status = ?", outbound.JobStatusQueued).
+		Where("\"nextAttemptAt\" <= ?", now).
+		Scan(ctx, &sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return sessionIDs, nil
+}
+
+// NextDue retorna o job mais antigo da fila da sessão cujo horário de envio já chegou
+func (r *outboundRepository) NextDue(ctx context.Context, sessionID uuid.UUID, now time.Time) (*outbound.Job, error) {
+	job := new(outbound.Job)
+	err := r.db.NewSelect().
+		Model(job).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("status = ?", outbound.JobStatusQueued).
+		Where("\"nextAttemptAt\" <= ?", now).
+		Order("seq ASC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// Update persiste o resultado de uma tentativa de envio
+func (r *outboundRepository) Update(ctx context.Context, job *outbound.Job) error {
+	job.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(job).
+		Column("status", "attempts", "nextAttemptAt", "messageId", "lastError", "sentAt", "updatedAt").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Cancel cancela um job que ainda está na fila
+func (r *outboundRepository) Cancel(ctx context.Context, sessionID, id uuid.UUID) (*outbound.Job, error) {
+	job := new(outbound.Job)
+	res, err := r.db.NewUpdate().
+		Model(job).
+		Set("status = ?", outbound.JobStatusCanceled).
+		Set("\"updatedAt\" = ?", time.Now()).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("status = ?", outbound.JobStatusQueued).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		// Diferenciar job inexistente de job que já saiu da fila
+		if _, err := r.GetByID(ctx, sessionID, id); err != nil {
+			return nil, err
+		}
+		return nil, outbound.ErrJobNotCancelable
+	}
+	return job, nil
+}
+
+// FailInterrupted marca como falhos os jobs que estavam em envio quando o processo foi encerrado
+func (r *outboundRepository) FailInterrupted(ctx context.Context, reason string) (int, error) {
+	res, err := r.db.NewUpdate().
+		Model((*outbound.Job)(nil)).
+		Set("status = ?", outbound.JobStatusFailed).
+		Set("\"lastError\" = ?", reason).
+		Set("\"updatedAt\" = ?", time.Now()).
+		Where("status = ?", outbound.JobStatusSending).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,191 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/schedule"
+)
+
+// scheduleRepository implementa a interface Repository dos agendamentos
+type scheduleRepository struct {
+	db *bun.DB
+}
+
+// NewScheduleRepository cria uma nova instância do repositório de agendamentos
+func NewScheduleRepository(db *bun.DB) schedule.Repository {
+	return &scheduleRepository{db: db}
+}
+
+// Create insere um novo agendamento
+func (r *scheduleRepository) Create(ctx context.Context, msg *schedule.ScheduledMessage) error {
+	now := time.Now()
+	if msg.ID == uuid.Nil {
+		msg.ID = uuid.New()
+	}
+	if msg.Status == "" {
+		msg.Status = schedule.StatusScheduled
+	}
+	msg.NextAttemptAt = msg.SendAt
+	msg.CreatedAt = now
+	msg.UpdatedAt = now
+
+	_, err := r.db.NewInsert().Model(msg).Exec(ctx)
+	return err
+}
+
+// GetByID busca um agendamento de uma sessão pelo ID
+func (r *scheduleRepository) GetByID(ctx context.Context, sessionID, id uuid.UUID) (*schedule.ScheduledMessage, error) {
+	msg := new(schedule.ScheduledMessage)
+	err := r.db.NewSelect().
+		Model(msg).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, schedule.ErrScheduleNotFound
+		}
+		return nil, err
+	}
+	return msg, nil
+}
+
+// List retorna os agendamentos que atendem ao filtro e o total encontrado
+func (r *scheduleRepository) List(ctx context.Context, filter schedule.Filter) ([]*schedule.ScheduledMessage, int, error) {
+	var messages []*schedule.ScheduledMessage
+	query := r.db.NewSelect().
+		Model(&messages).
+		Where("\"sessionId\" = ?", filter.SessionID)
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	total, err := query.Order("sendAt ASC").ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return messages, total, nil
+}
+
+// UpdatePending altera um agendamento que ainda aguarda o envio
+func (r *scheduleRepository) UpdatePending(ctx context.Context, msg *schedule.ScheduledMessage) error {
+	msg.NextAttemptAt = msg.SendAt
+	msg.UpdatedAt = time.Now()
+
+	res, err := r.db.NewUpdate().
+		Model(msg).
+		Column("kind", "payload", "sendAt", "timezone", "ifDisconnected", "nextAttemptAt", "updatedAt").
+		WherePK().
+		Where("status = ?", schedule.StatusScheduled).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return schedule.ErrScheduleNotEditable
+	}
+	return nil
+}
+
+// ClaimDue marca como em envio e retorna os agendamentos vencidos
+func (r *scheduleRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*schedule.ScheduledMessage, error) {
+	due := r.db.NewSelect().
+		Model((*schedule.ScheduledMessage)(nil)).
+		Column("id").
+		Where("status = ?", schedule.StatusScheduled).
+		Where("\"nextAttemptAt\" <= ?", now).
+		Order("nextAttemptAt ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	var messages []*schedule.ScheduledMessage
+	_, err := r.db.NewUpdate().
+		Model((*schedule.ScheduledMessage)(nil)).
+		Set("status = ?", schedule.StatusSending).
+		Set("\"updatedAt\" = ?", now).
+		Where("id IN (?)", due).
+		Returning("*").
+		Exec(ctx, &messages)
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// Update persiste o resultado de uma tentativa de envio
+func (r *scheduleRepository) Update(ctx context.Context, msg *schedule.ScheduledMessage) error {
+	msg.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(msg).
+		Column("status", "attempts", "nextAttemptAt", "messageId", "lastError", "sentAt", "updatedAt").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Cancel cancela um agendamento que ainda aguarda o envio
+func (r *scheduleRepository) Cancel(ctx context.Context, sessionID, id uuid.UUID) (*schedule.ScheduledMessage, error) {
+	msg := new(schedule.ScheduledMessage)
+	res, err := r.db.NewUpdate().
+		Model(msg).
+		Set("status = ?", schedule.StatusCanceled).
+		Set("\"updatedAt\" = ?", time.Now()).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("status = ?", schedule.StatusScheduled).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		// Diferenciar agendamento inexistente de agendamento que já saiu da espera
+		if _, err := r.GetByID(ctx, sessionID, id); err != nil {
+			return nil, err
+		}
+		return nil, schedule.ErrScheduleNotEditable
+	}
+	return msg, nil
+}
+
+// FailInterrupted marca como falhos os agendamentos que estavam em envio quando o processo foi encerrado
+func (r *scheduleRepository) FailInterrupted(ctx context.Context, reason string) (int, error) {
+	res, err := r.db.NewUpdate().
+		Model((*schedule.ScheduledMessage)(nil)).
+		Set("status = ?", schedule.StatusFailed).
+		Set("\"lastError\" = ?", reason).
+		Set("\"updatedAt\" = ?", time.Now()).
+		Where("status = ?", schedule.StatusSending).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
@@ -28,6 +28,12 @@ func (r *sessionRepository) Create(ctx context.Context, sess *session.Session) e
 	sess.UpdatedAt = time.Now()
 	sess.Status = session.WhatsAppStatusDisconnected
 	sess.IsActive = true
+	if sess.CallPolicy == "" {
+		sess.CallPolicy = session.CallPolicyIgnore
+	}
+	if sess.HistorySyncDays == 0 {
+		sess.HistorySyncDays = session.DefaultHistorySyncDays
+	}
 
 	_, err := r.db.NewInsert().Model(sess).Exec(ctx)
 	return err
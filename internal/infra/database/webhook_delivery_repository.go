@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/webhook"
+)
+
+// webhookDeliveryRepository implementa a interface DeliveryRepository
+type webhookDeliveryRepository struct {
+	db *bun.DB
+}
+
+// NewWebhookDeliveryRepository cria uma nova instância do repositório do outbox de webhooks
+func NewWebhookDeliveryRepository(db *bun.DB) webhook.DeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+// Enqueue insere uma nova entrega pendente
+func (r *webhookDeliveryRepository) Enqueue(ctx context.Context, delivery *webhook.Delivery) error {
+	now := time.Now()
+	if delivery.ID == uuid.Nil {
+		delivery.ID = uuid.New()
+	}
+	if delivery.Status == "" {
+		delivery.Status = webhook.DeliveryStatusPending
+	}
+	if delivery.NextAttemptAt.IsZero() {
+		delivery.NextAttemptAt = now
+	}
+	delivery.CreatedAt = now
+	delivery.UpdatedAt = now
+
+	_, err := r.db.NewInsert().Model(delivery).Returning("seq").Exec(ctx)
+	return err
+}
+
+// GetByID busca uma entrega de uma sessão pelo ID
+func (r *webhookDeliveryRepository) GetByID(ctx context.Context, sessionID, id uuid.UUID) (*webhook.Delivery, error) {
+	delivery := new(webhook.Delivery)
+	err := r.db.NewSelect().
+		Model(delivery).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, webhook.ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// List retorna as entregas que atendem ao filtro e o total encontrado
+func (r *webhookDeliveryRepository) List(ctx context.Context, filter webhook.DeliveryFilter) ([]*webhook.Delivery, int, error) {
+	var deliveries []*webhook.Delivery
+	query := r.db.NewSelect().
+		Model(&deliveries).
+		Where("\"sessionId\" = ?", filter.SessionID)
+
+	if filter.WebhookID != uuid.Nil {
+		query = query.Where("\"webhookId\" = ?", filter.WebhookID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Event != "" {
+		query = query.Where("event = ?", filter.Event)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	total, err := query.Order("seq DESC").ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return deliveries, total, nil
+}
+
+// ListDueHeads retorna a entrega pendente mais antiga de cada webhook cujo horário de envio já chegou.
+// Entregas anteriores aos múltiplos webhooks, sem webhookId, são agrupadas pela sessão.
+// A consulta não trava as linhas (sem FOR UPDATE SKIP LOCKED): duas instâncias lendo o outbox receberiam as mesmas
+// entregas, por isso apenas uma instância da API deve processá-lo.
+func (r *webhookDeliveryRepository) ListDueHeads(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	var deliveries []*webhook.Delivery
+	err := r.db.NewRaw(`
+		SELECT * FROM (
+			SELECT DISTINCT ON (COALESCE("webhookId", "sessionId")) *
+			FROM ?
+			WHERE status = ?
+			ORDER BY COALESCE("webhookId", "sessionId"), seq ASC
+		) AS heads
+		WHERE "nextAttemptAt" <= ?
+		ORDER BY "nextAttemptAt" ASC
+		LIMIT ?`,
+		bun.Ident("zapcore_webhook_deliveries"), webhook.DeliveryStatusPending, now, limit,
+	).Scan(ctx, &deliveries)
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// Update persiste o resultado de uma tentativa de entrega
+func (r *webhookDeliveryRepository) Update(ctx context.Context, delivery *webhook.Delivery) error {
+	delivery.UpdatedAt = time.Now()
+	_, err := r.db.NewUpdate().
+		Model(delivery).
+		Column("status", "attempts", "nextAttemptAt", "url", "lastStatusCode", "lastError",
+			"lastResponse", "attemptLog", "deliveredAt", "updatedAt").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// Requeue recoloca entregas na fila com tentativas zeradas
+func (r *webhookDeliveryRepository) Requeue(ctx context.Context, sessionID uuid.UUID, ids []uuid.UUID, status webhook.DeliveryStatus) (int, error) {
+	now := time.Now()
+	query := r.db.NewUpdate().
+		Model((*webhook.Delivery)(nil)).
+		Set("status = ?", webhook.DeliveryStatusPending).
+		Set("attempts = 0").
+		Set("\"nextAttemptAt\" = ?", now).
+		Set("\"deliveredAt\" = NULL").
+		Set("\"updatedAt\" = ?", now).
+		Where("\"sessionId\" = ?", sessionID).
+		Where("status <> ?", webhook.DeliveryStatusPending)
+
+	if len(ids) > 0 {
+		query = query.Where("id IN (?)", bun.In(ids))
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	res, err := query.Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,189 @@
+//go:build integration
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// Os testes de integração usam o Postgres do docker-compose (docker compose up -d postgres)
+// e podem ser apontados para outro banco pelas variáveis DB_*.
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// newIntegrationDB conecta ao banco de teste, aplica as migrações e cria uma sessão própria do teste
+func newIntegrationDB(t *testing.T) (*bun.DB, uuid.UUID) {
+	t.Helper()
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		getEnv("DB_USER", "zmeow"), getEnv("DB_PASSWORD", "zmeow123"), getEnv("DB_HOST", "localhost"),
+		getEnv("DB_PORT", "5432"), getEnv("DB_NAME", "zmeow"), getEnv("DB_SSL_MODE", "disable"))
+
+	nop := zerolog.Nop()
+	db, err := NewDatabase(dsn, false, logger.NewZerologLogger(&nop))
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := RunMigrations(db); err != nil {
+		t.Fatalf("RunMigrations() error = %v", err)
+	}
+
+	sess := &session.Session{Name: "outbox-test-" + uuid.NewString()}
+	if err := NewSessionRepository(db).Create(context.Background(), sess); err != nil {
+		t.Fatalf("creating session: %v", err)
+	}
+	t.Cleanup(func() {
+		// As entregas da sessão são removidas em cascata
+		db.NewDelete().Model((*session.Session)(nil)).Where("id = ?", sess.ID).Exec(context.Background())
+	})
+
+	return db, sess.ID
+}
+
+// enqueueDelivery grava uma entrega pendente do webhook
+func enqueueDelivery(t *testing.T, repo webhook.DeliveryRepository, sessionID, webhookID uuid.UUID, event string) *webhook.Delivery {
+	t.Helper()
+
+	delivery := &webhook.Delivery{
+		SessionID:   sessionID,
+		WebhookID:   webhookID,
+		Event:       event,
+		Payload:     []byte(`{"event":"` + event + `"}`),
+		MaxAttempts: 2,
+	}
+	if err := repo.Enqueue(context.Background(), delivery); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	return delivery
+}
+
+// headsOf filtra as entregas da sessão do teste, já que o banco pode conter outras
+func headsOf(t *testing.T, repo webhook.DeliveryRepository, sessionID uuid.UUID, now time.Time) map[uuid.UUID]uuid.UUID {
+	t.Helper()
+
+	deliveries, err := repo.ListDueHeads(context.Background(), now, 1000)
+	if err != nil {
+		t.Fatalf("ListDueHeads() error = %v", err)
+	}
+
+	heads := make(map[uuid.UUID]uuid.UUID)
+	for _, delivery := range deliveries {
+		if delivery.SessionID == sessionID {
+			heads[delivery.WebhookID] = delivery.ID
+		}
+	}
+	return heads
+}
+
+func TestWebhookDeliveryRepositoryListDueHeads(t *testing.T) {
+	db, sessionID := newIntegrationDB(t)
+	repo := NewWebhookDeliveryRepository(db)
+	ctx := context.Background()
+	webhookA, webhookB := uuid.New(), uuid.New()
+
+	firstA := enqueueDelivery(t, repo, sessionID, webhookA, "message")
+	secondA := enqueueDelivery(t, repo, sessionID, webhookA, "receipt")
+	firstB := enqueueDelivery(t, repo, sessionID, webhookB, "message")
+
+	heads := headsOf(t, repo, sessionID, time.Now())
+	if len(heads) != 2 || heads[webhookA] != firstA.ID || heads[webhookB] != firstB.ID {
+		t.Fatalf("ListDueHeads() = %v, want the oldest delivery of each webhook", heads)
+	}
+
+	// Com a primeira entrega aguardando o retry, a seguinte do mesmo webhook continua retida
+	firstA.Attempts = 1
+	firstA.ScheduleRetry(time.Hour)
+	if err := repo.Update(ctx, firstA); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	heads = headsOf(t, repo, sessionID, time.Now())
+	if _, ok := heads[webhookA]; ok {
+		t.Fatalf("ListDueHeads() returned %v for a webhook whose head is retrying", heads[webhookA])
+	}
+	if heads[webhookB] != firstB.ID {
+		t.Fatalf("ListDueHeads() = %v, want the other webhook unaffected", heads)
+	}
+
+	// Quando a primeira vai para o dead-letter, a seguinte passa a ser a cabeça da fila
+	firstA.Attempts = firstA.MaxAttempts
+	firstA.ScheduleRetry(time.Hour)
+	if err := repo.Update(ctx, firstA); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	heads = headsOf(t, repo, sessionID, time.Now())
+	if heads[webhookA] != secondA.ID {
+		t.Fatalf("ListDueHeads() = %v, want %s after the head was dead-lettered", heads, secondA.ID)
+	}
+}
+
+func TestWebhookDeliveryRepositoryRequeue(t *testing.T) {
+	db, sessionID := newIntegrationDB(t)
+	repo := NewWebhookDeliveryRepository(db)
+	ctx := context.Background()
+	webhookID := uuid.New()
+
+	dead := enqueueDelivery(t, repo, sessionID, webhookID, "message")
+	dead.Attempts = dead.MaxAttempts
+	dead.ScheduleRetry(time.Hour)
+	delivered := enqueueDelivery(t, repo, sessionID, webhookID, "receipt")
+	delivered.Attempts = 1
+	delivered.MarkDelivered()
+	pending := enqueueDelivery(t, repo, sessionID, webhookID, "presence")
+	for _, delivery := range []*webhook.Delivery{dead, delivered} {
+		if err := repo.Update(ctx, delivery); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	}
+
+	// Filtrando pelo status, apenas as entregas no dead-letter voltam à fila
+	requeued, err := repo.Requeue(ctx, sessionID, nil, webhook.DeliveryStatusDead)
+	if err != nil || requeued != 1 {
+		t.Fatalf("Requeue(dead) = %d, %v, want 1", requeued, err)
+	}
+	replayed, err := repo.GetByID(ctx, sessionID, dead.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if replayed.Status != webhook.DeliveryStatusPending || replayed.Attempts != 0 || replayed.NextAttemptAt.After(time.Now()) {
+		t.Fatalf("replayed delivery = %q, %d attempts, next at %v; want pending, no attempts and due", replayed.Status, replayed.Attempts, replayed.NextAttemptAt)
+	}
+	if replayed.Seq != dead.Seq {
+		t.Fatalf("replayed delivery seq = %d, want %d to keep its position", replayed.Seq, dead.Seq)
+	}
+
+	// Pelo ID, uma entrega já entregue também pode ser reenviada; pendentes e outras sessões não são afetadas
+	requeued, err = repo.Requeue(ctx, sessionID, []uuid.UUID{delivered.ID, pending.ID}, "")
+	if err != nil || requeued != 1 {
+		t.Fatalf("Requeue(ids) = %d, %v, want 1", requeued, err)
+	}
+	if requeued, err = repo.Requeue(ctx, uuid.New(), []uuid.UUID{delivered.ID}, ""); err != nil || requeued != 0 {
+		t.Fatalf("Requeue() from another session = %d, %v, want 0", requeued, err)
+	}
+
+	replayed, err = repo.GetByID(ctx, sessionID, delivered.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if replayed.Status != webhook.DeliveryStatusPending || replayed.DeliveredAt != nil {
+		t.Fatalf("replayed delivery = %q delivered at %v, want pending", replayed.Status, replayed.DeliveredAt)
+	}
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"zmeow/internal/domain/webhook"
+)
+
+// webhookRepository implementa a interface WebhookRepository
+type webhookRepository struct {
+	db *bun.DB
+}
+
+// NewWebhookRepository cria uma nova instância do repositório de webhooks
+func NewWebhookRepository(db *bun.DB) webhook.WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+// Save cria ou atualiza um webhook (identificado pelo ID)
+func (r *webhookRepository) Save(ctx context.Context, wh *webhook.Webhook) error {
+	now := time.Now()
+	if wh.ID == uuid.Nil {
+		wh.ID = uuid.New()
+	}
+	if wh.CreatedAt.IsZero() {
+		wh.CreatedAt = now
+	}
+	wh.UpdatedAt = now
+
+	_, err := r.db.NewInsert().
+		Model(wh).
+		On("CONFLICT (id) DO UPDATE").
+		Set("url = EXCLUDED.url").
+		Set("secret = EXCLUDED.secret").
+		Set("enabled = EXCLUDED.enabled").
+		Set("retries = EXCLUDED.retries").
+		Set("timeout = EXCLUDED.timeout").
+		Set("\"previousSecret\" = EXCLUDED.\"previousSecret\"").
+		Set("\"previousSecretExpiresAt\" = EXCLUDED.\"previousSecretExpiresAt\"").
+		Set("events = EXCLUDED.events").
+		Set("headers = EXCLUDED.headers").
+		Set("\"updatedAt\" = EXCLUDED.\"updatedAt\"").
+		Returning("*").
+		Exec(ctx)
+
+	return err
+}
+
+// GetByID busca um webhook de uma sessão pelo ID
+func (r *webhookRepository) GetByID(ctx context.Context, sessionID, id uuid.UUID) (*webhook.Webhook, error) {
+	wh := new(webhook.Webhook)
+	err := r.db.NewSelect().
+		Model(wh).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return wh, nil
+}
+
+// GetPrimary busca o webhook principal (o mais antigo) de uma sessão
+func (r *webhookRepository) GetPrimary(ctx context.Context, sessionID uuid.UUID) (*webhook.Webhook, error) {
+	wh := new(webhook.Webhook)
+	err := r.db.NewSelect().
+		Model(wh).
+		Where("\"sessionId\" = ?", sessionID).
+		Order("createdAt ASC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return wh, nil
+}
+
+// ListBySession retorna os webhooks de uma sessão, do mais antigo ao mais recente
+func (r *webhookRepository) ListBySession(ctx context.Context, sessionID uuid.UUID) ([]*webhook.Webhook, error) {
+	var webhooks []*webhook.Webhook
+	err := r.db.NewSelect().
+		Model(&webhooks).
+		Where("\"sessionId\" = ?", sessionID).
+		Order("createdAt ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// List retorna todos os webhooks configurados
+func (r *webhookRepository) List(ctx context.Context) ([]*webhook.Webhook, error) {
+	var webhooks []*webhook.Webhook
+	err := r.db.NewSelect().Model(&webhooks).Order("createdAt ASC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// Delete remove um webhook de uma sessão
+func (r *webhookRepository) Delete(ctx context.Context, sessionID, id uuid.UUID) error {
+	res, err := r.db.NewDelete().
+		Model((*webhook.Webhook)(nil)).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+	return nil
+}
+
+// SetEnabled habilita ou desabilita um webhook de uma sessão
+func (r *webhookRepository) SetEnabled(ctx context.Context, sessionID, id uuid.UUID, enabled bool) error {
+	res, err := r.db.NewUpdate().
+		Model((*webhook.Webhook)(nil)).
+		Set("enabled = ?", enabled).
+		Set("\"updatedAt\" = ?", time.Now()).
+		Where("id = ?", id).
+		Where("\"sessionId\" = ?", sessionID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"zmeow/internal/domain/media"
+)
+
+// LocalStorage armazena as mídias no sistema de arquivos, uma pasta por sessão
+type LocalStorage struct {
+	root string
+}
+
+// NewLocalStorage cria o backend local, criando o diretório raiz se necessário
+func NewLocalStorage(root string) (*LocalStorage, error) {
+	if root == "" {
+		return nil, fmt.Errorf("media storage path is required")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create media storage directory: %w", err)
+	}
+	return &LocalStorage{root: root}, nil
+}
+
+// Put grava o objeto em um arquivo temporário e o renomeia, evitando leituras parciais
+func (s *LocalStorage) Put(_ context.Context, key string, data io.Reader, _ int64, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create media directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary media file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write media file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write media file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get abre o arquivo do objeto; o tipo de conteúdo é inferido da extensão
+func (s *LocalStorage) Get(_ context.Context, key string) (io.ReadCloser, *media.ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, media.ErrObjectNotFound
+		}
+		return nil, nil, err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return file, &media.ObjectInfo{Key: key, Size: stat.Size(), ContentType: contentType}, nil
+}
+
+// Exists verifica se o arquivo do objeto existe
+func (s *LocalStorage) Exists(_ context.Context, key string) (bool, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Delete remove o arquivo do objeto
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path converte a chave em um caminho dentro da raiz, rejeitando chaves que escapem dela
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if key == "" || clean == "/" || strings.Contains(key, "..") {
+		return "", media.ErrInvalidObjectKey
+	}
+	return filepath.Join(s.root, filepath.FromSlash(clean)), nil
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"zmeow/internal/domain/media"
+)
+
+// s3PartSize limita o buffer do upload multipart quando o tamanho do objeto é desconhecido (size -1);
+// sem ele o minio-go dimensiona as partes para o maior objeto possível
+const s3PartSize = 16 << 20
+
+// S3Options contém a configuração de um backend compatível com S3 (AWS S3, MinIO, etc.)
+type S3Options struct {
+	// Endpoint no formato host[:porta], sem esquema (ex.: localhost:9000)
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+}
+
+// S3Storage armazena as mídias em um bucket compatível com S3 usando o cliente minio-go.
+// Usa endereçamento por caminho (endpoint/bucket/chave), suportado tanto pelo AWS S3 quanto pelo MinIO.
+// O bucket deve existir previamente.
+type S3Storage struct {
+	bucket string
+	client *minio.Client
+}
+
+// NewS3Storage cria o backend S3
+func NewS3Storage(opts S3Options) (*S3Storage, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" {
+		return nil, fmt.Errorf("S3 endpoint and bucket are required")
+	}
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, fmt.Errorf("S3 access key and secret key are required")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+
+	client, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(opts.AccessKey, opts.SecretKey, ""),
+		Secure:       opts.UseSSL,
+		Region:       opts.Region,
+		BucketLookup: minio.BucketLookupPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	return &S3Storage{bucket: opts.Bucket, client: client}, nil
+}
+
+// Put envia o objeto
+func (s *S3Storage) Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error {
+	if err := validateS3Key(key); err != nil {
+		return err
+	}
+
+	_, err := s.client.PutObject(ctx, s.bucket, key, data, size, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    s3PartSize,
+	})
+	if err != nil {
+		return fmt.Errorf("S3 put failed: %w", err)
+	}
+	return nil
+}
+
+// Get abre o objeto para leitura
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, *media.ObjectInfo, error) {
+	if err := validateS3Key(key); err != nil {
+		return nil, nil, err
+	}
+
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("S3 get failed: %w", err)
+	}
+
+	// GetObject é preguiçoso: o Stat faz a requisição e revela um objeto inexistente
+	stat, err := object.Stat()
+	if err != nil {
+		object.Close()
+		if isNotFound(err) {
+			return nil, nil, media.ErrObjectNotFound
+		}
+		return nil, nil, fmt.Errorf("S3 get failed: %w", err)
+	}
+
+	contentType := stat.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return object, &media.ObjectInfo{Key: key, Size: stat.Size, ContentType: contentType}, nil
+}
+
+// Exists verifica o objeto com HEAD
+func (s *S3Storage) Exists(ctx context.Context, key string) (bool, error) {
+	if err := validateS3Key(key); err != nil {
+		return false, err
+	}
+
+	if _, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{}); err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("S3 head failed: %w", err)
+	}
+	return true, nil
+}
+
+// Delete remove o objeto; o S3 não acusa erro para objetos inexistentes
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := validateS3Key(key); err != nil {
+		return err
+	}
+
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil && !isNotFound(err) {
+		return fmt.Errorf("S3 delete failed: %w", err)
+	}
+	return nil
+}
+
+// validateS3Key rejeita chaves vazias ou com navegação de diretórios
+func validateS3Key(key string) error {
+	if key == "" || strings.Contains(key, "..") {
+		return media.ErrInvalidObjectKey
+	}
+	return nil
+}
+
+// isNotFound verifica se o erro do S3 indica objeto inexistente
+func isNotFound(err error) bool {
+	resp := minio.ToErrorResponse(err)
+	return resp.StatusCode == http.StatusNotFound || resp.Code == "NoSuchKey"
+}
@@ -0,0 +1,200 @@
+//go:build integration
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"zmeow/internal/domain/media"
+)
+
+// Os testes de integração usam o MinIO do docker-compose (docker compose --profile storage up -d minio)
+// e podem ser apontados para outro servidor pelas variáveis MEDIA_S3_*.
+func newIntegrationS3(t *testing.T) (*S3Storage, S3Options) {
+	t.Helper()
+
+	opts := S3Options{
+		Endpoint:  getEnv("MEDIA_S3_ENDPOINT", "localhost:9000"),
+		Region:    getEnv("MEDIA_S3_REGION", "us-east-1"),
+		Bucket:    "zmeow-integration-" + strings.ToLower(uuid.NewString()[:8]),
+		AccessKey: getEnv("MEDIA_S3_ACCESS_KEY", "minioadmin"),
+		SecretKey: getEnv("MEDIA_S3_SECRET_KEY", "minioadmin"),
+	}
+
+	admin, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(opts.AccessKey, opts.SecretKey, ""),
+		Region: opts.Region,
+	})
+	if err != nil {
+		t.Fatalf("creating admin client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := admin.MakeBucket(ctx, opts.Bucket, minio.MakeBucketOptions{Region: opts.Region}); err != nil {
+		t.Fatalf("creating bucket %s: %v", opts.Bucket, err)
+	}
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		for object := range admin.ListObjects(ctx, opts.Bucket, minio.ListObjectsOptions{Recursive: true}) {
+			admin.RemoveObject(ctx, opts.Bucket, object.Key, minio.RemoveObjectOptions{})
+		}
+		admin.RemoveBucket(ctx, opts.Bucket)
+	})
+
+	s3, err := NewS3Storage(opts)
+	if err != nil {
+		t.Fatalf("NewS3Storage() error = %v", err)
+	}
+	return s3, opts
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func TestS3StorageRoundTrip(t *testing.T) {
+	s3, _ := newIntegrationS3(t)
+	ctx := context.Background()
+	sessionID := uuid.New()
+
+	tests := []struct {
+		name        string
+		key         string
+		content     []byte
+		size        int64
+		contentType string
+		wantType    string
+	}{
+		{
+			name:        "known size",
+			key:         media.ObjectKey(sessionID, strings.Repeat("a", 64), ".jpg"),
+			content:     []byte("jpeg bytes"),
+			size:        int64(len("jpeg bytes")),
+			contentType: "image/jpeg",
+			wantType:    "image/jpeg",
+		},
+		{
+			name:     "unknown size without content type",
+			key:      media.ObjectKey(sessionID, strings.Repeat("b", 64), ""),
+			content:  bytes.Repeat([]byte("x"), 1<<20),
+			size:     -1,
+			wantType: "application/octet-stream",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s3.Put(ctx, tt.key, bytes.NewReader(tt.content), tt.size, tt.contentType); err != nil {
+				t.Fatalf("Put() error = %v", err)
+			}
+
+			exists, err := s3.Exists(ctx, tt.key)
+			if err != nil || !exists {
+				t.Fatalf("Exists() = %v, %v, want true", exists, err)
+			}
+
+			data, info, err := s3.Get(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			got, err := io.ReadAll(data)
+			data.Close()
+			if err != nil {
+				t.Fatalf("reading object: %v", err)
+			}
+			if !bytes.Equal(got, tt.content) {
+				t.Fatalf("Get() returned %d bytes, want %d", len(got), len(tt.content))
+			}
+			if info.Size != int64(len(tt.content)) || info.ContentType != tt.wantType {
+				t.Fatalf("Get() info = %+v, want size %d and type %q", info, len(tt.content), tt.wantType)
+			}
+
+			if err := s3.Delete(ctx, tt.key); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if exists, err := s3.Exists(ctx, tt.key); err != nil || exists {
+				t.Fatalf("Exists() after delete = %v, %v, want false", exists, err)
+			}
+		})
+	}
+}
+
+func TestS3StorageMissingObject(t *testing.T) {
+	s3, _ := newIntegrationS3(t)
+	ctx := context.Background()
+	key := media.ObjectKey(uuid.New(), strings.Repeat("c", 64), ".png")
+
+	if _, _, err := s3.Get(ctx, key); !errors.Is(err, media.ErrObjectNotFound) {
+		t.Fatalf("Get() error = %v, want %v", err, media.ErrObjectNotFound)
+	}
+	if exists, err := s3.Exists(ctx, key); err != nil || exists {
+		t.Fatalf("Exists() = %v, %v, want false", exists, err)
+	}
+	if err := s3.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() of a missing object error = %v", err)
+	}
+	if err := s3.Put(ctx, "../escape", strings.NewReader("x"), 1, ""); !errors.Is(err, media.ErrInvalidObjectKey) {
+		t.Fatalf("Put() with traversal key error = %v, want %v", err, media.ErrInvalidObjectKey)
+	}
+}
+
+// TestS3StoragePresignedFetch confere que um objeto gravado pelo S3Storage é lido por uma URL pré-assinada
+// do mesmo bucket, garantindo que a chave e o content type gravados são os esperados pelo S3
+func TestS3StoragePresignedFetch(t *testing.T) {
+	s3, opts := newIntegrationS3(t)
+	ctx := context.Background()
+	key := media.ObjectKey(uuid.New(), strings.Repeat("d", 64), ".ogg")
+	content := []byte("ogg audio")
+
+	if err := s3.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "audio/ogg"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	signedURL, err := s3.client.PresignedGetObject(ctx, opts.Bucket, key, time.Minute, nil)
+	if err != nil {
+		t.Fatalf("PresignedGetObject() error = %v", err)
+	}
+
+	resp, err := http.Get(signedURL.String())
+	if err != nil {
+		t.Fatalf("GET presigned url: %v", err)
+	}
+	defer resp.Body.Close()
+
+	got, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !bytes.Equal(got, content) {
+		t.Fatalf("GET presigned url = %d %q, want 200 %q", resp.StatusCode, got, content)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "audio/ogg" {
+		t.Fatalf("Content-Type = %q, want audio/ogg", contentType)
+	}
+
+	// Uma URL adulterada para outra chave não pode ser usada
+	tampered := *signedURL
+	tampered.Path = strings.Replace(tampered.Path, strings.Repeat("d", 64), strings.Repeat("e", 64), 1)
+	resp, err = http.Get(tampered.String())
+	if err != nil {
+		t.Fatalf("GET tampered url: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("GET tampered url = %d, want %d", resp.StatusCode, http.StatusForbidden)
+	}
+}
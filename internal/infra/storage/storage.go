@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"zmeow/internal/app/config"
+	"zmeow/internal/domain/media"
+)
+
+// Drivers de armazenamento suportados
+const (
+	DriverDisabled = "disabled"
+	DriverLocal    = "local"
+	DriverS3       = "s3"
+)
+
+// New cria o backend de armazenamento configurado em MEDIA_STORAGE_DRIVER.
+// Retorna nil quando o armazenamento está desabilitado.
+func New(cfg *config.Config) (media.Storage, error) {
+	switch strings.ToLower(cfg.MediaStorage.Driver) {
+	case "", DriverDisabled:
+		return nil, nil
+	case DriverLocal:
+		return NewLocalStorage(cfg.MediaStorage.LocalPath)
+	case DriverS3:
+		return NewS3Storage(S3Options{
+			Endpoint:  cfg.MediaStorage.S3Endpoint,
+			Region:    cfg.MediaStorage.S3Region,
+			Bucket:    cfg.MediaStorage.S3Bucket,
+			AccessKey: cfg.MediaStorage.S3AccessKey,
+			SecretKey: cfg.MediaStorage.S3SecretKey,
+			UseSSL:    cfg.MediaStorage.S3UseSSL,
+		})
+	}
+	return nil, fmt.Errorf("unknown media storage driver %q", cfg.MediaStorage.Driver)
+}
+
+// NewURLSigner cria o URLSigner a partir da configuração de armazenamento
+func NewURLSigner(cfg *config.Config) *media.URLSigner {
+	return media.NewURLSigner(cfg.MediaStorage.PublicURL, cfg.MediaStorage.URLSecret, cfg.MediaStorage.URLTTL)
+}
@@ -2,9 +2,12 @@ package core
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"regexp"
 	"strings"
 	"time"
@@ -18,6 +21,7 @@ import (
 
 	"zmeow/internal/domain/message"
 	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
 	"zmeow/pkg/logger"
 )
 
@@ -312,7 +316,7 @@ func (uc *UnifiedClient) GetUnderlyingManager() whatsapp.WhatsAppManager {
 }
 
 // SendTextMessage envia uma mensagem de texto
-func (uc *UnifiedClient) SendTextMessage(ctx context.Context, sessionID uuid.UUID, phone, message string) (string, error) {
+func (uc *UnifiedClient) SendTextMessage(ctx context.Context, sessionID uuid.UUID, phone, message string, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -343,6 +347,13 @@ func (uc *UnifiedClient) SendTextMessage(ctx context.Context, sessionID uuid.UUI
 		Conversation: proto.String(message),
 	}
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg)
 	if err != nil {
@@ -359,11 +370,13 @@ func (uc *UnifiedClient) SendTextMessage(ctx context.Context, sessionID uuid.UUI
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("Text message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
 // SendMediaMessage envia mídia (imagem, áudio, vídeo, documento)
-func (uc *UnifiedClient) SendMediaMessage(ctx context.Context, sessionID uuid.UUID, phone, mediaType string, mediaData []byte, caption, fileName, mimeType string) (string, error) {
+func (uc *UnifiedClient) SendMediaMessage(ctx context.Context, sessionID uuid.UUID, phone, mediaType string, mediaData []byte, caption, fileName, mimeType string, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -479,6 +492,13 @@ func (uc *UnifiedClient) SendMediaMessage(ctx context.Context, sessionID uuid.UU
 		return "", fmt.Errorf("unsupported media type for message creation: %s", mediaType)
 	}
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg)
 	if err != nil {
@@ -496,11 +516,13 @@ func (uc *UnifiedClient) SendMediaMessage(ctx context.Context, sessionID uuid.UU
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("Media message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
 // SendMediaFromURL baixa mídia de uma URL e envia como mensagem
-func (uc *UnifiedClient) SendMediaFromURL(ctx context.Context, targetSessionID uuid.UUID, phone, mediaType, mediaURL, caption, fileName, mimeType string) (string, error) {
+func (uc *UnifiedClient) SendMediaFromURL(ctx context.Context, targetSessionID uuid.UUID, phone, mediaType, mediaURL, caption, fileName, mimeType string, contextInfo *message.MessageContextInfo) (string, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId": targetSessionID,
 		"phone":     phone,
@@ -544,11 +566,11 @@ func (uc *UnifiedClient) SendMediaFromURL(ctx context.Context, targetSessionID u
 	}
 
 	// Usar o método tradicional para enviar os dados baixados
-	return uc.SendMediaMessage(ctx, targetSessionID, phone, mediaType, mediaData, caption, fileName, mimeType)
+	return uc.SendMediaMessage(ctx, targetSessionID, phone, mediaType, mediaData, caption, fileName, mimeType, contextInfo)
 }
 
 // SendLocationMessage envia uma localização
-func (uc *UnifiedClient) SendLocationMessage(ctx context.Context, sessionID uuid.UUID, phone string, latitude, longitude float64, name, address string) (string, error) {
+func (uc *UnifiedClient) SendLocationMessage(ctx context.Context, sessionID uuid.UUID, phone string, latitude, longitude float64, name, address string, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -587,6 +609,13 @@ func (uc *UnifiedClient) SendLocationMessage(ctx context.Context, sessionID uuid
 		},
 	}
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg)
 	if err != nil {
@@ -603,11 +632,13 @@ func (uc *UnifiedClient) SendLocationMessage(ctx context.Context, sessionID uuid
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("Location message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
 // SendContactMessage envia um contato
-func (uc *UnifiedClient) SendContactMessage(ctx context.Context, sessionID uuid.UUID, phone, contactName, contactJID string) (string, error) {
+func (uc *UnifiedClient) SendContactMessage(ctx context.Context, sessionID uuid.UUID, phone, contactName, contactJID string, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -645,6 +676,13 @@ func (uc *UnifiedClient) SendContactMessage(ctx context.Context, sessionID uuid.
 		},
 	}
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg)
 	if err != nil {
@@ -661,11 +699,13 @@ func (uc *UnifiedClient) SendContactMessage(ctx context.Context, sessionID uuid.
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("Contact message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
 // SendStickerMessage envia um sticker
-func (uc *UnifiedClient) SendStickerMessage(ctx context.Context, sessionID uuid.UUID, phone string, stickerData []byte, mimeType string) (string, error) {
+func (uc *UnifiedClient) SendStickerMessage(ctx context.Context, sessionID uuid.UUID, phone string, stickerData []byte, mimeType string, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -740,6 +780,13 @@ func (uc *UnifiedClient) SendStickerMessage(ctx context.Context, sessionID uuid.
 	// CORREÇÃO ADICIONAL: Usar SendRequestExtra com ID como WuzAPI
 	messageID := whatsmeowClient.GenerateMessageID()
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem com ID específico como WuzAPI
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg, whatsmeow.SendRequestExtra{ID: messageID})
 	if err != nil {
@@ -759,6 +806,8 @@ func (uc *UnifiedClient) SendStickerMessage(ctx context.Context, sessionID uuid.
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("Sticker message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
@@ -791,7 +840,7 @@ func (uc *UnifiedClient) generateStickerThumbnail(stickerData []byte) ([]byte, e
 }
 
 // SendButtonsMessage envia mensagem com botões
-func (uc *UnifiedClient) SendButtonsMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer string, buttons []message.MessageButton) (string, error) {
+func (uc *UnifiedClient) SendButtonsMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer string, buttons []message.MessageButton, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -853,6 +902,13 @@ func (uc *UnifiedClient) SendButtonsMessage(ctx context.Context, sessionID uuid.
 		},
 	}
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg)
 	if err != nil {
@@ -869,11 +925,13 @@ func (uc *UnifiedClient) SendButtonsMessage(ctx context.Context, sessionID uuid.
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("Buttons message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
 // SendListMessage envia mensagem com lista
-func (uc *UnifiedClient) SendListMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer, title, buttonText string, sections []message.MessageListSection) (string, error) {
+func (uc *UnifiedClient) SendListMessage(ctx context.Context, sessionID uuid.UUID, phone, text, footer, title, buttonText string, sections []message.MessageListSection, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -955,6 +1013,13 @@ func (uc *UnifiedClient) SendListMessage(ctx context.Context, sessionID uuid.UUI
 		},
 	}
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg)
 	if err != nil {
@@ -971,11 +1036,13 @@ func (uc *UnifiedClient) SendListMessage(ctx context.Context, sessionID uuid.UUI
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("List message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
 // SendPollMessage envia enquete
-func (uc *UnifiedClient) SendPollMessage(ctx context.Context, sessionID uuid.UUID, phone, name string, options []string, selectableCount int) (string, error) {
+func (uc *UnifiedClient) SendPollMessage(ctx context.Context, sessionID uuid.UUID, phone, name string, options []string, selectableCount int, contextInfo *message.MessageContextInfo) (string, error) {
 	targetSessionID := uc.resolveSessionID(sessionID)
 
 	uc.logger.WithFields(map[string]interface{}{
@@ -1024,6 +1091,13 @@ func (uc *UnifiedClient) SendPollMessage(ctx context.Context, sessionID uuid.UUI
 	// Usar BuildPollCreation como na referência wuzapi
 	msg := whatsmeowClient.BuildPollCreation(name, options, selectableCount)
 
+	// Anexar contexto da mensagem (resposta, menções, encaminhamento)
+	waContext, err := uc.buildContextInfo(ctx, targetSessionID, whatsmeowClient, recipientJID, contextInfo)
+	if err != nil {
+		return "", err
+	}
+	applyContextInfo(msg, waContext)
+
 	// Enviar mensagem
 	resp, err := whatsmeowClient.SendMessage(ctx, recipientJID, msg)
 	if err != nil {
@@ -1040,6 +1114,8 @@ func (uc *UnifiedClient) SendPollMessage(ctx context.Context, sessionID uuid.UUI
 		"timestamp": resp.Timestamp,
 	}).Info().Msg("Poll message sent successfully")
 
+	uc.recordSentMessage(targetSessionID, whatsmeowClient, recipientJID, messageID, resp.Timestamp, msg)
+
 	return messageID, nil
 }
 
@@ -1244,6 +1320,188 @@ func (uc *UnifiedClient) ReactMessage(ctx context.Context, sessionID uuid.UUID,
 	return nil
 }
 
+// SendChatPresence envia o indicador de digitação ou gravação para um chat
+func (uc *UnifiedClient) SendChatPresence(ctx context.Context, sessionID uuid.UUID, chat string, state message.ChatPresenceState) error {
+	targetSessionID := uc.resolveSessionID(sessionID)
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": targetSessionID,
+		"chat":      chat,
+		"state":     state,
+	}).Debug().Msg("Sending chat presence")
+
+	// Verificar se a sessão está conectada
+	if !uc.manager.IsConnected(targetSessionID) {
+		return fmt.Errorf("session %s is not connected", targetSessionID)
+	}
+
+	chatJID, ok := uc.parseJIDLikeWuzapi(chat)
+	if !ok {
+		return fmt.Errorf("invalid chat: %s", chat)
+	}
+
+	// Gravação é enviada como "composing" com mídia de áudio, como no app oficial
+	presence := types.ChatPresenceComposing
+	media := types.ChatPresenceMediaText
+	switch state {
+	case message.ChatPresenceRecording:
+		media = types.ChatPresenceMediaAudio
+	case message.ChatPresencePaused:
+		presence = types.ChatPresencePaused
+	}
+
+	whatsmeowClient, err := uc.getWhatsmeowClient(targetSessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get whatsmeow client: %w", err)
+	}
+
+	if err := whatsmeowClient.SendChatPresence(chatJID, presence, media); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to send chat presence")
+		return fmt.Errorf("failed to send chat presence: %w", err)
+	}
+
+	return nil
+}
+
+// MarkRead marca como lidas mensagens de um remetente em um chat; sender é ignorado fora de grupos
+func (uc *UnifiedClient) MarkRead(ctx context.Context, sessionID uuid.UUID, chat, sender string, messageIDs []string, timestamp time.Time) error {
+	targetSessionID := uc.resolveSessionID(sessionID)
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":  targetSessionID,
+		"chat":       chat,
+		"sender":     sender,
+		"messageIds": messageIDs,
+	}).Debug().Msg("Marking messages as read")
+
+	// Verificar se a sessão está conectada
+	if !uc.manager.IsConnected(targetSessionID) {
+		return fmt.Errorf("session %s is not connected", targetSessionID)
+	}
+
+	chatJID, ok := uc.parseJIDLikeWuzapi(chat)
+	if !ok {
+		return fmt.Errorf("invalid chat: %s", chat)
+	}
+
+	senderJID := types.EmptyJID
+	if sender != "" {
+		if senderJID, ok = uc.parseJIDLikeWuzapi(sender); !ok {
+			return fmt.Errorf("invalid sender: %s", sender)
+		}
+	}
+
+	whatsmeowClient, err := uc.getWhatsmeowClient(targetSessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get whatsmeow client: %w", err)
+	}
+
+	ids := make([]types.MessageID, len(messageIDs))
+	for i, id := range messageIDs {
+		ids[i] = types.MessageID(id)
+	}
+
+	if err := whatsmeowClient.MarkRead(ids, timestamp, chatJID, senderJID); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to mark messages as read")
+		return fmt.Errorf("failed to mark messages as read: %w", err)
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": targetSessionID,
+		"chat":      chatJID.String(),
+		"count":     len(ids),
+	}).Info().Msg("Messages marked as read")
+
+	return nil
+}
+
+// DownloadMedia baixa e descriptografa uma mídia a partir dos metadados recebidos na mensagem.
+// O conteúdo é gravado em um arquivo temporário, removido quando MediaContent.Data é fechado.
+func (uc *UnifiedClient) DownloadMedia(ctx context.Context, sessionID uuid.UUID, mediaType message.InboundMessageType, media *message.InboundMedia) (*message.MediaContent, error) {
+	targetSessionID := uc.resolveSessionID(sessionID)
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":  targetSessionID,
+		"mediaType":  mediaType,
+		"directPath": media.DirectPath,
+	}).Debug().Msg("Downloading media")
+
+	// Verificar se a sessão está conectada
+	if !uc.manager.IsConnected(targetSessionID) {
+		return nil, fmt.Errorf("session %s is not connected", targetSessionID)
+	}
+
+	var waMediaType whatsmeow.MediaType
+	switch mediaType.MediaKind() {
+	case message.InboundTypeImage:
+		waMediaType = whatsmeow.MediaImage
+	case message.InboundTypeVideo:
+		waMediaType = whatsmeow.MediaVideo
+	case message.InboundTypeAudio:
+		waMediaType = whatsmeow.MediaAudio
+	case message.InboundTypeDocument:
+		waMediaType = whatsmeow.MediaDocument
+	default:
+		return nil, fmt.Errorf("%w: unsupported media type %q", message.ErrInvalidMediaInfo, mediaType)
+	}
+
+	mediaKey, err := decodeMediaField("mediaKey", media.MediaKey)
+	if err != nil {
+		return nil, err
+	}
+	fileSHA256, err := decodeMediaField("fileSha256", media.FileSHA256)
+	if err != nil {
+		return nil, err
+	}
+	fileEncSHA256, err := decodeMediaField("fileEncSha256", media.FileEncSHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tamanho desconhecido desativa a verificação de tamanho do whatsmeow
+	fileLength := -1
+	if media.FileLength > 0 {
+		fileLength = int(media.FileLength)
+	}
+
+	whatsmeowClient, err := uc.getWhatsmeowClient(targetSessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get whatsmeow client: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "zmeow-media-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmp := &tempMediaFile{File: file}
+
+	err = whatsmeowClient.DownloadMediaWithPathToFile(ctx, media.DirectPath, fileEncSHA256, fileSHA256, mediaKey, fileLength, waMediaType, "", file)
+	if err != nil {
+		tmp.Close()
+		uc.logger.WithError(err).Error().Msg("Failed to download media")
+		if errors.Is(err, whatsmeow.ErrMediaDownloadFailedWith404) || errors.Is(err, whatsmeow.ErrMediaDownloadFailedWith410) {
+			return nil, fmt.Errorf("%w: %v", message.ErrMediaExpired, err)
+		}
+		return nil, fmt.Errorf("failed to download media: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("failed to read downloaded media: %w", err)
+	}
+
+	return &message.MediaContent{
+		MimeType: media.MimeType,
+		FileName: media.FileName,
+		Size:     info.Size(),
+		Data:     tmp,
+	}, nil
+}
+
 // Adapter functions para compatibilidade com implementações antigas
 
 // NewClientAdapter cria um adapter que substitui o Client antigo
@@ -1356,6 +1614,15 @@ func (uc *UnifiedClient) isValidMediaType(mediaType string) bool {
 
 // parsePhoneToJID converte um número de telefone para JID do WhatsApp
 func (uc *UnifiedClient) parsePhoneToJID(phone string) (types.JID, error) {
+	// JIDs completos (grupos, @lid, newsletters) são usados como informados
+	if strings.Contains(phone, "@") && !strings.HasSuffix(phone, "@"+types.DefaultUserServer) {
+		jid, err := types.ParseJID(phone)
+		if err != nil {
+			return types.EmptyJID, err
+		}
+		return jid, nil
+	}
+
 	// Normalizar número de telefone
 	normalizedPhone := uc.normalizePhoneNumber(phone)
 
@@ -1368,6 +1635,46 @@ func (uc *UnifiedClient) parsePhoneToJID(phone string) (types.JID, error) {
 	return jid, nil
 }
 
+// recordSentMessage grava no histórico da sessão uma mensagem enviada com sucesso.
+// A gravação é síncrona: o registro precisa existir antes que os recibos da mensagem cheguem ao
+// MessageStatusTracker, que ignora recibos de mensagens fora do histórico.
+func (uc *UnifiedClient) recordSentMessage(sessionID uuid.UUID, client *whatsmeow.Client, to types.JID, messageID string, timestamp time.Time, msg *waE2E.Message) {
+	coreManager, ok := uc.manager.(*Manager)
+	if !ok {
+		return
+	}
+
+	sender := types.EmptyJID
+	if client.Store.ID != nil {
+		sender = client.Store.ID.ToNonAD()
+	}
+
+	coreManager.recordMessage(sessionID, services.NormalizeSentMessage(messageID, to, sender, timestamp, msg))
+}
+
+// tempMediaFile remove o arquivo temporário da mídia ao ser fechado
+type tempMediaFile struct {
+	*os.File
+}
+
+func (f *tempMediaFile) Close() error {
+	err := f.File.Close()
+	os.Remove(f.Name())
+	return err
+}
+
+// decodeMediaField decodifica um hash ou chave de mídia em base64, como enviado no webhook
+func decodeMediaField(name, value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s is not valid base64", message.ErrInvalidMediaInfo, name)
+	}
+	return data, nil
+}
+
 // getWhatsmeowClient obtém o cliente whatsmeow da sessão
 func (uc *UnifiedClient) getWhatsmeowClient(sessionID uuid.UUID) (*whatsmeow.Client, error) {
 	// Verificar se o manager é do tipo *Manager (core manager)
@@ -0,0 +1,201 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"zmeow/internal/domain/message"
+)
+
+// buildContextInfo converte o contexto informado na requisição (resposta, menções, encaminhamento e
+// mensagens temporárias) no ContextInfo do WhatsApp. Retorna nil quando não há contexto a anexar.
+func (uc *UnifiedClient) buildContextInfo(ctx context.Context, sessionID uuid.UUID, client *whatsmeow.Client, chat types.JID, info *message.MessageContextInfo) (*waE2E.ContextInfo, error) {
+	if info == nil {
+		return nil, nil
+	}
+
+	result := &waE2E.ContextInfo{}
+	empty := true
+
+	if info.StanzaID != nil && *info.StanzaID != "" {
+		participant, quoted := uc.quotedMessage(ctx, sessionID, client, chat, *info.StanzaID)
+		if info.Participant != nil && *info.Participant != "" {
+			jid, ok := uc.parseJIDLikeWuzapi(*info.Participant)
+			if !ok {
+				return nil, fmt.Errorf("%w: invalid participant %q", message.ErrInvalidContextInfo, *info.Participant)
+			}
+			participant = jid.ToNonAD().String()
+		}
+		if participant == "" {
+			if chat.Server == types.GroupServer {
+				return nil, fmt.Errorf("%w: participant is required to reply to group messages not found in history", message.ErrInvalidContextInfo)
+			}
+			participant = chat.String()
+		}
+
+		result.StanzaID = proto.String(*info.StanzaID)
+		result.Participant = proto.String(participant)
+		result.QuotedMessage = quoted
+		empty = false
+	}
+
+	mentions, err := uc.mentionedJIDs(client, chat, info)
+	if err != nil {
+		return nil, err
+	}
+	if len(mentions) > 0 {
+		result.MentionedJID = mentions
+		empty = false
+	}
+
+	if info.IsForwarded {
+		result.IsForwarded = proto.Bool(true)
+		result.ForwardingScore = proto.Uint32(1)
+		empty = false
+	}
+
+	if info.Expiration > 0 {
+		result.Expiration = proto.Uint32(info.Expiration)
+		empty = false
+	}
+
+	if empty {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// quotedMessage busca no histórico a mensagem respondida, retornando o autor e o conteúdo citado.
+// Mensagens fora do histórico são citadas sem texto; o WhatsApp exibe a cópia local quando existe.
+func (uc *UnifiedClient) quotedMessage(ctx context.Context, sessionID uuid.UUID, client *whatsmeow.Client, chat types.JID, stanzaID string) (string, *waE2E.Message) {
+	quoted := &waE2E.Message{Conversation: proto.String("")}
+
+	coreManager, ok := uc.manager.(*Manager)
+	if !ok || coreManager.messageRepo == nil {
+		return "", quoted
+	}
+
+	msg, err := coreManager.messageRepo.GetByMessageID(ctx, sessionID, stanzaID)
+	if err != nil {
+		if !errors.Is(err, message.ErrMessageNotFound) {
+			uc.logger.WithError(err).WithField("messageId", stanzaID).Warn().Msg("Failed to load quoted message from history")
+		}
+		return "", quoted
+	}
+	if msg.ChatJID != chat.String() {
+		return "", quoted
+	}
+
+	quoted.Conversation = proto.String(msg.Text)
+
+	if msg.Direction == message.DirectionOutbound {
+		if client.Store.ID != nil {
+			return client.Store.ID.ToNonAD().String(), quoted
+		}
+		return "", quoted
+	}
+	if msg.SenderJID != "" {
+		return msg.SenderJID, quoted
+	}
+	return "", quoted
+}
+
+// mentionedJIDs normaliza os JIDs mencionados e, com mentionAll, inclui todos os participantes do grupo.
+// Fora de grupos, mentionAll é ignorado e apenas as menções explícitas são mantidas.
+func (uc *UnifiedClient) mentionedJIDs(client *whatsmeow.Client, chat types.JID, info *message.MessageContextInfo) ([]string, error) {
+	seen := make(map[string]bool)
+	var mentions []string
+	add := func(jid types.JID) {
+		value := jid.ToNonAD().String()
+		if !seen[value] {
+			seen[value] = true
+			mentions = append(mentions, value)
+		}
+	}
+
+	for _, mention := range info.MentionedJIDs {
+		jid, ok := uc.parseJIDLikeWuzapi(mention)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid mentioned JID %q", message.ErrInvalidContextInfo, mention)
+		}
+		add(jid)
+	}
+
+	if !info.MentionAll || chat.Server != types.GroupServer {
+		return mentions, nil
+	}
+
+	group, err := client.GetGroupInfo(chat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group participants: %w", err)
+	}
+
+	var own types.JID
+	if client.Store.ID != nil {
+		own = *client.Store.ID
+	}
+	for _, participant := range mentionableParticipants(group.Participants, own) {
+		add(participant)
+	}
+	return mentions, nil
+}
+
+// mentionableParticipants retorna os participantes do grupo, exceto a própria conta da sessão,
+// comparada tanto pelo JID quanto pelo número (grupos com LID)
+func mentionableParticipants(participants []types.GroupParticipant, own types.JID) []types.JID {
+	own = own.ToNonAD()
+	result := make([]types.JID, 0, len(participants))
+	for _, participant := range participants {
+		if !own.IsEmpty() && (participant.JID.ToNonAD() == own || participant.PhoneNumber.ToNonAD() == own) {
+			continue
+		}
+		result = append(result, participant.JID)
+	}
+	return result
+}
+
+// applyContextInfo anexa o ContextInfo ao conteúdo da mensagem. Textos simples (Conversation) não
+// aceitam contexto e são convertidos em ExtendedTextMessage.
+func applyContextInfo(msg *waE2E.Message, contextInfo *waE2E.ContextInfo) {
+	if msg == nil || contextInfo == nil {
+		return
+	}
+
+	switch {
+	case msg.Conversation != nil:
+		msg.ExtendedTextMessage = &waE2E.ExtendedTextMessage{
+			Text:        msg.Conversation,
+			ContextInfo: contextInfo,
+		}
+		msg.Conversation = nil
+	case msg.ExtendedTextMessage != nil:
+		msg.ExtendedTextMessage.ContextInfo = contextInfo
+	case msg.ImageMessage != nil:
+		msg.ImageMessage.ContextInfo = contextInfo
+	case msg.AudioMessage != nil:
+		msg.AudioMessage.ContextInfo = contextInfo
+	case msg.VideoMessage != nil:
+		msg.VideoMessage.ContextInfo = contextInfo
+	case msg.DocumentMessage != nil:
+		msg.DocumentMessage.ContextInfo = contextInfo
+	case msg.StickerMessage != nil:
+		msg.StickerMessage.ContextInfo = contextInfo
+	case msg.LocationMessage != nil:
+		msg.LocationMessage.ContextInfo = contextInfo
+	case msg.ContactMessage != nil:
+		msg.ContactMessage.ContextInfo = contextInfo
+	case msg.ButtonsMessage != nil:
+		msg.ButtonsMessage.ContextInfo = contextInfo
+	case msg.ListMessage != nil:
+		msg.ListMessage.ContextInfo = contextInfo
+	case msg.PollCreationMessage != nil:
+		msg.PollCreationMessage.ContextInfo = contextInfo
+	}
+}
@@ -0,0 +1,285 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"zmeow/internal/domain/message"
+	"zmeow/pkg/logger"
+)
+
+// historyRepository é um histórico de mensagens em memória indexado pelo ID do WhatsApp
+type historyRepository struct {
+	message.MessageRepository
+	messages map[string]*message.Message
+}
+
+func (r *historyRepository) GetByMessageID(ctx context.Context, sessionID uuid.UUID, messageID string) (*message.Message, error) {
+	if msg, ok := r.messages[messageID]; ok {
+		return msg, nil
+	}
+	return nil, message.ErrMessageNotFound
+}
+
+func TestBuildContextInfo(t *testing.T) {
+	nop := zerolog.Nop()
+	privateChat := types.NewJID("5511999999999", types.DefaultUserServer)
+	groupChat := types.NewJID("120363123456789012", types.GroupServer)
+	own := types.JID{User: "5511000000000", Device: 7, Server: types.DefaultUserServer}
+	client := &whatsmeow.Client{Store: &store.Device{ID: &own}}
+
+	uc := &UnifiedClient{
+		manager: &Manager{messageRepo: &historyRepository{messages: map[string]*message.Message{
+			"INBOUND1":  {ChatJID: privateChat.String(), SenderJID: privateChat.String(), Direction: message.DirectionInbound, Text: "Pergunta"},
+			"OUTBOUND1": {ChatJID: privateChat.String(), Direction: message.DirectionOutbound, Text: "Resposta"},
+			"GROUP1":    {ChatJID: groupChat.String(), SenderJID: "5511888888888@s.whatsapp.net", Direction: message.DirectionInbound, Text: "No grupo"},
+		}}},
+		logger: logger.NewZerologLogger(&nop),
+	}
+
+	tests := []struct {
+		name    string
+		chat    types.JID
+		info    *message.MessageContextInfo
+		want    *waE2E.ContextInfo
+		wantErr error
+	}{
+		{
+			name: "no context",
+			chat: privateChat,
+		},
+		{
+			name: "reply to a received message",
+			chat: privateChat,
+			info: &message.MessageContextInfo{StanzaID: proto.String("INBOUND1")},
+			want: &waE2E.ContextInfo{
+				StanzaID:      proto.String("INBOUND1"),
+				Participant:   proto.String(privateChat.String()),
+				QuotedMessage: &waE2E.Message{Conversation: proto.String("Pergunta")},
+			},
+		},
+		{
+			name: "reply to an own message quotes the session account",
+			chat: privateChat,
+			info: &message.MessageContextInfo{StanzaID: proto.String("OUTBOUND1")},
+			want: &waE2E.ContextInfo{
+				StanzaID:      proto.String("OUTBOUND1"),
+				Participant:   proto.String("5511000000000@s.whatsapp.net"),
+				QuotedMessage: &waE2E.Message{Conversation: proto.String("Resposta")},
+			},
+		},
+		{
+			name: "reply in a group uses the author from history",
+			chat: groupChat,
+			info: &message.MessageContextInfo{StanzaID: proto.String("GROUP1")},
+			want: &waE2E.ContextInfo{
+				StanzaID:      proto.String("GROUP1"),
+				Participant:   proto.String("5511888888888@s.whatsapp.net"),
+				QuotedMessage: &waE2E.Message{Conversation: proto.String("No grupo")},
+			},
+		},
+		{
+			name: "reply to a message outside history in a private chat",
+			chat: privateChat,
+			info: &message.MessageContextInfo{StanzaID: proto.String("UNKNOWN1")},
+			want: &waE2E.ContextInfo{
+				StanzaID:      proto.String("UNKNOWN1"),
+				Participant:   proto.String(privateChat.String()),
+				QuotedMessage: &waE2E.Message{Conversation: proto.String("")},
+			},
+		},
+		{
+			name: "reply to a message of another chat is not quoted from history",
+			chat: groupChat,
+			info: &message.MessageContextInfo{StanzaID: proto.String("INBOUND1"), Participant: proto.String("5511777777777")},
+			want: &waE2E.ContextInfo{
+				StanzaID:      proto.String("INBOUND1"),
+				Participant:   proto.String("5511777777777@s.whatsapp.net"),
+				QuotedMessage: &waE2E.Message{Conversation: proto.String("")},
+			},
+		},
+		{
+			name: "informed participant overrides history",
+			chat: groupChat,
+			info: &message.MessageContextInfo{StanzaID: proto.String("GROUP1"), Participant: proto.String("5511777777777:3@s.whatsapp.net")},
+			want: &waE2E.ContextInfo{
+				StanzaID:      proto.String("GROUP1"),
+				Participant:   proto.String("5511777777777@s.whatsapp.net"),
+				QuotedMessage: &waE2E.Message{Conversation: proto.String("No grupo")},
+			},
+		},
+		{
+			name:    "group reply outside history without participant",
+			chat:    groupChat,
+			info:    &message.MessageContextInfo{StanzaID: proto.String("UNKNOWN1")},
+			wantErr: message.ErrInvalidContextInfo,
+		},
+		{
+			name:    "invalid participant",
+			chat:    privateChat,
+			info:    &message.MessageContextInfo{StanzaID: proto.String("INBOUND1"), Participant: proto.String("@s.whatsapp.net")},
+			wantErr: message.ErrInvalidContextInfo,
+		},
+		{
+			name: "mentions are normalized and deduplicated",
+			chat: privateChat,
+			info: &message.MessageContextInfo{MentionedJIDs: []string{"+5511777777777", "5511777777777@s.whatsapp.net", "5511666666666:2@s.whatsapp.net"}},
+			want: &waE2E.ContextInfo{MentionedJID: []string{"5511777777777@s.whatsapp.net", "5511666666666@s.whatsapp.net"}},
+		},
+		{
+			name:    "invalid mention",
+			chat:    privateChat,
+			info:    &message.MessageContextInfo{MentionedJIDs: []string{"@s.whatsapp.net"}},
+			wantErr: message.ErrInvalidContextInfo,
+		},
+		{
+			name: "mention all outside a group keeps only explicit mentions",
+			chat: privateChat,
+			info: &message.MessageContextInfo{MentionAll: true, MentionedJIDs: []string{"5511777777777"}},
+			want: &waE2E.ContextInfo{MentionedJID: []string{"5511777777777@s.whatsapp.net"}},
+		},
+		{
+			name: "mention all alone outside a group is ignored",
+			chat: privateChat,
+			info: &message.MessageContextInfo{MentionAll: true},
+		},
+		{
+			name: "forwarded with expiration",
+			chat: privateChat,
+			info: &message.MessageContextInfo{IsForwarded: true, Expiration: 604800},
+			want: &waE2E.ContextInfo{IsForwarded: proto.Bool(true), ForwardingScore: proto.Uint32(1), Expiration: proto.Uint32(604800)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.buildContextInfo(context.Background(), uuid.New(), client, tt.chat, tt.info)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("buildContextInfo() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildContextInfo() error = %v", err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Fatalf("buildContextInfo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMentionableParticipants(t *testing.T) {
+	own := types.JID{User: "5511000000000", Device: 7, Server: types.DefaultUserServer}
+	phone := func(user string) types.JID { return types.NewJID(user, types.DefaultUserServer) }
+	lid := func(user string) types.JID { return types.NewJID(user, types.HiddenUserServer) }
+
+	tests := []struct {
+		name         string
+		participants []types.GroupParticipant
+		own          types.JID
+		want         []types.JID
+	}{
+		{
+			name: "excludes the session account by phone number",
+			participants: []types.GroupParticipant{
+				{JID: phone("5511111111111")},
+				{JID: phone("5511000000000")},
+				{JID: phone("5511222222222")},
+			},
+			own:  own,
+			want: []types.JID{phone("5511111111111"), phone("5511222222222")},
+		},
+		{
+			name: "excludes the session account in LID groups",
+			participants: []types.GroupParticipant{
+				{JID: lid("111111111111111"), PhoneNumber: phone("5511111111111")},
+				{JID: lid("100000000000000"), PhoneNumber: phone("5511000000000")},
+			},
+			own:  own,
+			want: []types.JID{lid("111111111111111")},
+		},
+		{
+			name:         "without a logged in account every participant is mentioned",
+			participants: []types.GroupParticipant{{JID: phone("5511111111111")}, {JID: phone("5511000000000")}},
+			want:         []types.JID{phone("5511111111111"), phone("5511000000000")},
+		},
+		{
+			name: "empty group",
+			own:  own,
+			want: []types.JID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mentionableParticipants(tt.participants, tt.own)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("mentionableParticipants() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyContextInfo(t *testing.T) {
+	contextInfo := &waE2E.ContextInfo{StanzaID: proto.String("QUOTED1"), MentionedJID: []string{"5511777777777@s.whatsapp.net"}}
+
+	tests := []struct {
+		name string
+		msg  *waE2E.Message
+		get  func(msg *waE2E.Message) *waE2E.ContextInfo
+	}{
+		{name: "extended text", msg: &waE2E.Message{ExtendedTextMessage: &waE2E.ExtendedTextMessage{Text: proto.String("Olá")}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetExtendedTextMessage().GetContextInfo() }},
+		{name: "image", msg: &waE2E.Message{ImageMessage: &waE2E.ImageMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetImageMessage().GetContextInfo() }},
+		{name: "audio", msg: &waE2E.Message{AudioMessage: &waE2E.AudioMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetAudioMessage().GetContextInfo() }},
+		{name: "video", msg: &waE2E.Message{VideoMessage: &waE2E.VideoMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetVideoMessage().GetContextInfo() }},
+		{name: "document", msg: &waE2E.Message{DocumentMessage: &waE2E.DocumentMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetDocumentMessage().GetContextInfo() }},
+		{name: "sticker", msg: &waE2E.Message{StickerMessage: &waE2E.StickerMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetStickerMessage().GetContextInfo() }},
+		{name: "location", msg: &waE2E.Message{LocationMessage: &waE2E.LocationMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetLocationMessage().GetContextInfo() }},
+		{name: "contact", msg: &waE2E.Message{ContactMessage: &waE2E.ContactMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetContactMessage().GetContextInfo() }},
+		{name: "buttons", msg: &waE2E.Message{ButtonsMessage: &waE2E.ButtonsMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetButtonsMessage().GetContextInfo() }},
+		{name: "list", msg: &waE2E.Message{ListMessage: &waE2E.ListMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetListMessage().GetContextInfo() }},
+		{name: "poll", msg: &waE2E.Message{PollCreationMessage: &waE2E.PollCreationMessage{}}, get: func(m *waE2E.Message) *waE2E.ContextInfo { return m.GetPollCreationMessage().GetContextInfo() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyContextInfo(tt.msg, contextInfo)
+			if got := tt.get(tt.msg); got != contextInfo {
+				t.Fatalf("ContextInfo = %v, want %v", got, contextInfo)
+			}
+		})
+	}
+
+	t.Run("plain text becomes extended text", func(t *testing.T) {
+		msg := &waE2E.Message{Conversation: proto.String("Olá")}
+		applyContextInfo(msg, contextInfo)
+
+		if msg.Conversation != nil {
+			t.Fatal("Conversation was kept, want it moved to ExtendedTextMessage")
+		}
+		if msg.GetExtendedTextMessage().GetText() != "Olá" || msg.GetExtendedTextMessage().GetContextInfo() != contextInfo {
+			t.Fatalf("ExtendedTextMessage = %v, want the text with the context", msg.GetExtendedTextMessage())
+		}
+	})
+
+	t.Run("nil context leaves the message untouched", func(t *testing.T) {
+		msg := &waE2E.Message{Conversation: proto.String("Olá")}
+		applyContextInfo(msg, nil)
+
+		if msg.GetConversation() != "Olá" || msg.ExtendedTextMessage != nil {
+			t.Fatalf("message = %v, want it unchanged", msg)
+		}
+	})
+}
@@ -6,11 +6,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/store/sqlstore"
 
 	"zmeow/internal/app/config"
 	"zmeow/internal/domain/whatsapp"
 	"zmeow/internal/infra/database"
+	"zmeow/internal/infra/storage"
 	"zmeow/internal/infra/whatsapp/connection"
 	"zmeow/internal/infra/whatsapp/events"
 	"zmeow/internal/infra/whatsapp/services"
@@ -40,13 +42,53 @@ func NewServiceFactory(db *bun.DB, container *sqlstore.Container, cfg *config.Co
 func (f *ServiceFactory) CreateServices() (*WhatsAppServices, error) {
 	// Criar repositórios
 	sessionRepo := database.NewSessionRepository(f.db)
+	messageRepo := database.NewMessageRepository(f.db)
+	chatRepo := database.NewChatRepository(f.db)
+	contactRepo := database.NewContactRepository(f.db)
 
 	// Criar serviços base
 	sessionManager := session.NewSessionManager(f.container, sessionRepo, f.logger)
 	qrManager := connection.NewQRCodeManager(f.logger)
 	webhookService := services.NewWebhookService(f.logger)
-	eventProcessor := events.NewEventProcessor(sessionManager, sessionRepo, webhookService, f.logger)
-	connectionManager := connection.NewConnectionManager(sessionManager, qrManager, eventProcessor, f.logger)
+
+	// Download automático de mídias, quando há armazenamento configurado
+	mediaStorage, err := storage.New(f.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize media storage: %w", err)
+	}
+	var mediaArchiver *services.MediaArchiver
+	if mediaStorage != nil {
+		mediaArchiver = services.NewMediaArchiver(mediaStorage, storage.NewURLSigner(f.config), sessionRepo, f.logger)
+	}
+
+	eventBus := services.NewEventBus(f.config.Events.BufferSize, f.logger)
+	metrics := services.NewEventMetrics()
+
+	clients := func(sessionID uuid.UUID) *whatsmeow.Client {
+		state, err := sessionManager.GetSession(sessionID)
+		if err != nil {
+			return nil
+		}
+		return state.Client
+	}
+	historySync := services.NewHistorySyncIngester(
+		sessionRepo,
+		messageRepo,
+		chatRepo,
+		contactRepo,
+		f.logger,
+	)
+	translator := events.NewTranslator(clients, messageRepo, mediaArchiver, historySync, f.logger)
+	dispatcher := events.NewDispatcher(translator, f.config.Events.QueueSize, f.config.Events.FollowUpWorkers, f.logger)
+	dispatcher.Register(events.NewSessionStateSink(sessionManager))
+	dispatcher.Register(events.NewDatabaseSink(sessionRepo, messageRepo))
+	dispatcher.Register(events.NewChatSink(chatRepo, messageRepo))
+	dispatcher.Register(events.NewContactSink(contactRepo))
+	dispatcher.Register(events.NewCallPolicySink(clients, services.NewCallResponder(sessionRepo, messageRepo, f.logger)))
+	dispatcher.Register(events.NewEventBusSink(eventBus))
+	dispatcher.Register(events.NewWebhookSink(webhookService))
+	dispatcher.Register(events.NewMetricsSink(metrics))
+	connectionManager := connection.NewConnectionManager(sessionManager, qrManager, dispatcher, f.logger)
 
 	// Iniciar rotina de limpeza do QR Manager
 	go qrManager.StartCleanupRoutine(context.Background())
@@ -61,10 +103,12 @@ func (f *ServiceFactory) CreateServices() (*WhatsAppServices, error) {
 
 	return &WhatsAppServices{
 		SessionManager:    sessionManager,
-		EventProcessor:    eventProcessor,
+		EventDispatcher:   dispatcher,
 		QRManager:         qrManager,
 		ConnectionManager: connectionManager,
 		WebhookService:    webhookService,
+		EventBus:          eventBus,
+		Metrics:           metrics,
 		ConfigService:     configService,
 		ValidationService: validationService,
 		SecurityService:   securityService,
@@ -74,10 +118,12 @@ func (f *ServiceFactory) CreateServices() (*WhatsAppServices, error) {
 // WhatsAppServices agrupa todos os serviços WhatsApp
 type WhatsAppServices struct {
 	SessionManager    *session.SessionManager
-	EventProcessor    *events.EventProcessor
+	EventDispatcher   *events.Dispatcher
 	QRManager         *connection.QRCodeManager
 	ConnectionManager *connection.ConnectionManager
 	WebhookService    *services.WebhookServiceImpl
+	EventBus          *services.EventBusImpl
+	Metrics           *services.EventMetrics
 	ConfigService     whatsapp.ConfigService
 	ValidationService whatsapp.ValidationService
 	SecurityService   whatsapp.SecurityService
@@ -2,12 +2,9 @@ package core
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
-	"reflect"
-	"strings"
 	"sync"
 	"time"
 
@@ -16,13 +13,18 @@ import (
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/store/sqlstore"
 	"go.mau.fi/whatsmeow/types"
-	"go.mau.fi/whatsmeow/types/events"
 
 	"zmeow/internal/app/config"
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
 	"zmeow/internal/domain/session"
 	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/broker"
 	"zmeow/internal/infra/database"
+	"zmeow/internal/infra/storage"
 	"zmeow/internal/infra/whatsapp/connection"
+	"zmeow/internal/infra/whatsapp/events"
+	"zmeow/internal/infra/whatsapp/services"
 	sessionPkg "zmeow/internal/infra/whatsapp/session"
 	"zmeow/pkg/logger"
 )
@@ -87,13 +89,15 @@ const (
 
 // Event types
 const (
-	EventConnected    = "connected"
-	EventDisconnected = "disconnected"
-	EventQRCode       = "qr_code"
-	EventMessage      = "message"
-	EventError        = "error"
-	EventPairSuccess  = "pair_success"
-	EventSessionReady = "session_ready"
+	EventConnected     = "connected"
+	EventDisconnected  = "disconnected"
+	EventQRCode        = "qr_code"
+	EventMessage       = "message"
+	EventMessageStatus = "message.status"
+	EventError         = "error"
+	EventPairSuccess   = "pair_success"
+	EventPairError     = "pair_error"
+	EventSessionReady  = "session_ready"
 )
 
 // Component names for logging
@@ -164,6 +168,28 @@ type Manager struct {
 
 	// Connection management
 	connectionManager *connection.ConnectionManager
+
+	// Webhooks
+	webhookService *services.WebhookServiceImpl
+
+	// Eventos ao vivo (WebSocket/SSE)
+	eventBus *services.EventBusImpl
+
+	// Histórico de mensagens e chats
+	messageRepo message.MessageRepository
+	chatRepo    chat.ChatRepository
+
+	// Download automático de mídias (nil quando o armazenamento está desabilitado)
+	mediaArchiver *services.MediaArchiver
+
+	// Métricas em memória alimentadas pelos eventos das sessões
+	metrics *services.EventMetrics
+
+	// Pipeline único dos eventos do whatsmeow
+	dispatcher *events.Dispatcher
+
+	// Broker que recebe os eventos (nil quando desabilitado)
+	eventPublisher broker.Publisher
 }
 
 // ============================================================================
@@ -187,6 +213,40 @@ func NewManager(db *bun.DB, cfg *config.Config, log logger.Logger) (*Manager, er
 		config:        cfg,
 	}
 
+	// Inicializar WebhookService com outbox persistente
+	manager.webhookService = services.NewWebhookServiceWithOutbox(log,
+		database.NewWebhookDeliveryRepository(db),
+		services.WebhookOutboxOptions{
+			Workers:      cfg.Webhook.Workers,
+			PollInterval: cfg.Webhook.PollInterval,
+			BaseBackoff:  cfg.Webhook.BaseBackoff,
+			MaxBackoff:   cfg.Webhook.MaxBackoff,
+		},
+	)
+
+	// EventBus consumido pelos streams de eventos ao vivo
+	manager.eventBus = services.NewEventBus(cfg.Events.BufferSize, log)
+
+	// Histórico de mensagens recebidas e enviadas
+	manager.messageRepo = database.NewMessageRepository(db)
+	manager.chatRepo = database.NewChatRepository(db)
+	manager.metrics = services.NewEventMetrics()
+
+	// Armazenamento das mídias baixadas automaticamente
+	mediaStorage, err := storage.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize media storage: %w", err)
+	}
+	if mediaStorage != nil {
+		manager.mediaArchiver = services.NewMediaArchiver(mediaStorage, storage.NewURLSigner(cfg), database.NewSessionRepository(db), log)
+	}
+
+	// Broker de mensagens que recebe os eventos, quando configurado
+	manager.eventPublisher, err = broker.New(cfg, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize event broker: %w", err)
+	}
+
 	// Inicializar ConnectionManager
 	manager.initConnectionManager()
 
@@ -201,18 +261,50 @@ func (m *Manager) initConnectionManager() {
 	// Criar QRCodeManager
 	qrManager := connection.NewQRCodeManager(m.logger)
 
-	// Criar EventProcessor
-	eventProcessor := &EventProcessorWrapper{manager: m}
+	// Criar o dispatcher de eventos e registrar os sinks, chamados nesta ordem para cada evento
+	contactRepo := database.NewContactRepository(m.db)
+	historySync := services.NewHistorySyncIngester(
+		database.NewSessionRepository(m.db),
+		m.messageRepo,
+		m.chatRepo,
+		contactRepo,
+		m.logger,
+	)
+	translator := events.NewTranslator(m.getClient, m.messageRepo, m.mediaArchiver, historySync, m.logger)
+	m.dispatcher = events.NewDispatcher(translator, m.config.Events.QueueSize, m.config.Events.FollowUpWorkers, m.logger)
+	m.dispatcher.Register(events.NewSessionStateSink(sessionManager))
+	m.dispatcher.Register(events.NewDatabaseSink(database.NewSessionRepository(m.db), m.messageRepo))
+	m.dispatcher.Register(events.NewChatSink(m.chatRepo, m.messageRepo))
+	m.dispatcher.Register(events.NewContactSink(contactRepo))
+	m.dispatcher.Register(events.NewCampaignSink(database.NewCampaignRepository(m.db)))
+	m.dispatcher.Register(events.NewCallPolicySink(m.getClient, services.NewCallResponder(database.NewSessionRepository(m.db), m.messageRepo, m.logger)))
+	m.dispatcher.Register(events.NewEventBusSink(m.eventBus))
+	m.dispatcher.Register(events.NewWebhookSink(m.webhookService))
+	m.dispatcher.Register(events.NewMetricsSink(m.metrics))
+	if m.eventPublisher != nil {
+		m.dispatcher.Register(events.NewBrokerSink(m.eventPublisher))
+	}
 
 	// Criar ConnectionManager
 	m.connectionManager = connection.NewConnectionManager(
 		sessionManager,
 		qrManager,
-		eventProcessor,
+		m.dispatcher,
 		m.logger,
 	)
 }
 
+// getClient retorna o cliente whatsmeow de uma sessão carregada
+func (m *Manager) getClient(sessionID uuid.UUID) *whatsmeow.Client {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if state, exists := m.sessionStates[sessionID]; exists {
+		return state.Client
+	}
+	return nil
+}
+
 // ============================================================================
 // CONFIG ADAPTER METHODS (from config_adapter.go)
 // ============================================================================
@@ -385,6 +477,11 @@ func (m *Manager) DeleteSession(ctx context.Context, sessionID uuid.UUID) error
 	delete(m.sessionStates, sessionID)
 	m.mutex.Unlock()
 
+	// Encerrar a fila de eventos e descartar os eventos recentes e as métricas da sessão
+	m.dispatcher.RemoveSession(sessionID)
+	m.eventBus.RemoveSession(sessionID)
+	m.metrics.RemoveSession(sessionID)
+
 	// Remover do banco de dados
 	repo := database.NewSessionRepository(m.db)
 	if err := repo.Delete(ctx, sessionID); err != nil {
@@ -583,6 +680,8 @@ func (m *Manager) RemoveSession(sessionID uuid.UUID) error {
 	delete(m.sessionStates, sessionID)
 	m.mutex.Unlock()
 
+	m.dispatcher.RemoveSession(sessionID)
+
 	m.logger.WithField("session_id", sessionID).Info().Msg("Session removed successfully")
 	return nil
 }
@@ -678,6 +777,76 @@ func (m *Manager) RestoreSessions(ctx context.Context) error {
 	return nil
 }
 
+// LoadWebhookConfigs carrega as configurações de webhook persistidas no WebhookService
+func (m *Manager) LoadWebhookConfigs(ctx context.Context) error {
+	repo := database.NewWebhookRepository(m.db)
+	webhooks, err := repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load webhook configs from database: %w", err)
+	}
+
+	configs := make([]*services.WebhookConfig, 0, len(webhooks))
+	for _, wh := range webhooks {
+		configs = append(configs, wh.ToConfig())
+	}
+
+	m.webhookService.LoadConfigs(configs)
+	return nil
+}
+
+// recordMessage grava uma mensagem normalizada no histórico da sessão e atualiza o chat
+func (m *Manager) recordMessage(sessionID uuid.UUID, msg *message.InboundMessage) {
+	if m.messageRepo == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := m.messageRepo.Save(ctx, message.NewMessage(sessionID, msg)); err != nil {
+		m.logger.WithError(err).WithFields(map[string]interface{}{
+			"sessionId": sessionID,
+			"messageId": msg.ID,
+		}).Error().Msg("Failed to record message in history")
+	}
+
+	if !msg.Type.IsChatMessage() {
+		return
+	}
+	if err := m.chatRepo.RecordMessage(ctx, sessionID, msg.Chat, msg.IsGroup, msg.FromMe, msg.Timestamp); err != nil {
+		m.logger.WithError(err).WithFields(map[string]interface{}{
+			"sessionId": sessionID,
+			"chat":      msg.Chat,
+		}).Error().Msg("Failed to record message in chat")
+	}
+}
+
+// StartWebhookDelivery inicia os workers de entrega do outbox de webhooks.
+// Deve ser chamado após LoadWebhookConfigs, para que as entregas pendentes encontrem suas configurações.
+func (m *Manager) StartWebhookDelivery() {
+	m.webhookService.Start()
+}
+
+// GetWebhookService retorna o serviço de webhooks usado pelo manager
+func (m *Manager) GetWebhookService() whatsapp.WebhookService {
+	return m.webhookService
+}
+
+// GetMetrics retorna as métricas das sessões alimentadas pelo dispatcher de eventos
+func (m *Manager) GetMetrics() whatsapp.MetricsService {
+	return m.metrics
+}
+
+// PublishEvent entrega aos sinks do dispatcher um evento gerado pela aplicação
+func (m *Manager) PublishEvent(event whatsapp.Event) {
+	m.dispatcher.Publish(event)
+}
+
+// GetEventBus retorna o EventBus em que o manager publica os eventos das sessões
+func (m *Manager) GetEventBus() whatsapp.EventStream {
+	return m.eventBus
+}
+
 // ConnectRestoredSessions conecta automaticamente todas as sessões restauradas
 func (m *Manager) ConnectRestoredSessions(ctx context.Context) {
 	m.logger.Debug().Msg("Starting automatic connection of restored sessions")
@@ -858,512 +1027,33 @@ func (smw *SessionManagerWrapper) SetEventHandler(sessionID uuid.UUID, handlerID
 	return nil
 }
 
-// EventProcessorWrapper adapta o Manager para implementar EventProcessorInterface
-type EventProcessorWrapper struct {
-	manager *Manager
-}
-
-func (epw *EventProcessorWrapper) ProcessEvent(sessionID uuid.UUID, evt interface{}) {
-	eventType := fmt.Sprintf("%T", evt)
-
-	// Log do evento para debugging
-	epw.manager.logger.WithFields(map[string]interface{}{
-		"sessionId":  sessionID,
-		"eventType":  eventType,
-		"rawPayload": evt,
-	}).Trace().Msg("Raw WhatsApp Event Payload")
-
-	// Processar eventos específicos
-	switch e := evt.(type) {
-	case *events.Connected:
-		epw.handleConnected(sessionID, e)
-	case *events.Disconnected:
-		epw.handleDisconnected(sessionID, e)
-	case *events.LoggedOut:
-		epw.handleLoggedOut(sessionID, e)
-	case *events.PairSuccess:
-		epw.handlePairSuccess(sessionID, e)
-	case *events.Message:
-		epw.handleMessage(sessionID, e)
-	case *events.Receipt:
-		// Processar recibo (lógica futura aqui)
-	default:
-		epw.manager.logger.WithFields(map[string]interface{}{
-			"sessionId": sessionID,
-			"eventType": eventType,
-		}).Debug().Msg("Unhandled WhatsApp event")
-	}
-
-	// Log consolidado do evento com mensagens descritivas
-	rawData := extractEventData(evt)
-	message := getEventMessage(eventType, evt)
-
-	epw.manager.logger.WithFields(map[string]interface{}{
-		"eventType": eventType,
-		"sessionId": sessionID,
-	}).Info().Msgf("%s raw=%s", message, rawData)
-}
-
-// handleConnected processa evento de conexão
-func (epw *EventProcessorWrapper) handleConnected(sessionID uuid.UUID, _ *events.Connected) {
-	epw.manager.mutex.Lock()
-	defer epw.manager.mutex.Unlock()
-
-	state, exists := epw.manager.sessionStates[sessionID]
-	if !exists {
-		epw.manager.logger.WithField("sessionId", sessionID).Error().Msg("Session not found for connected event")
-		return
-	}
-
-	// Atualizar status na memória
-	state.Status = StatusConnected
-	now := time.Now()
-	state.LastSeen = &now
-
-	// Atualizar JID se disponível
-	if state.Client != nil && state.Client.Store.ID != nil {
-		state.JID = state.Client.Store.ID
-	}
-
-	epw.manager.logger.WithFields(map[string]interface{}{
-		"sessionId": sessionID,
-		"jid":       state.JID,
-	}).Info().Msg("Session connected")
-
-	// Atualizar banco de dados
-	go epw.updateDatabaseOnConnect(sessionID, state.JID)
-}
-
-// handleDisconnected processa evento de desconexão
-func (epw *EventProcessorWrapper) handleDisconnected(sessionID uuid.UUID, _ *events.Disconnected) {
-	epw.manager.mutex.Lock()
-	defer epw.manager.mutex.Unlock()
-
-	state, exists := epw.manager.sessionStates[sessionID]
-	if !exists {
-		epw.manager.logger.WithField("sessionId", sessionID).Error().Msg("Session not found for disconnected event")
-		return
-	}
-
-	// Atualizar status na memória
-	state.Status = StatusDisconnected
-	now := time.Now()
-	state.LastSeen = &now
-
-	epw.manager.logger.WithField("sessionId", sessionID).Info().Msg("Session disconnected")
-
-	// Atualizar banco de dados
-	go epw.updateDatabaseStatus(sessionID, session.WhatsAppStatusDisconnected)
-}
-
-// handleLoggedOut processa evento de logout
-func (epw *EventProcessorWrapper) handleLoggedOut(sessionID uuid.UUID, _ *events.LoggedOut) {
-	epw.manager.mutex.Lock()
-	defer epw.manager.mutex.Unlock()
-
-	state, exists := epw.manager.sessionStates[sessionID]
-	if !exists {
-		epw.manager.logger.WithField("sessionId", sessionID).Error().Msg("Session not found for logged out event")
-		return
-	}
-
-	// Atualizar status na memória
-	state.Status = StatusDisconnected
-	state.JID = nil
-	now := time.Now()
-	state.LastSeen = &now
-
-	epw.manager.logger.WithField("sessionId", sessionID).Warn().Msg("Session logged out")
-
-	// Atualizar banco de dados
-	go epw.updateDatabaseOnLogout(sessionID)
-}
-
-// handlePairSuccess processa sucesso no pareamento
-func (epw *EventProcessorWrapper) handlePairSuccess(sessionID uuid.UUID, evt *events.PairSuccess) {
-	epw.manager.mutex.Lock()
-	defer epw.manager.mutex.Unlock()
-
-	state, exists := epw.manager.sessionStates[sessionID]
-	if !exists {
-		epw.manager.logger.WithField("sessionId", sessionID).Error().Msg("Session not found for pair success event")
-		return
-	}
-
-	// Atualizar JID na memória
-	state.JID = &evt.ID
-
-	epw.manager.logger.WithFields(map[string]interface{}{
-		"sessionId": sessionID,
-		"jid":       evt.ID.String(),
-	}).Info().Msg("Phone pairing successful")
-
-	// Salvar WaJID no banco de dados
-	go epw.saveWaJIDToDatabase(sessionID, evt.ID.String())
-}
-
-// handleMessage processa mensagens recebidas
-func (epw *EventProcessorWrapper) handleMessage(sessionID uuid.UUID, evt *events.Message) {
-	epw.manager.mutex.Lock()
-	defer epw.manager.mutex.Unlock()
-
-	state, exists := epw.manager.sessionStates[sessionID]
-	if !exists {
-		epw.manager.logger.WithField("sessionId", sessionID).Error().Msg("Session not found for message event")
-		return
-	}
-
-	// Atualizar último visto
-	now := time.Now()
-	state.LastSeen = &now
-
-	epw.manager.logger.WithFields(map[string]interface{}{
-		"sessionId": sessionID,
-		"messageId": evt.Info.ID,
-		"from":      evt.Info.Sender.String(),
-		"timestamp": evt.Info.Timestamp,
-	}).Info().Msg("Message received")
-
-	// Atualizar último visto no banco
-	go epw.updateLastSeen(sessionID)
-}
-
-// Métodos auxiliares para atualização do banco de dados
-func (epw *EventProcessorWrapper) updateDatabaseOnConnect(sessionID uuid.UUID, jid *types.JID) {
-	ctx := context.Background()
-	repo := database.NewSessionRepository(epw.manager.db)
-
-	// Atualizar status para conectado
-	if err := repo.UpdateStatus(ctx, sessionID, session.WhatsAppStatusConnected); err != nil {
-		epw.manager.logger.WithError(err).Error().Msg("Failed to update status to connected in database")
-	}
-
-	// Atualizar JID se disponível
-	if jid != nil {
-		if err := repo.UpdateJID(ctx, sessionID, jid.String()); err != nil {
-			epw.manager.logger.WithError(err).Error().Msg("Failed to update JID in database")
-		}
-	}
-
-	epw.manager.logger.WithField("sessionId", sessionID).Debug().Msg("Database updated on connect")
-}
-
-func (epw *EventProcessorWrapper) updateDatabaseStatus(sessionID uuid.UUID, status session.WhatsAppSessionStatus) {
-	ctx := context.Background()
-	repo := database.NewSessionRepository(epw.manager.db)
-
-	if err := repo.UpdateStatus(ctx, sessionID, status); err != nil {
-		epw.manager.logger.WithError(err).Error().Msg("Failed to update status in database")
-	}
-
-	epw.manager.logger.WithFields(map[string]interface{}{
-		"sessionId": sessionID,
-		"status":    status,
-	}).Debug().Msg("Database status updated")
-}
-
-func (epw *EventProcessorWrapper) updateDatabaseOnLogout(sessionID uuid.UUID) {
-	ctx := context.Background()
-	repo := database.NewSessionRepository(epw.manager.db)
-
-	// Atualizar status para desconectado
-	if err := repo.UpdateStatus(ctx, sessionID, session.WhatsAppStatusDisconnected); err != nil {
-		epw.manager.logger.WithError(err).Error().Msg("Failed to update status to disconnected in database")
-	}
-
-	// Limpar JID
-	if err := repo.UpdateJID(ctx, sessionID, ""); err != nil {
-		epw.manager.logger.WithError(err).Error().Msg("Failed to clear JID in database")
-	}
-
-	epw.manager.logger.WithField("sessionId", sessionID).Debug().Msg("Database updated on logout")
-}
-
-func (epw *EventProcessorWrapper) updateLastSeen(sessionID uuid.UUID) {
-	ctx := context.Background()
-	repo := database.NewSessionRepository(epw.manager.db)
-
-	if err := repo.UpdateLastSeen(ctx, sessionID); err != nil {
-		epw.manager.logger.WithError(err).Error().Msg("Failed to update last seen in database")
-	}
-}
-
-// getEventMessage retorna uma mensagem descritiva para cada tipo de evento
-func getEventMessage(eventType string, evt interface{}) string {
-	switch eventType {
-	case "*events.Connected":
-		return "Conexão estabelecida com sucesso"
-	case "*events.Disconnected":
-		return "Conexão encerrada"
-	case "*events.Message":
-		if msg, ok := evt.(*events.Message); ok {
-			if msg.Info.IsFromMe {
-				return "Mensagem enviada"
-			}
-			return "Mensagem recebida"
-		}
-		return "Evento de mensagem"
-	case "*events.Receipt":
-		if receipt, ok := evt.(*events.Receipt); ok {
-			switch receipt.Type {
-			case types.ReceiptTypeDelivered:
-				return "Mensagem entregue"
-			case types.ReceiptTypeRead:
-				return "Mensagem lida"
-			case types.ReceiptTypePlayed:
-				return "Áudio/vídeo reproduzido"
-			default:
-				return "Recibo de mensagem"
-			}
-		}
-		return "Recibo de mensagem"
-	case "*events.Presence":
-		if presence, ok := evt.(*events.Presence); ok {
-			switch presence.Unavailable {
-			case false:
-				return "Usuário online"
-			default:
-				return "Usuário offline"
-			}
-		}
-		return "Status de presença"
-	case "*events.ChatPresence":
-		if chatPresence, ok := evt.(*events.ChatPresence); ok {
-			switch chatPresence.State {
-			case "composing":
-				return "Usuário digitando"
-			case "recording":
-				return "Usuário gravando áudio"
-			case "paused":
-				return "Usuário parou de digitar"
-			default:
-				return "Status de digitação"
-			}
-		}
-		return "Status de digitação"
-	case "*events.PairSuccess":
-		return "Dispositivo pareado com sucesso"
-	case "*events.PairError":
-		return "Erro no pareamento do dispositivo"
-	case "*events.LoggedOut":
-		return "Sessão desconectada remotamente"
-	case "*events.OfflineSyncPreview":
-		if sync, ok := evt.(*events.OfflineSyncPreview); ok {
-			return fmt.Sprintf("Sincronização offline iniciada (%d mensagens pendentes)", sync.Total)
-		}
-		return "Sincronização offline iniciada"
-	case "*events.OfflineSyncCompleted":
-		if sync, ok := evt.(*events.OfflineSyncCompleted); ok {
-			return fmt.Sprintf("Sincronização offline concluída (%d mensagens processadas)", sync.Count)
-		}
-		return "Sincronização offline concluída"
-	case "*events.HistorySync":
-		return "Histórico sincronizado"
-	case "*events.AppState":
-		return "Estado da aplicação atualizado"
-	case "*events.KeepAliveTimeout":
-		return "Timeout de keep-alive"
-	case "*events.KeepAliveRestored":
-		return "Keep-alive restaurado"
-	case "*events.Blocklist":
-		return "Lista de bloqueios atualizada"
-	case "*events.Contact":
-		return "Contato atualizado"
-	case "*events.PushName":
-		return "Nome do contato atualizado"
-	case "*events.GroupInfo":
-		return "Informações do grupo atualizadas"
-	case "*events.JoinedGroup":
-		return "Adicionado ao grupo"
-	case "*events.Newsletter":
-		return "Newsletter atualizada"
-	case "*events.CallOffer":
-		return "Chamada recebida"
-	case "*events.CallAccept":
-		return "Chamada aceita"
-	case "*events.CallPreAccept":
-		return "Chamada pré-aceita"
-	case "*events.CallTransport":
-		return "Transporte de chamada"
-	case "*events.CallRelayLatency":
-		return "Latência de chamada"
-	case "*events.CallTerminate":
-		return "Chamada encerrada"
-	case "*events.UnknownCallEvent":
-		return "Evento de chamada desconhecido"
-	case "*events.UndecryptableMessage":
-		return "Mensagem não descriptografável"
-	case "*events.MediaRetry":
-		return "Tentativa de reenvio de mídia"
-	case "*events.AppStateSyncComplete":
-		return "Sincronização de estado completa"
-	case "*events.PictureUpdate":
-		return "Foto de perfil atualizada"
-	case "*events.IdentityChange":
-		return "Identidade alterada"
-	case "*events.PrivacySettings":
-		return "Configurações de privacidade atualizadas"
-	case "*events.TempBan":
-		return "Banimento temporário"
-	case "*events.ConnectFailure":
-		return "Falha na conexão"
-	case "*events.ClientOutdated":
-		return "Cliente desatualizado"
-	case "*events.StreamReplaced":
-		return "Stream substituído"
-	case "*events.StreamError":
-		return "Erro no stream"
-	case "*events.QRScanned":
-		return "QR Code escaneado"
-	case "*events.PairCode":
-		return "Código de pareamento gerado"
-	default:
-		return "Evento do WhatsApp"
-	}
-}
-
-// extractEventData extrai dados estruturados dos eventos do WhatsApp
-func extractEventData(evt interface{}) string {
-	// Primeiro tentar serialização JSON padrão
-	if eventJSON, err := json.Marshal(evt); err == nil {
-		// Se o JSON não estiver vazio, retornar
-		jsonStr := string(eventJSON)
-		if jsonStr != "{}" && jsonStr != "null" {
-			return jsonStr
-		}
-	}
-
-	// Para eventos vazios, adicionar informações contextuais
-	eventType := fmt.Sprintf("%T", evt)
-	switch eventType {
-	case "*events.Connected":
-		return `{"status":"connected","timestamp":"` + time.Now().Format(time.RFC3339) + `"}`
-	case "*events.Disconnected":
-		return `{"status":"disconnected","timestamp":"` + time.Now().Format(time.RFC3339) + `"}`
-	default:
-		// Para outros eventos vazios, usar reflection
-		return extractEventDataWithReflection(evt)
-	}
-}
-
-// extractEventDataWithReflection usa reflection para extrair dados de eventos
-func extractEventDataWithReflection(evt interface{}) string {
-	if evt == nil {
-		return "{}"
-	}
-
-	// Usar reflection para extrair campos
-	v := reflect.ValueOf(evt)
-	if v.Kind() == reflect.Ptr {
-		if v.IsNil() {
-			return "{}"
-		}
-		v = v.Elem()
-	}
-
-	if v.Kind() != reflect.Struct {
-		// Se não for struct, tentar converter para string
-		return fmt.Sprintf(`{"value": %v}`, evt)
-	}
-
-	// Extrair campos da struct
-	result := make(map[string]interface{})
-	t := v.Type()
-
-	for i := 0; i < v.NumField(); i++ {
-		field := v.Field(i)
-		fieldType := t.Field(i)
-
-		// Pular campos não exportados
-		if !field.CanInterface() {
-			continue
-		}
-
-		// Obter nome do campo (usar tag json se disponível)
-		fieldName := fieldType.Name
-		if jsonTag := fieldType.Tag.Get("json"); jsonTag != "" && jsonTag != "-" {
-			if commaIdx := strings.Index(jsonTag, ","); commaIdx > 0 {
-				fieldName = jsonTag[:commaIdx]
-			} else {
-				fieldName = jsonTag
-			}
-		}
-
-		// Obter valor do campo
-		fieldValue := field.Interface()
+// Close encerra o manager e todos os seus recursos
+func (m *Manager) Close() error {
+	m.logger.Info().Msg("Closing WhatsApp Manager")
 
-		// Processar valores especiais
-		switch v := fieldValue.(type) {
-		case time.Time:
-			if !v.IsZero() {
-				result[fieldName] = v.Format(time.RFC3339)
-			}
-		case *time.Time:
-			if v != nil && !v.IsZero() {
-				result[fieldName] = v.Format(time.RFC3339)
-			}
-		default:
-			// Verificar se o valor não é zero
-			if !reflect.ValueOf(fieldValue).IsZero() {
-				result[fieldName] = fieldValue
-			}
+	// Desconectar as sessões ativas; os eventos de desconexão ainda passam pelo dispatcher
+	m.mutex.RLock()
+	for _, state := range m.sessionStates {
+		if state.Client != nil {
+			state.Client.Disconnect()
 		}
 	}
+	m.mutex.RUnlock()
 
-	// Se não encontrou nenhum campo, retornar objeto vazio
-	if len(result) == 0 {
-		return "{}"
-	}
-
-	// Serializar resultado
-	if resultJSON, err := json.Marshal(result); err == nil {
-		return string(resultJSON)
-	}
-
-	return "{}"
-}
-
-// saveWaJIDToDatabase salva o WaJID no banco de dados após autenticação bem-sucedida
-func (epw *EventProcessorWrapper) saveWaJIDToDatabase(sessionID uuid.UUID, waJID string) {
-	ctx := context.Background()
-
-	// Obter repository
-	repo := database.NewSessionRepository(epw.manager.db)
-
-	// Salvar WaJID
-	err := repo.UpdateWhatsAppJID(ctx, sessionID, waJID)
-	if err != nil {
-		epw.manager.logger.WithError(err).WithFields(map[string]interface{}{
-			"sessionId": sessionID,
-			"waJid":     waJID,
-		}).Error().Msg("Failed to save WaJID to database")
-		return
+	// Processar os eventos já enfileirados antes de encerrar o envio de webhooks
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultConnectionTimeout)
+	defer cancel()
+	if err := m.dispatcher.Close(ctx); err != nil {
+		m.logger.WithError(err).Warn().Msg("Failed to drain event dispatcher")
 	}
-
-	// Atualizar status para connected
-	err = repo.UpdateStatus(ctx, sessionID, session.WhatsAppStatusConnected)
-	if err != nil {
-		epw.manager.logger.WithError(err).WithField("sessionId", sessionID).Error().Msg("Failed to update session status to connected")
+	if m.eventPublisher != nil {
+		m.eventPublisher.Close()
 	}
 
-	epw.manager.logger.WithFields(map[string]interface{}{
-		"sessionId": sessionID,
-		"waJid":     waJID,
-	}).Info().Msg("WaJID saved to database and status updated to connected")
-}
-
-// Close encerra o manager e todos os seus recursos
-func (m *Manager) Close() error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	m.logger.Info().Msg("Closing WhatsApp Manager")
-
-	// Fechar todas as sessões ativas
-	for sessionID, state := range m.sessionStates {
-		if state.Client != nil {
-			state.Client.Disconnect()
-		}
+	for sessionID := range m.sessionStates {
 		delete(m.sessionStates, sessionID)
 	}
 
@@ -1372,6 +1062,11 @@ func (m *Manager) Close() error {
 		m.container.Close()
 	}
 
+	// Fechar WebhookService
+	if m.webhookService != nil {
+		m.webhookService.Close()
+	}
+
 	m.logger.Info().Msg("WhatsApp Manager closed successfully")
 	return nil
 }
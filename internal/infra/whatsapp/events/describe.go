@@ -0,0 +1,247 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// getEventMessage retorna uma mensagem descritiva para cada tipo de evento
+func getEventMessage(eventType string, evt interface{}) string {
+	switch eventType {
+	case "*events.Connected":
+		return "Conexão estabelecida com sucesso"
+	case "*events.Disconnected":
+		return "Conexão encerrada"
+	case "*events.Message":
+		if msg, ok := evt.(*events.Message); ok {
+			if msg.Info.IsFromMe {
+				return "Mensagem enviada"
+			}
+			return "Mensagem recebida"
+		}
+		return "Evento de mensagem"
+	case "*events.Receipt":
+		if receipt, ok := evt.(*events.Receipt); ok {
+			switch receipt.Type {
+			case types.ReceiptTypeDelivered:
+				return "Mensagem entregue"
+			case types.ReceiptTypeRead:
+				return "Mensagem lida"
+			case types.ReceiptTypePlayed:
+				return "Áudio/vídeo reproduzido"
+			default:
+				return "Recibo de mensagem"
+			}
+		}
+		return "Recibo de mensagem"
+	case "*events.Presence":
+		if presence, ok := evt.(*events.Presence); ok {
+			switch presence.Unavailable {
+			case false:
+				return "Usuário online"
+			default:
+				return "Usuário offline"
+			}
+		}
+		return "Status de presença"
+	case "*events.ChatPresence":
+		if chatPresence, ok := evt.(*events.ChatPresence); ok {
+			switch chatPresence.State {
+			case "composing":
+				return "Usuário digitando"
+			case "recording":
+				return "Usuário gravando áudio"
+			case "paused":
+				return "Usuário parou de digitar"
+			default:
+				return "Status de digitação"
+			}
+		}
+		return "Status de digitação"
+	case "*events.PairSuccess":
+		return "Dispositivo pareado com sucesso"
+	case "*events.PairError":
+		return "Erro no pareamento do dispositivo"
+	case "*events.LoggedOut":
+		return "Sessão desconectada remotamente"
+	case "*events.OfflineSyncPreview":
+		if sync, ok := evt.(*events.OfflineSyncPreview); ok {
+			return fmt.Sprintf("Sincronização offline iniciada (%d mensagens pendentes)", sync.Total)
+		}
+		return "Sincronização offline iniciada"
+	case "*events.OfflineSyncCompleted":
+		if sync, ok := evt.(*events.OfflineSyncCompleted); ok {
+			return fmt.Sprintf("Sincronização offline concluída (%d mensagens processadas)", sync.Count)
+		}
+		return "Sincronização offline concluída"
+	case "*events.HistorySync":
+		return "Histórico sincronizado"
+	case "*events.AppState":
+		return "Estado da aplicação atualizado"
+	case "*events.KeepAliveTimeout":
+		return "Timeout de keep-alive"
+	case "*events.KeepAliveRestored":
+		return "Keep-alive restaurado"
+	case "*events.Blocklist":
+		return "Lista de bloqueios atualizada"
+	case "*events.Contact":
+		return "Contato atualizado"
+	case "*events.PushName":
+		return "Nome do contato atualizado"
+	case "*events.GroupInfo":
+		return "Informações do grupo atualizadas"
+	case "*events.JoinedGroup":
+		return "Adicionado ao grupo"
+	case "*events.Newsletter":
+		return "Newsletter atualizada"
+	case "*events.CallOffer":
+		return "Chamada recebida"
+	case "*events.CallAccept":
+		return "Chamada aceita"
+	case "*events.CallPreAccept":
+		return "Chamada pré-aceita"
+	case "*events.CallTransport":
+		return "Transporte de chamada"
+	case "*events.CallRelayLatency":
+		return "Latência de chamada"
+	case "*events.CallTerminate":
+		return "Chamada encerrada"
+	case "*events.UnknownCallEvent":
+		return "Evento de chamada desconhecido"
+	case "*events.UndecryptableMessage":
+		return "Mensagem não descriptografável"
+	case "*events.MediaRetry":
+		return "Tentativa de reenvio de mídia"
+	case "*events.AppStateSyncComplete":
+		return "Sincronização de estado completa"
+	case "*events.PictureUpdate":
+		return "Foto de perfil atualizada"
+	case "*events.IdentityChange":
+		return "Identidade alterada"
+	case "*events.PrivacySettings":
+		return "Configurações de privacidade atualizadas"
+	case "*events.TempBan":
+		return "Banimento temporário"
+	case "*events.ConnectFailure":
+		return "Falha na conexão"
+	case "*events.ClientOutdated":
+		return "Cliente desatualizado"
+	case "*events.StreamReplaced":
+		return "Stream substituído"
+	case "*events.StreamError":
+		return "Erro no stream"
+	case "*events.QRScanned":
+		return "QR Code escaneado"
+	case "*events.PairCode":
+		return "Código de pareamento gerado"
+	default:
+		return "Evento do WhatsApp"
+	}
+}
+
+// extractEventData extrai dados estruturados dos eventos do WhatsApp
+func extractEventData(evt interface{}) string {
+	// Primeiro tentar serialização JSON padrão
+	if eventJSON, err := json.Marshal(evt); err == nil {
+		// Se o JSON não estiver vazio, retornar
+		jsonStr := string(eventJSON)
+		if jsonStr != "{}" && jsonStr != "null" {
+			return jsonStr
+		}
+	}
+
+	// Para eventos vazios, adicionar informações contextuais
+	eventType := fmt.Sprintf("%T", evt)
+	switch eventType {
+	case "*events.Connected":
+		return `{"status":"connected","timestamp":"` + time.Now().Format(time.RFC3339) + `"}`
+	case "*events.Disconnected":
+		return `{"status":"disconnected","timestamp":"` + time.Now().Format(time.RFC3339) + `"}`
+	default:
+		// Para outros eventos vazios, usar reflection
+		return extractEventDataWithReflection(evt)
+	}
+}
+
+// extractEventDataWithReflection usa reflection para extrair dados de eventos
+func extractEventDataWithReflection(evt interface{}) string {
+	if evt == nil {
+		return "{}"
+	}
+
+	// Usar reflection para extrair campos
+	v := reflect.ValueOf(evt)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "{}"
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		// Se não for struct, tentar converter para string
+		return fmt.Sprintf(`{"value": %v}`, evt)
+	}
+
+	// Extrair campos da struct
+	result := make(map[string]interface{})
+	t := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		fieldType := t.Field(i)
+
+		// Pular campos não exportados
+		if !field.CanInterface() {
+			continue
+		}
+
+		// Obter nome do campo (usar tag json se disponível)
+		fieldName := fieldType.Name
+		if jsonTag := fieldType.Tag.Get("json"); jsonTag != "" && jsonTag != "-" {
+			if commaIdx := strings.Index(jsonTag, ","); commaIdx > 0 {
+				fieldName = jsonTag[:commaIdx]
+			} else {
+				fieldName = jsonTag
+			}
+		}
+
+		// Obter valor do campo
+		fieldValue := field.Interface()
+
+		// Processar valores especiais
+		switch v := fieldValue.(type) {
+		case time.Time:
+			if !v.IsZero() {
+				result[fieldName] = v.Format(time.RFC3339)
+			}
+		case *time.Time:
+			if v != nil && !v.IsZero() {
+				result[fieldName] = v.Format(time.RFC3339)
+			}
+		default:
+			// Verificar se o valor não é zero
+			if !reflect.ValueOf(fieldValue).IsZero() {
+				result[fieldName] = fieldValue
+			}
+		}
+	}
+
+	// Se não encontrou nenhum campo, retornar objeto vazio
+	if len(result) == 0 {
+		return "{}"
+	}
+
+	// Serializar resultado
+	if resultJSON, err := json.Marshal(result); err == nil {
+		return string(resultJSON)
+	}
+
+	return "{}"
+}
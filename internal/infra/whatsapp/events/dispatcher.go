@@ -0,0 +1,249 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+const (
+	// DefaultQueueSize é o número de eventos do whatsmeow aguardando processamento por sessão
+	DefaultQueueSize = 256
+	// DefaultSinkTimeout é o tempo máximo de cada sink para processar um evento
+	DefaultSinkTimeout = 30 * time.Second
+)
+
+// Dispatcher é o ponto único de entrada dos eventos do whatsmeow.
+// Cada sessão tem uma fila processada por um único worker, preservando a ordem dos eventos:
+// o Translator converte cada evento em eventos de domínio, entregues em sequência aos sinks registrados.
+// Quando a fila de uma sessão está cheia, o handler do whatsmeow aguarda, aplicando backpressure.
+// Processamentos lentos disparados pelos eventos rodam no FollowUpRunner, fora da fila da sessão.
+type Dispatcher struct {
+	translator *Translator
+	queueSize  int
+	followUps  *FollowUpRunner
+
+	mutex  sync.Mutex
+	sinks  []whatsapp.EventSink
+	queues map[uuid.UUID]*sessionQueue
+	closed bool
+	wg     sync.WaitGroup
+
+	logger logger.Logger
+}
+
+// sessionQueue é a fila de eventos de uma sessão; done sinaliza ao worker que deve encerrar
+type sessionQueue struct {
+	events chan interface{}
+	done   chan struct{}
+}
+
+// NewDispatcher cria uma nova instância do Dispatcher.
+// followUpWorkers limita os processamentos em segundo plano do Translator executados ao mesmo tempo.
+func NewDispatcher(translator *Translator, queueSize, followUpWorkers int, log logger.Logger) *Dispatcher {
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+
+	d := &Dispatcher{
+		translator: translator,
+		queueSize:  queueSize,
+		queues:     make(map[uuid.UUID]*sessionQueue),
+		logger:     log.WithComponent("event-dispatcher"),
+	}
+	d.followUps = newFollowUpRunner(followUpWorkers, queueSize, d.deliverAll, log)
+	translator.followUps = d.followUps
+
+	return d
+}
+
+// Register adiciona um sink; os sinks recebem cada evento na ordem em que foram registrados
+func (d *Dispatcher) Register(sink whatsapp.EventSink) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.sinks = append(d.sinks, sink)
+	d.logger.WithField("sink", sink.Name()).Debug().Msg("Event sink registered")
+}
+
+// ProcessEvent enfileira um evento do whatsmeow para a sessão; usado como handler dos clientes
+func (d *Dispatcher) ProcessEvent(sessionID uuid.UUID, evt interface{}) {
+	d.mutex.Lock()
+	if d.closed {
+		d.mutex.Unlock()
+		return
+	}
+
+	queue, exists := d.queues[sessionID]
+	if !exists {
+		queue = &sessionQueue{
+			events: make(chan interface{}, d.queueSize),
+			done:   make(chan struct{}),
+		}
+		d.queues[sessionID] = queue
+		d.wg.Add(1)
+		go d.run(sessionID, queue)
+	}
+	d.mutex.Unlock()
+
+	select {
+	case queue.events <- evt:
+	case <-queue.done:
+	}
+}
+
+// run processa em ordem os eventos de uma sessão; ao encerrar, processa os que já estavam na fila
+// e libera o worker de trabalhos em segundo plano da sessão
+func (d *Dispatcher) run(sessionID uuid.UUID, queue *sessionQueue) {
+	defer d.wg.Done()
+	defer d.followUps.RemoveSession(sessionID)
+
+	for {
+		select {
+		case evt := <-queue.events:
+			d.dispatch(sessionID, evt)
+		case <-queue.done:
+			for {
+				select {
+				case evt := <-queue.events:
+					d.dispatch(sessionID, evt)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// dispatch traduz o evento e o entrega a todos os sinks
+func (d *Dispatcher) dispatch(sessionID uuid.UUID, evt interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.logger.WithFields(map[string]interface{}{
+				"sessionId": sessionID,
+				"eventType": fmt.Sprintf("%T", evt),
+				"panic":     r,
+			}).Error().Msg("Panic while dispatching WhatsApp event")
+		}
+	}()
+
+	eventType := fmt.Sprintf("%T", evt)
+	d.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"eventType":  eventType,
+		"rawPayload": evt,
+	}).Trace().Msg("Raw WhatsApp Event Payload")
+
+	domainEvents := d.translator.Translate(context.Background(), sessionID, evt)
+	if len(domainEvents) == 0 {
+		d.logger.WithFields(map[string]interface{}{
+			"sessionId": sessionID,
+			"eventType": eventType,
+		}).Debug().Msg("WhatsApp event without domain equivalent")
+	}
+
+	for _, event := range domainEvents {
+		d.deliverAll(event)
+	}
+
+	// Log consolidado do evento com mensagens descritivas
+	d.logger.WithFields(map[string]interface{}{
+		"eventType": eventType,
+		"sessionId": sessionID,
+	}).Info().Msgf("%s raw=%s", getEventMessage(eventType, evt), extractEventData(evt))
+}
+
+// deliverAll entrega o evento a todos os sinks, na ordem de registro
+func (d *Dispatcher) deliverAll(event whatsapp.Event) {
+	d.mutex.Lock()
+	sinks := d.sinks
+	d.mutex.Unlock()
+
+	for _, sink := range sinks {
+		d.deliver(sink, event)
+	}
+}
+
+// deliver entrega o evento a um sink; falhas são registradas sem interromper os demais
+func (d *Dispatcher) deliver(sink whatsapp.EventSink, event whatsapp.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultSinkTimeout)
+	defer cancel()
+
+	if err := sink.Handle(ctx, event); err != nil {
+		d.logger.WithError(err).WithFields(map[string]interface{}{
+			"sink":      sink.Name(),
+			"sessionId": event.SessionID,
+			"event":     event.Type,
+		}).Error().Msg("Event sink failed")
+	}
+}
+
+// Publish entrega aos sinks um evento de domínio gerado pela aplicação, sem passar pelo Translator.
+// A entrega é feita na goroutine do chamador, fora da fila da sessão.
+func (d *Dispatcher) Publish(event whatsapp.Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	d.mutex.Lock()
+	closed := d.closed
+	d.mutex.Unlock()
+	if closed {
+		return
+	}
+
+	d.deliverAll(event)
+}
+
+// RemoveSession encerra o worker da sessão depois de processar os eventos já enfileirados
+func (d *Dispatcher) RemoveSession(sessionID uuid.UUID) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if queue, exists := d.queues[sessionID]; exists {
+		close(queue.done)
+		delete(d.queues, sessionID)
+	}
+}
+
+// Close deixa de aceitar eventos e aguarda os workers processarem as filas e os trabalhos em segundo plano
+// terminarem até ctx expirar. Os eventos gerados por esses trabalhos ainda são entregues aos sinks.
+func (d *Dispatcher) Close(ctx context.Context) error {
+	d.mutex.Lock()
+	if d.closed {
+		d.mutex.Unlock()
+		return nil
+	}
+	d.closed = true
+	for sessionID, queue := range d.queues {
+		close(queue.done)
+		delete(d.queues, sessionID)
+	}
+	d.mutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("event dispatcher did not drain before shutdown: %w", ctx.Err())
+	}
+
+	// Os workers das sessões já terminaram e não enfileiram novos trabalhos
+	if err := d.followUps.Close(ctx); err != nil {
+		return err
+	}
+
+	d.logger.Info().Msg("Event dispatcher closed")
+	return nil
+}
@@ -0,0 +1,166 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// DefaultFollowUpWorkers é o número de processamentos em segundo plano executados ao mesmo tempo
+const DefaultFollowUpWorkers = 4
+
+// FollowUp é um processamento lento disparado por um evento (download de mídia, gravação do histórico),
+// executado fora da fila de eventos da sessão. Os eventos retornados são entregues aos sinks ao terminar.
+type FollowUp func(ctx context.Context) []whatsapp.Event
+
+// FollowUpRunner executa os FollowUps sem bloquear a fila de eventos das sessões.
+// Os trabalhos de uma sessão rodam em ordem, um por vez, e no máximo workers trabalhos rodam ao mesmo tempo.
+// Quando a fila de uma sessão está cheia, Submit aguarda, aplicando backpressure apenas àquela sessão.
+type FollowUpRunner struct {
+	queueSize int
+	slots     chan struct{}
+	publish   func(whatsapp.Event)
+
+	mutex  sync.Mutex
+	queues map[uuid.UUID]*followUpQueue
+	closed bool
+	wg     sync.WaitGroup
+
+	logger logger.Logger
+}
+
+// followUpQueue é a fila de trabalhos de uma sessão; done sinaliza ao worker que deve encerrar
+type followUpQueue struct {
+	jobs chan FollowUp
+	done chan struct{}
+}
+
+// newFollowUpRunner cria o runner; publish entrega aos sinks os eventos gerados pelos trabalhos
+func newFollowUpRunner(workers, queueSize int, publish func(whatsapp.Event), log logger.Logger) *FollowUpRunner {
+	if workers <= 0 {
+		workers = DefaultFollowUpWorkers
+	}
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+
+	return &FollowUpRunner{
+		queueSize: queueSize,
+		slots:     make(chan struct{}, workers),
+		publish:   publish,
+		queues:    make(map[uuid.UUID]*followUpQueue),
+		logger:    log.WithComponent("event-follow-up"),
+	}
+}
+
+// Submit enfileira um trabalho da sessão; retorna false se o runner já foi encerrado
+func (f *FollowUpRunner) Submit(sessionID uuid.UUID, job FollowUp) bool {
+	f.mutex.Lock()
+	if f.closed {
+		f.mutex.Unlock()
+		return false
+	}
+
+	queue, exists := f.queues[sessionID]
+	if !exists {
+		queue = &followUpQueue{
+			jobs: make(chan FollowUp, f.queueSize),
+			done: make(chan struct{}),
+		}
+		f.queues[sessionID] = queue
+		f.wg.Add(1)
+		go f.run(sessionID, queue)
+	}
+	f.mutex.Unlock()
+
+	select {
+	case queue.jobs <- job:
+		return true
+	case <-queue.done:
+		return false
+	}
+}
+
+// run executa em ordem os trabalhos de uma sessão; ao encerrar, executa os que já estavam na fila
+func (f *FollowUpRunner) run(sessionID uuid.UUID, queue *followUpQueue) {
+	defer f.wg.Done()
+
+	for {
+		select {
+		case job := <-queue.jobs:
+			f.execute(sessionID, job)
+		case <-queue.done:
+			for {
+				select {
+				case job := <-queue.jobs:
+					f.execute(sessionID, job)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// execute aguarda uma vaga, roda o trabalho e publica os eventos gerados
+func (f *FollowUpRunner) execute(sessionID uuid.UUID, job FollowUp) {
+	f.slots <- struct{}{}
+	defer func() { <-f.slots }()
+
+	defer func() {
+		if r := recover(); r != nil {
+			f.logger.WithFields(map[string]interface{}{
+				"sessionId": sessionID,
+				"panic":     r,
+			}).Error().Msg("Panic while running event follow-up")
+		}
+	}()
+
+	for _, event := range job(context.Background()) {
+		f.publish(event)
+	}
+}
+
+// RemoveSession encerra o worker da sessão depois de executar os trabalhos já enfileirados
+func (f *FollowUpRunner) RemoveSession(sessionID uuid.UUID) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if queue, exists := f.queues[sessionID]; exists {
+		close(queue.done)
+		delete(f.queues, sessionID)
+	}
+}
+
+// Close deixa de aceitar trabalhos e aguarda os enfileirados terminarem até ctx expirar
+func (f *FollowUpRunner) Close(ctx context.Context) error {
+	f.mutex.Lock()
+	if f.closed {
+		f.mutex.Unlock()
+		return nil
+	}
+	f.closed = true
+	for sessionID, queue := range f.queues {
+		close(queue.done)
+		delete(f.queues, sessionID)
+	}
+	f.mutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		f.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event follow-ups did not finish before shutdown: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,94 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+func TestFollowUpRunnerKeepsSessionOrder(t *testing.T) {
+	nop := zerolog.Nop()
+
+	var mutex sync.Mutex
+	published := map[uuid.UUID][]string{}
+	runner := newFollowUpRunner(2, 4, func(event whatsapp.Event) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		published[event.SessionID] = append(published[event.SessionID], event.Data.(string))
+	}, logger.NewZerologLogger(&nop))
+
+	sessions := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	want := []string{"a", "b", "c", "d", "e", "f"}
+	for _, item := range want {
+		for _, sessionID := range sessions {
+			sessionID, item := sessionID, item
+			runner.Submit(sessionID, func(context.Context) []whatsapp.Event {
+				time.Sleep(time.Millisecond)
+				return []whatsapp.Event{{SessionID: sessionID, Data: item}}
+			})
+		}
+	}
+
+	// Um trabalho que entra em pânico não derruba o worker da sessão
+	runner.Submit(sessions[0], func(context.Context) []whatsapp.Event { panic("boom") })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := runner.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	for _, sessionID := range sessions {
+		got := published[sessionID]
+		if len(got) != len(want) {
+			t.Fatalf("session %s published %v, want %v", sessionID, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("session %s published %v, want %v", sessionID, got, want)
+			}
+		}
+	}
+
+	if runner.Submit(sessions[0], func(context.Context) []whatsapp.Event { return nil }) {
+		t.Fatal("Submit() after Close() = true, want false")
+	}
+}
+
+func TestMediaHandoff(t *testing.T) {
+	tests := []struct {
+		name         string
+		finishAfter  time.Duration
+		wait         time.Duration
+		wantInline   bool
+		wantFollowUp bool
+	}{
+		{name: "download finishes while waiting", finishAfter: 0, wait: 5 * time.Second, wantInline: true},
+		{name: "download finishes after the wait", finishAfter: 100 * time.Millisecond, wait: 10 * time.Millisecond, wantFollowUp: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handoff := newMediaHandoff()
+			followUp := make(chan bool, 1)
+			go func() {
+				time.Sleep(tt.finishAfter)
+				followUp <- !handoff.finish()
+			}()
+
+			if got := handoff.wait(tt.wait); got != tt.wantInline {
+				t.Fatalf("wait() = %v, want %v", got, tt.wantInline)
+			}
+			if got := <-followUp; got != tt.wantFollowUp {
+				t.Fatalf("follow-up event = %v, want %v", got, tt.wantFollowUp)
+			}
+		})
+	}
+}
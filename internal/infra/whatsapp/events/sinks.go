@@ -0,0 +1,484 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow/types"
+
+	"zmeow/internal/domain/campaign"
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/broker"
+	"zmeow/internal/infra/whatsapp/services"
+	sessionpkg "zmeow/internal/infra/whatsapp/session"
+)
+
+// SessionManagerInterface define os métodos necessários do session manager
+type SessionManagerInterface interface {
+	GetSession(sessionID uuid.UUID) (*sessionpkg.SessionState, error)
+	UpdateSessionStatus(sessionID uuid.UUID, status string) error
+	UpdateSessionQRCode(sessionID uuid.UUID, qrCode string) error
+	UpdateSessionJID(sessionID uuid.UUID, jid *types.JID) error
+}
+
+// WebhookService interface para envio de webhooks
+type WebhookService interface {
+	SendWebhook(sessionID uuid.UUID, event string, data map[string]interface{}) error
+}
+
+// SessionStateSink mantém o estado em memória das sessões (status, JID e último visto)
+type SessionStateSink struct {
+	sessionManager SessionManagerInterface
+}
+
+// NewSessionStateSink cria uma nova instância do SessionStateSink
+func NewSessionStateSink(sessionManager SessionManagerInterface) *SessionStateSink {
+	return &SessionStateSink{sessionManager: sessionManager}
+}
+
+// Name retorna o nome do sink
+func (s *SessionStateSink) Name() string {
+	return "session-state"
+}
+
+// Handle aplica o evento ao estado em memória da sessão
+func (s *SessionStateSink) Handle(_ context.Context, event whatsapp.Event) error {
+	switch event.Type {
+	case whatsapp.EventConnected:
+		if jid := eventJID(event); jid != nil {
+			if err := s.sessionManager.UpdateSessionJID(event.SessionID, jid); err != nil {
+				return err
+			}
+		}
+		if err := s.sessionManager.UpdateSessionQRCode(event.SessionID, ""); err != nil {
+			return err
+		}
+		return s.sessionManager.UpdateSessionStatus(event.SessionID, string(session.WhatsAppStatusConnected))
+	case whatsapp.EventDisconnected:
+		return s.sessionManager.UpdateSessionStatus(event.SessionID, string(session.WhatsAppStatusDisconnected))
+	case whatsapp.EventLoggedOut:
+		if err := s.sessionManager.UpdateSessionJID(event.SessionID, nil); err != nil {
+			return err
+		}
+		return s.sessionManager.UpdateSessionStatus(event.SessionID, string(session.WhatsAppStatusDisconnected))
+	case whatsapp.EventPairSuccess:
+		if jid := eventJID(event); jid != nil {
+			return s.sessionManager.UpdateSessionJID(event.SessionID, jid)
+		}
+	case whatsapp.EventMessage:
+		// Atualizar o status também renova o último visto da sessão
+		state, err := s.sessionManager.GetSession(event.SessionID)
+		if err != nil {
+			return err
+		}
+		return s.sessionManager.UpdateSessionStatus(event.SessionID, state.Status)
+	}
+	return nil
+}
+
+// DatabaseSink persiste as mudanças de conexão da sessão e o histórico de mensagens recebidas
+type DatabaseSink struct {
+	sessionRepo session.SessionRepository
+	messageRepo message.MessageRepository
+}
+
+// NewDatabaseSink cria uma nova instância do DatabaseSink
+func NewDatabaseSink(sessionRepo session.SessionRepository, messageRepo message.MessageRepository) *DatabaseSink {
+	return &DatabaseSink{
+		sessionRepo: sessionRepo,
+		messageRepo: messageRepo,
+	}
+}
+
+// Name retorna o nome do sink
+func (s *DatabaseSink) Name() string {
+	return "database"
+}
+
+// Handle grava o evento no banco de dados
+func (s *DatabaseSink) Handle(ctx context.Context, event whatsapp.Event) error {
+	switch event.Type {
+	case whatsapp.EventConnected:
+		if err := s.sessionRepo.UpdateStatus(ctx, event.SessionID, session.WhatsAppStatusConnected); err != nil {
+			return fmt.Errorf("failed to update status to connected: %w", err)
+		}
+		if jid := eventJID(event); jid != nil {
+			if err := s.sessionRepo.UpdateJID(ctx, event.SessionID, jid.String()); err != nil {
+				return fmt.Errorf("failed to update JID: %w", err)
+			}
+		}
+	case whatsapp.EventPairSuccess:
+		if jid := eventJID(event); jid != nil {
+			if err := s.sessionRepo.UpdateWhatsAppJID(ctx, event.SessionID, jid.String()); err != nil {
+				return fmt.Errorf("failed to save WhatsApp JID: %w", err)
+			}
+		}
+	case whatsapp.EventDisconnected:
+		if err := s.sessionRepo.UpdateStatus(ctx, event.SessionID, session.WhatsAppStatusDisconnected); err != nil {
+			return fmt.Errorf("failed to update status to disconnected: %w", err)
+		}
+	case whatsapp.EventLoggedOut:
+		if err := s.sessionRepo.UpdateStatus(ctx, event.SessionID, session.WhatsAppStatusDisconnected); err != nil {
+			return fmt.Errorf("failed to update status to disconnected: %w", err)
+		}
+		if err := s.sessionRepo.UpdateJID(ctx, event.SessionID, ""); err != nil {
+			return fmt.Errorf("failed to clear JID: %w", err)
+		}
+	case whatsapp.EventMessage:
+		var errs []error
+		if msg, ok := eventData(event)["message"].(*message.InboundMessage); ok && s.messageRepo != nil {
+			if err := s.messageRepo.Save(ctx, message.NewMessage(event.SessionID, msg)); err != nil {
+				errs = append(errs, fmt.Errorf("failed to record message in history: %w", err))
+			}
+		}
+		if err := s.sessionRepo.UpdateLastSeen(ctx, event.SessionID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update last seen: %w", err))
+		}
+		return errors.Join(errs...)
+	case whatsapp.EventMessageMedia:
+		data := eventData(event)
+		msg, ok := data["message"].(*message.InboundMessage)
+		if !ok || s.messageRepo == nil || stringValue(data, "error") != "" {
+			return nil
+		}
+		if err := s.messageRepo.UpdateContent(ctx, event.SessionID, msg.ID, msg); err != nil && !errors.Is(err, message.ErrMessageNotFound) {
+			return fmt.Errorf("failed to record archived media in history: %w", err)
+		}
+	}
+	return nil
+}
+
+// ChatSink mantém a tabela de chats atualizada a partir das mensagens e das ações de chat
+// sincronizadas entre os dispositivos (arquivar, fixar, silenciar, marcar como lido, limpar e apagar)
+type ChatSink struct {
+	chatRepo    chat.ChatRepository
+	messageRepo message.MessageRepository
+}
+
+// NewChatSink cria uma nova instância do ChatSink
+func NewChatSink(chatRepo chat.ChatRepository, messageRepo message.MessageRepository) *ChatSink {
+	return &ChatSink{
+		chatRepo:    chatRepo,
+		messageRepo: messageRepo,
+	}
+}
+
+// Name retorna o nome do sink
+func (s *ChatSink) Name() string {
+	return "chats"
+}
+
+// Handle aplica o evento ao chat correspondente
+func (s *ChatSink) Handle(ctx context.Context, event whatsapp.Event) error {
+	data := eventData(event)
+
+	if event.Type == whatsapp.EventMessage {
+		msg, ok := data["message"].(*message.InboundMessage)
+		if !ok || msg.Chat == types.StatusBroadcastJID.String() || !msg.Type.IsChatMessage() {
+			return nil
+		}
+		if err := s.chatRepo.RecordMessage(ctx, event.SessionID, msg.Chat, msg.IsGroup, msg.FromMe, msg.Timestamp); err != nil {
+			return fmt.Errorf("failed to record message in chat: %w", err)
+		}
+		return nil
+	}
+
+	jid := stringValue(data, "chat")
+	if jid == "" {
+		return nil
+	}
+
+	var err error
+	switch event.Type {
+	case whatsapp.EventArchive:
+		archived, _ := data["archived"].(bool)
+		err = s.chatRepo.SetArchived(ctx, event.SessionID, jid, archived)
+	case whatsapp.EventPin:
+		pinned, _ := data["pinned"].(bool)
+		err = s.chatRepo.SetPinned(ctx, event.SessionID, jid, pinned)
+	case whatsapp.EventMute:
+		var muteEndTime *time.Time
+		if muted, _ := data["muted"].(bool); muted {
+			end, ok := data["muteEndTimestamp"].(time.Time)
+			if !ok {
+				end = chat.MutedForever
+			}
+			muteEndTime = &end
+		}
+		err = s.chatRepo.SetMuteEndTime(ctx, event.SessionID, jid, muteEndTime)
+	case whatsapp.EventMarkChatAsRead:
+		read, _ := data["read"].(bool)
+		err = s.chatRepo.SetRead(ctx, event.SessionID, jid, read)
+	case whatsapp.EventClearChat:
+		if err = s.messageRepo.DeleteByChat(ctx, event.SessionID, jid); err == nil {
+			err = s.chatRepo.Clear(ctx, event.SessionID, jid)
+		}
+	case whatsapp.EventDeleteChat:
+		if err = s.messageRepo.DeleteByChat(ctx, event.SessionID, jid); err == nil {
+			err = s.chatRepo.Delete(ctx, event.SessionID, jid)
+		}
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply %s to chat: %w", event.Type, err)
+	}
+	return nil
+}
+
+// ContactSink mantém os contatos sincronizados a partir dos nomes recebidos: nome da agenda (contact),
+// nome público (push_name) e nome comercial verificado (business_name)
+type ContactSink struct {
+	contactRepo contact.ContactRepository
+}
+
+// NewContactSink cria uma nova instância do ContactSink
+func NewContactSink(contactRepo contact.ContactRepository) *ContactSink {
+	return &ContactSink{contactRepo: contactRepo}
+}
+
+// Name retorna o nome do sink
+func (s *ContactSink) Name() string {
+	return "contacts"
+}
+
+// Handle grava o nome recebido no contato correspondente
+func (s *ContactSink) Handle(ctx context.Context, event whatsapp.Event) error {
+	data := eventData(event)
+
+	c := &contact.Contact{SessionID: event.SessionID}
+	switch event.Type {
+	case whatsapp.EventContact:
+		c.FullName = stringValue(data, "fullName")
+	case whatsapp.EventPushName:
+		c.PushName = stringValue(data, "newPushName")
+	case whatsapp.EventBusinessName:
+		c.BusinessName = stringValue(data, "newBusinessName")
+	default:
+		return nil
+	}
+
+	jid, err := types.ParseJID(stringValue(data, "jid"))
+	if err != nil || jid.IsEmpty() || c.DisplayName() == "" {
+		return nil
+	}
+	c.JID = jid.ToNonAD().String()
+
+	if err := s.contactRepo.Upsert(ctx, c); err != nil {
+		return fmt.Errorf("failed to save contact from %s: %w", event.Type, err)
+	}
+	return nil
+}
+
+// CampaignSink aplica os recibos de entrega e leitura (message.status) aos destinatários das campanhas
+type CampaignSink struct {
+	campaignRepo campaign.Repository
+}
+
+// NewCampaignSink cria uma nova instância do CampaignSink
+func NewCampaignSink(campaignRepo campaign.Repository) *CampaignSink {
+	return &CampaignSink{campaignRepo: campaignRepo}
+}
+
+// Name retorna o nome do sink
+func (s *CampaignSink) Name() string {
+	return "campaigns"
+}
+
+// Handle avança o status do destinatário da campanha que recebeu a mensagem
+func (s *CampaignSink) Handle(ctx context.Context, event whatsapp.Event) error {
+	if event.Type != whatsapp.EventMessageStatus {
+		return nil
+	}
+	data := eventData(event)
+
+	var status campaign.RecipientStatus
+	value, _ := data["status"].(message.Status)
+	switch value {
+	case message.StatusDelivered:
+		status = campaign.RecipientDelivered
+	case message.StatusRead, message.StatusPlayed:
+		status = campaign.RecipientRead
+	default:
+		return nil
+	}
+
+	messageID := stringValue(data, "messageId")
+	if messageID == "" {
+		return nil
+	}
+
+	if _, err := s.campaignRepo.ApplyDeliveryStatus(ctx, event.SessionID, messageID, status); err != nil {
+		return fmt.Errorf("failed to update campaign recipient status: %w", err)
+	}
+	return nil
+}
+
+// CallPolicySink aplica a política de chamadas da sessão às chamadas recebidas (call_offer).
+// É registrado antes dos sinks de entrega: a ação aplicada é acrescentada aos dados do evento
+// (campo callAction), chegando assim aos webhooks e demais consumidores.
+type CallPolicySink struct {
+	clients   ClientResolver
+	responder *services.CallResponder
+}
+
+// NewCallPolicySink cria uma nova instância do CallPolicySink
+func NewCallPolicySink(clients ClientResolver, responder *services.CallResponder) *CallPolicySink {
+	return &CallPolicySink{clients: clients, responder: responder}
+}
+
+// Name retorna o nome do sink
+func (s *CallPolicySink) Name() string {
+	return "call-policy"
+}
+
+// Handle rejeita a chamada e envia a resposta configurada, conforme a política da sessão
+func (s *CallPolicySink) Handle(ctx context.Context, event whatsapp.Event) error {
+	if event.Type != whatsapp.EventCallOffer {
+		return nil
+	}
+
+	data := eventData(event)
+	callID, _ := data["callId"].(string)
+	from, err := types.ParseJID(stringValue(data, "from"))
+	if err != nil || callID == "" {
+		return fmt.Errorf("invalid call offer event: missing caller or call ID")
+	}
+	caller, _ := types.ParseJID(stringValue(data, "callCreator"))
+
+	action, err := s.responder.Respond(ctx, s.clients(event.SessionID), event.SessionID, from, caller, callID)
+	if action != nil {
+		data["callAction"] = action
+	}
+	return err
+}
+
+// EventBusSink publica os eventos no EventBus consumido pelos streams ao vivo (WebSocket/SSE)
+type EventBusSink struct {
+	eventBus whatsapp.EventBus
+}
+
+// NewEventBusSink cria uma nova instância do EventBusSink
+func NewEventBusSink(eventBus whatsapp.EventBus) *EventBusSink {
+	return &EventBusSink{eventBus: eventBus}
+}
+
+// Name retorna o nome do sink
+func (s *EventBusSink) Name() string {
+	return "event-bus"
+}
+
+// Handle publica o evento no EventBus
+func (s *EventBusSink) Handle(_ context.Context, event whatsapp.Event) error {
+	s.eventBus.Publish(event)
+	return nil
+}
+
+// WebhookSink encaminha os eventos aos webhooks da sessão que os assinam
+type WebhookSink struct {
+	webhookService WebhookService
+}
+
+// NewWebhookSink cria uma nova instância do WebhookSink
+func NewWebhookSink(webhookService WebhookService) *WebhookSink {
+	return &WebhookSink{webhookService: webhookService}
+}
+
+// Name retorna o nome do sink
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Handle enfileira o evento para os webhooks da sessão
+func (s *WebhookSink) Handle(_ context.Context, event whatsapp.Event) error {
+	return s.webhookService.SendWebhook(event.SessionID, string(event.Type), eventData(event))
+}
+
+// MetricsSink contabiliza os eventos no serviço de métricas
+type MetricsSink struct {
+	metrics whatsapp.MetricsService
+}
+
+// NewMetricsSink cria uma nova instância do MetricsSink
+func NewMetricsSink(metrics whatsapp.MetricsService) *MetricsSink {
+	return &MetricsSink{metrics: metrics}
+}
+
+// Name retorna o nome do sink
+func (s *MetricsSink) Name() string {
+	return "metrics"
+}
+
+// Handle registra o evento nas métricas da sessão
+func (s *MetricsSink) Handle(_ context.Context, event whatsapp.Event) error {
+	return s.metrics.RecordEvent(event.SessionID, string(event.Type), eventData(event))
+}
+
+// BrokerSink publica os eventos em um broker de mensagens (RabbitMQ, NATS JetStream ou Redis Streams),
+// no mesmo formato do corpo dos webhooks
+type BrokerSink struct {
+	publisher broker.Publisher
+}
+
+// NewBrokerSink cria uma nova instância do BrokerSink
+func NewBrokerSink(publisher broker.Publisher) *BrokerSink {
+	return &BrokerSink{publisher: publisher}
+}
+
+// Name retorna o nome do sink
+func (s *BrokerSink) Name() string {
+	return "broker-" + s.publisher.Name()
+}
+
+// Handle publica o evento e aguarda a confirmação do broker
+func (s *BrokerSink) Handle(ctx context.Context, event whatsapp.Event) error {
+	body, err := json.Marshal(services.WebhookPayload{
+		SessionID: event.SessionID,
+		Event:     string(event.Type),
+		Timestamp: event.Timestamp,
+		Data:      eventData(event),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return s.publisher.Publish(ctx, broker.Message{
+		SessionID: event.SessionID,
+		EventType: string(event.Type),
+		Body:      body,
+	})
+}
+
+// eventJID extrai o JID informado nos eventos de conexão e pareamento
+func eventJID(event whatsapp.Event) *types.JID {
+	raw := stringValue(eventData(event), "jid")
+	if raw == "" {
+		return nil
+	}
+
+	jid, err := types.ParseJID(raw)
+	if err != nil {
+		return nil
+	}
+	return &jid
+}
+
+// eventData retorna os dados do evento; os eventos produzidos pelo Translator sempre usam um mapa
+func eventData(event whatsapp.Event) map[string]interface{} {
+	data, _ := event.Data.(map[string]interface{})
+	return data
+}
+
+// stringValue retorna o campo de texto dos dados do evento, ou vazio quando ausente
+func stringValue(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
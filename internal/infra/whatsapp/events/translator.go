@@ -0,0 +1,323 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+const (
+	// DefaultMediaArchiveTimeout é o tempo máximo para baixar e gravar a mídia de uma mensagem
+	DefaultMediaArchiveTimeout = 5 * time.Minute
+	// DefaultMediaArchiveWait é quanto a fila da sessão aguarda o download para entregar o evento message já com
+	// a URL armazenada; downloads mais lentos terminam em segundo plano e geram um evento message.media
+	DefaultMediaArchiveWait = 3 * time.Second
+	// mediaArchiveCheckTimeout limita a consulta da configuração de download automático da sessão
+	mediaArchiveCheckTimeout = 5 * time.Second
+	// DefaultHistorySyncTimeout é o tempo máximo para gravar um bloco da sincronização de histórico
+	DefaultHistorySyncTimeout = 5 * time.Minute
+)
+
+// ClientResolver retorna o cliente whatsmeow de uma sessão, ou nil se ela não estiver carregada
+type ClientResolver func(sessionID uuid.UUID) *whatsmeow.Client
+
+// Translator converte os eventos do whatsmeow nos eventos de domínio entregues aos sinks.
+// É o único ponto de mapeamento: conexão, pareamento, mensagens e recibos são tratados aqui,
+// os demais eventos por services.NormalizeEvent.
+type Translator struct {
+	clients       ClientResolver
+	statusTracker *services.MessageStatusTracker
+	mediaArchiver *services.MediaArchiver
+	historySync   *services.HistorySyncIngester
+	// followUps é atribuído pelo Dispatcher que usa o Translator
+	followUps *FollowUpRunner
+	logger    logger.Logger
+}
+
+// NewTranslator cria uma nova instância do Translator.
+// mediaArchiver pode ser nil quando o armazenamento de mídias está desabilitado.
+func NewTranslator(clients ClientResolver, messageRepo message.MessageRepository, mediaArchiver *services.MediaArchiver, historySync *services.HistorySyncIngester, log logger.Logger) *Translator {
+	return &Translator{
+		clients:       clients,
+		statusTracker: services.NewMessageStatusTracker(messageRepo, log),
+		mediaArchiver: mediaArchiver,
+		historySync:   historySync,
+		logger:        log.WithComponent("event-translator"),
+	}
+}
+
+// Translate retorna os eventos de domínio correspondentes ao evento do whatsmeow.
+// Recibos podem gerar vários eventos (um por mensagem alterada) e eventos sem equivalente nenhum.
+func (t *Translator) Translate(ctx context.Context, sessionID uuid.UUID, evt interface{}) []whatsapp.Event {
+	switch e := evt.(type) {
+	case *events.Connected:
+		jid := ""
+		if client := t.clients(sessionID); client != nil && client.Store.ID != nil {
+			jid = client.Store.ID.String()
+		}
+		return t.single(sessionID, whatsapp.EventConnected, map[string]interface{}{
+			"jid": jid,
+		})
+	case *events.Disconnected:
+		return t.single(sessionID, whatsapp.EventDisconnected, map[string]interface{}{})
+	case *events.LoggedOut:
+		return t.single(sessionID, whatsapp.EventLoggedOut, map[string]interface{}{
+			"onConnect": e.OnConnect,
+			"reason":    e.Reason.String(),
+		})
+	case *events.PairSuccess:
+		return t.single(sessionID, whatsapp.EventPairSuccess, map[string]interface{}{
+			"jid":          e.ID.String(),
+			"businessName": e.BusinessName,
+			"platform":     e.Platform,
+		})
+	case *events.PairError:
+		return t.single(sessionID, whatsapp.EventPairError, map[string]interface{}{
+			"jid":   e.ID.String(),
+			"error": e.Error.Error(),
+		})
+	case *events.Message:
+		return t.translateMessage(ctx, sessionID, e)
+	case *events.Receipt:
+		return t.translateReceipt(ctx, sessionID, e)
+	case *events.HistorySync:
+		return t.translateHistorySync(ctx, sessionID, e)
+	case *events.QR:
+		// QR codes são entregues pelo canal de QR da conexão
+		return nil
+	}
+
+	eventType, data, ok := services.NormalizeEvent(evt)
+	if !ok {
+		return nil
+	}
+	data["sessionId"] = sessionID
+	return []whatsapp.Event{newEvent(sessionID, eventType, data)}
+}
+
+// translateMessage normaliza a mensagem e, se a sessão habilitou o download automático, arquiva a mídia.
+// Falhas no download não impedem a entrega.
+func (t *Translator) translateMessage(ctx context.Context, sessionID uuid.UUID, evt *events.Message) []whatsapp.Event {
+	msg := services.NormalizeMessage(evt)
+
+	if msg.Media != nil && t.mediaArchiver != nil {
+		checkCtx, cancel := context.WithTimeout(ctx, mediaArchiveCheckTimeout)
+		enabled := t.mediaArchiver.Enabled(checkCtx, sessionID)
+		cancel()
+		if enabled {
+			t.archiveMedia(sessionID, evt, msg)
+		}
+	}
+
+	return t.single(sessionID, whatsapp.EventMessage, map[string]interface{}{
+		"messageId":   evt.Info.ID,
+		"from":        evt.Info.Sender.String(),
+		"chat":        evt.Info.Chat.String(),
+		"fromMe":      evt.Info.IsFromMe,
+		"timestamp":   evt.Info.Timestamp,
+		"messageType": msg.Type,
+		"message":     msg,
+	})
+}
+
+// archiveMedia baixa a mídia no FollowUpRunner e aguarda até DefaultMediaArchiveWait. Se o download terminar nesse
+// prazo, msg recebe a mídia arquivada e o evento message já traz a URL armazenada; senão o evento segue sem ela e o
+// download continua em segundo plano, gerando um evento message.media ao terminar.
+func (t *Translator) archiveMedia(sessionID uuid.UUID, evt *events.Message, msg *message.InboundMessage) {
+	// O download trabalha em uma cópia, já que o evento message pode ser entregue antes de ele terminar
+	archived := *msg
+	media := *msg.Media
+	archived.Media = &media
+
+	client := t.clients(sessionID)
+	handoff := newMediaHandoff()
+	submitted := t.followUps.Submit(sessionID, func(ctx context.Context) []whatsapp.Event {
+		archiveCtx, cancel := context.WithTimeout(ctx, DefaultMediaArchiveTimeout)
+		err := t.mediaArchiver.Archive(archiveCtx, client, sessionID, evt.Message, &archived)
+		cancel()
+
+		if err != nil {
+			t.logger.WithError(err).WithFields(map[string]interface{}{
+				"sessionId": sessionID,
+				"messageId": archived.ID,
+			}).Error().Msg("Failed to archive message media")
+		}
+		if handoff.finish() {
+			return nil
+		}
+		return t.mediaEvent(sessionID, evt, &archived, err)
+	})
+	if !submitted {
+		t.logger.WithField("sessionId", sessionID).Warn().Msg("Event follow-ups closed, skipping media archive")
+		return
+	}
+
+	if handoff.wait(DefaultMediaArchiveWait) && archived.Media.StorageKey != "" {
+		msg.Media = archived.Media
+	}
+}
+
+// mediaEvent monta o evento message.media com o resultado de um download concluído depois do evento message
+func (t *Translator) mediaEvent(sessionID uuid.UUID, evt *events.Message, msg *message.InboundMessage, err error) []whatsapp.Event {
+	data := map[string]interface{}{
+		"messageId":   evt.Info.ID,
+		"from":        evt.Info.Sender.String(),
+		"chat":        evt.Info.Chat.String(),
+		"fromMe":      evt.Info.IsFromMe,
+		"messageType": msg.Type,
+		"message":     msg,
+		"storageKey":  msg.Media.StorageKey,
+		"storedUrl":   msg.Media.StoredURL,
+	}
+	if err != nil {
+		data["error"] = err.Error()
+	}
+	return t.single(sessionID, whatsapp.EventMessageMedia, data)
+}
+
+// mediaHandoff decide, sem corrida, quem entrega o resultado de um download: o evento message, se o download
+// terminar enquanto a fila da sessão ainda aguarda, ou o evento message.media, se terminar depois
+type mediaHandoff struct {
+	mutex     sync.Mutex
+	done      chan struct{}
+	finished  bool
+	abandoned bool
+}
+
+func newMediaHandoff() *mediaHandoff {
+	return &mediaHandoff{done: make(chan struct{})}
+}
+
+// finish marca o download como concluído; retorna true se o resultado será entregue pelo evento message
+func (h *mediaHandoff) finish() bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.abandoned {
+		return false
+	}
+	h.finished = true
+	close(h.done)
+	return true
+}
+
+// wait aguarda o download até timeout; retorna true se ele terminou a tempo de compor o evento message
+func (h *mediaHandoff) wait(timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-h.done:
+		return true
+	case <-timer.C:
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.finished {
+		return true
+	}
+	h.abandoned = true
+	return false
+}
+
+// translateHistorySync entrega o evento history_sync e grava o bloco do histórico no FollowUpRunner, para não
+// atrasar os demais eventos da sessão; ao terminar a gravação, o evento history.sync.progress traz o resultado
+func (t *Translator) translateHistorySync(ctx context.Context, sessionID uuid.UUID, evt *events.HistorySync) []whatsapp.Event {
+	eventType, data, _ := services.NormalizeEvent(evt)
+	data["sessionId"] = sessionID
+	result := []whatsapp.Event{newEvent(sessionID, eventType, data)}
+
+	if t.historySync == nil {
+		return result
+	}
+
+	client := t.clients(sessionID)
+	ingest := func(ctx context.Context) []whatsapp.Event {
+		return t.ingestHistorySync(ctx, client, sessionID, evt)
+	}
+	if t.followUps.Submit(sessionID, ingest) {
+		return result
+	}
+
+	// Com o runner encerrado, o bloco é gravado na própria fila para não se perder
+	return append(result, ingest(ctx)...)
+}
+
+// ingestHistorySync grava um bloco do histórico e monta o evento history.sync.progress
+func (t *Translator) ingestHistorySync(ctx context.Context, client *whatsmeow.Client, sessionID uuid.UUID, evt *events.HistorySync) []whatsapp.Event {
+	syncCtx, cancel := context.WithTimeout(ctx, DefaultHistorySyncTimeout)
+	defer cancel()
+
+	progress, err := t.historySync.Ingest(syncCtx, client, sessionID, evt)
+	progressData := map[string]interface{}{
+		"syncType":      progress.SyncType,
+		"chunkOrder":    progress.ChunkOrder,
+		"progress":      progress.Progress,
+		"enabled":       progress.Enabled,
+		"days":          progress.Days,
+		"conversations": progress.Conversations,
+		"chats":         progress.Chats,
+		"contacts":      progress.Contacts,
+		"messages":      progress.Messages,
+		"skipped":       progress.Skipped,
+		"failed":        progress.Failed,
+	}
+	if err != nil {
+		t.logger.WithError(err).WithField("sessionId", sessionID).Error().Msg("Failed to ingest history sync")
+		progressData["error"] = err.Error()
+	}
+
+	return t.single(sessionID, whatsapp.EventHistorySyncProgress, progressData)
+}
+
+// translateReceipt aplica o recibo às mensagens enviadas e gera um evento message.status por mudança efetiva
+func (t *Translator) translateReceipt(ctx context.Context, sessionID uuid.UUID, evt *events.Receipt) []whatsapp.Event {
+	if _, ok := services.ReceiptStatus(evt.Type); !ok || evt.IsFromMe {
+		return nil
+	}
+
+	changes := t.statusTracker.ApplyReceipt(ctx, sessionID, evt)
+	result := make([]whatsapp.Event, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, newEvent(sessionID, whatsapp.EventMessageStatus, map[string]interface{}{
+			"sessionId":      sessionID,
+			"messageId":      change.MessageID,
+			"chat":           change.Chat,
+			"recipient":      change.Recipient,
+			"status":         change.Status,
+			"previousStatus": change.PreviousStatus,
+			"messageStatus":  change.MessageStatus,
+			"timestamp":      change.Timestamp,
+		}))
+	}
+	return result
+}
+
+// single cria um evento com sessionId e, se ausente, timestamp nos dados
+func (t *Translator) single(sessionID uuid.UUID, eventType whatsapp.EventType, data map[string]interface{}) []whatsapp.Event {
+	data["sessionId"] = sessionID
+	if _, ok := data["timestamp"]; !ok {
+		data["timestamp"] = time.Now()
+	}
+	return []whatsapp.Event{newEvent(sessionID, eventType, data)}
+}
+
+// newEvent monta o evento de domínio; o ID é atribuído pelo EventBus
+func newEvent(sessionID uuid.UUID, eventType whatsapp.EventType, data map[string]interface{}) whatsapp.Event {
+	return whatsapp.Event{
+		Type:      eventType,
+		SessionID: sessionID,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+}
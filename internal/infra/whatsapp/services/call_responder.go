@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// CallAction descreve o que foi feito com uma chamada recebida, incluído no evento call_offer
+type CallAction struct {
+	Policy         session.CallPolicy `json:"policy"`
+	Rejected       bool               `json:"rejected"`
+	ReplyMessageID string             `json:"replyMessageId,omitempty"`
+}
+
+// CallResponder aplica a política de chamadas da sessão às chamadas recebidas
+type CallResponder struct {
+	sessionRepo session.SessionRepository
+	messageRepo message.MessageRepository
+	logger      logger.Logger
+}
+
+// NewCallResponder cria uma nova instância do CallResponder
+func NewCallResponder(sessionRepo session.SessionRepository, messageRepo message.MessageRepository, log logger.Logger) *CallResponder {
+	return &CallResponder{
+		sessionRepo: sessionRepo,
+		messageRepo: messageRepo,
+		logger:      log.WithComponent("call-responder"),
+	}
+}
+
+// Respond rejeita a chamada e, na política reject_reply, envia a mensagem configurada a quem ligou.
+// from é o dispositivo que originou a chamada e caller o usuário que a criou (vazio quando desconhecido).
+func (r *CallResponder) Respond(ctx context.Context, client *whatsmeow.Client, sessionID uuid.UUID, from, caller types.JID, callID string) (*CallAction, error) {
+	sess, err := r.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	action := &CallAction{Policy: sess.CallPolicy}
+	if sess.CallPolicy == "" || sess.CallPolicy == session.CallPolicyIgnore {
+		action.Policy = session.CallPolicyIgnore
+		return action, nil
+	}
+	if client == nil {
+		return action, fmt.Errorf("whatsapp client not available")
+	}
+
+	if err := client.RejectCall(from, callID); err != nil {
+		return action, fmt.Errorf("failed to reject call: %w", err)
+	}
+	action.Rejected = true
+
+	r.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"callId":    callID,
+		"from":      from.String(),
+	}).Info().Msg("Incoming call rejected")
+
+	if sess.CallPolicy != session.CallPolicyRejectAndReply || sess.CallReplyMessage == "" {
+		return action, nil
+	}
+
+	to := caller
+	if to.IsEmpty() {
+		to = from
+	}
+	to = to.ToNonAD()
+
+	msg := &waE2E.Message{Conversation: proto.String(sess.CallReplyMessage)}
+	resp, err := client.SendMessage(ctx, to, msg)
+	if err != nil {
+		return action, fmt.Errorf("failed to send call reply: %w", err)
+	}
+	action.ReplyMessageID = resp.ID
+
+	sender := types.EmptyJID
+	if client.Store.ID != nil {
+		sender = client.Store.ID.ToNonAD()
+	}
+	if err := r.messageRepo.Save(ctx, message.NewMessage(sessionID, NormalizeSentMessage(resp.ID, to, sender, resp.Timestamp, msg))); err != nil {
+		r.logger.WithError(err).WithField("messageId", resp.ID).Error().Msg("Failed to record call reply in history")
+	}
+
+	return action, nil
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/proto/waCommon"
+	"go.mau.fi/whatsmeow/proto/waSyncAction"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// ChatService altera o estado dos chats por patches de app state, sincronizados com os demais dispositivos da conta.
+// As ações que dependem do conteúdo do chat (arquivar, marcar como lido, limpar e apagar) informam a última
+// mensagem conhecida, usada pelos outros dispositivos para delimitar as mensagens afetadas.
+type ChatService struct {
+	manager   whatsapp.WhatsAppManager
+	sessionID uuid.UUID
+	logger    logger.Logger
+}
+
+// NewChatService cria uma nova instância do serviço de chats
+func NewChatService(manager whatsapp.WhatsAppManager, sessionID uuid.UUID, logger logger.Logger) *ChatService {
+	return &ChatService{
+		manager:   manager,
+		sessionID: sessionID,
+		logger:    logger.WithComponent("chat-service"),
+	}
+}
+
+// Archive arquiva ou desarquiva o chat; arquivar também desafixa
+func (cs *ChatService) Archive(ctx context.Context, chat types.JID, archive bool, last *message.Message) error {
+	timestamp, key := lastMessageKey(chat, last)
+	return cs.send(ctx, chat, "archive", appstate.BuildArchive(chat, archive, timestamp, key))
+}
+
+// Pin fixa ou desafixa o chat
+func (cs *ChatService) Pin(ctx context.Context, chat types.JID, pin bool) error {
+	return cs.send(ctx, chat, "pin", appstate.BuildPin(chat, pin))
+}
+
+// Mute silencia o chat pela duração informada (zero silencia por tempo indeterminado) ou reativa as notificações
+func (cs *ChatService) Mute(ctx context.Context, chat types.JID, mute bool, duration time.Duration) error {
+	return cs.send(ctx, chat, "mute", appstate.BuildMute(chat, mute, duration))
+}
+
+// MarkRead marca o chat como lido ou não lido
+func (cs *ChatService) MarkRead(ctx context.Context, chat types.JID, read bool, last *message.Message) error {
+	return cs.send(ctx, chat, "mark_read", buildMarkChatAsRead(chat, read, last))
+}
+
+// Clear apaga as mensagens do chat em todos os dispositivos, mantendo a conversa
+func (cs *ChatService) Clear(ctx context.Context, chat types.JID, last *message.Message) error {
+	return cs.send(ctx, chat, "clear", buildClearChat(chat, last))
+}
+
+// Delete apaga o chat e suas mensagens em todos os dispositivos
+func (cs *ChatService) Delete(ctx context.Context, chat types.JID, last *message.Message) error {
+	return cs.send(ctx, chat, "delete", buildDeleteChat(chat, last))
+}
+
+// send envia o patch de app state da ação
+func (cs *ChatService) send(ctx context.Context, chat types.JID, action string, patch appstate.PatchInfo) error {
+	client, err := cs.getWhatsmeowClient()
+	if err != nil {
+		return fmt.Errorf("failed to get whatsmeow client: %w", err)
+	}
+
+	if err := client.SendAppState(ctx, patch); err != nil {
+		cs.logger.WithError(err).WithFields(map[string]interface{}{
+			"sessionId": cs.sessionID,
+			"chat":      chat.String(),
+			"action":    action,
+		}).Error().Msg("Failed to send chat app state patch")
+		return fmt.Errorf("failed to %s chat: %w", action, err)
+	}
+
+	cs.logger.WithFields(map[string]interface{}{
+		"sessionId": cs.sessionID,
+		"chat":      chat.String(),
+		"action":    action,
+	}).Info().Msg("Chat updated")
+	return nil
+}
+
+// getWhatsmeowClient obtém o cliente whatsmeow para a sessão
+func (cs *ChatService) getWhatsmeowClient() (*whatsmeow.Client, error) {
+	client, err := cs.manager.GetClient(cs.sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get WhatsApp client: %w", err)
+	}
+
+	if unifiedClient, ok := client.(interface {
+		GetWhatsmeowClient(sessionID uuid.UUID) (*whatsmeow.Client, error)
+	}); ok {
+		return unifiedClient.GetWhatsmeowClient(cs.sessionID)
+	}
+
+	return nil, fmt.Errorf("unable to get whatsmeow client for session %s", cs.sessionID)
+}
+
+// Os patches abaixo não têm builder no whatsmeow; índices e versões seguem os enviados pelo WhatsApp Web
+
+// buildMarkChatAsRead monta o patch markChatAsRead (versão 3, regular_low)
+func buildMarkChatAsRead(chat types.JID, read bool, last *message.Message) appstate.PatchInfo {
+	return appstate.PatchInfo{
+		Type: appstate.WAPatchRegularLow,
+		Mutations: []appstate.MutationInfo{{
+			Index:   []string{appstate.IndexMarkChatAsRead, chat.String()},
+			Version: 3,
+			Value: &waSyncAction.SyncActionValue{
+				MarkChatAsReadAction: &waSyncAction.MarkChatAsReadAction{
+					Read:         proto.Bool(read),
+					MessageRange: messageRange(chat, last),
+				},
+			},
+		}},
+	}
+}
+
+// buildClearChat monta o patch clearChat (versão 6, regular_high); os índices finais removem
+// também as mensagens favoritadas e mantêm as mídias no aparelho, como o padrão do aplicativo
+func buildClearChat(chat types.JID, last *message.Message) appstate.PatchInfo {
+	return appstate.PatchInfo{
+		Type: appstate.WAPatchRegularHigh,
+		Mutations: []appstate.MutationInfo{{
+			Index:   []string{appstate.IndexClearChat, chat.String(), "1", "0"},
+			Version: 6,
+			Value: &waSyncAction.SyncActionValue{
+				ClearChatAction: &waSyncAction.ClearChatAction{
+					MessageRange: messageRange(chat, last),
+				},
+			},
+		}},
+	}
+}
+
+// buildDeleteChat monta o patch deleteChat (versão 6, regular_high)
+func buildDeleteChat(chat types.JID, last *message.Message) appstate.PatchInfo {
+	return appstate.PatchInfo{
+		Type: appstate.WAPatchRegularHigh,
+		Mutations: []appstate.MutationInfo{{
+			Index:   []string{appstate.IndexDeleteChat, chat.String(), "1"},
+			Version: 6,
+			Value: &waSyncAction.SyncActionValue{
+				DeleteChatAction: &waSyncAction.DeleteChatAction{
+					MessageRange: messageRange(chat, last),
+				},
+			},
+		}},
+	}
+}
+
+// messageRange delimita as mensagens afetadas pela ação até a última mensagem conhecida do chat
+func messageRange(chat types.JID, last *message.Message) *waSyncAction.SyncActionMessageRange {
+	timestamp, key := lastMessageKey(chat, last)
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	result := &waSyncAction.SyncActionMessageRange{
+		LastMessageTimestamp: proto.Int64(timestamp.Unix()),
+	}
+	if key != nil {
+		result.Messages = []*waSyncAction.SyncActionMessage{{
+			Key:       key,
+			Timestamp: proto.Int64(timestamp.Unix()),
+		}}
+	}
+	return result
+}
+
+// lastMessageKey retorna o horário e a chave da última mensagem do chat, ou zero e nil quando desconhecida
+func lastMessageKey(chat types.JID, last *message.Message) (time.Time, *waCommon.MessageKey) {
+	if last == nil {
+		return time.Time{}, nil
+	}
+
+	fromMe := last.Direction == message.DirectionOutbound
+	key := &waCommon.MessageKey{
+		RemoteJID: proto.String(chat.String()),
+		FromMe:    proto.Bool(fromMe),
+		ID:        proto.String(last.MessageID),
+	}
+	if chat.Server == types.GroupServer && !fromMe && last.SenderJID != "" {
+		key.Participant = proto.String(last.SenderJID)
+	}
+	return last.Timestamp, key
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContactCache guarda por um tempo limitado as consultas de contatos ao WhatsApp (verificação de números,
+// informações do perfil, fotos e perfis comerciais), evitando o limite de requisições dos servidores.
+// É compartilhado por todas as sessões; as chaves incluem o ID da sessão. Com ttl <= 0 nada é guardado.
+type ContactCache struct {
+	ttl       time.Duration
+	mu        sync.Mutex
+	entries   map[string]contactCacheEntry
+	lastSweep time.Time
+}
+
+type contactCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// NewContactCache cria uma nova instância do ContactCache
+func NewContactCache(ttl time.Duration) *ContactCache {
+	return &ContactCache{
+		ttl:       ttl,
+		entries:   make(map[string]contactCacheEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// get retorna o valor guardado para a chave, se ainda válido
+func (c *ContactCache) get(sessionID uuid.UUID, kind, key string) (interface{}, bool) {
+	if c == nil || c.ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[cacheKey(sessionID, kind, key)]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set guarda o valor da chave pelo ttl configurado, removendo as entradas vencidas a cada ttl
+func (c *ContactCache) set(sessionID uuid.UUID, kind, key string, value interface{}) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastSweep) > c.ttl {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+	c.entries[cacheKey(sessionID, kind, key)] = contactCacheEntry{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+func cacheKey(sessionID uuid.UUID, kind, key string) string {
+	return fmt.Sprintf("%s:%s:%s", sessionID, kind, key)
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/types"
+
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// Tipos de consulta guardados no ContactCache
+const (
+	cacheKindCheck    = "check"
+	cacheKindInfo     = "info"
+	cacheKindPicture  = "picture"
+	cacheKindBusiness = "business"
+)
+
+// ContactService consulta no WhatsApp as informações públicas dos contatos, com cache das respostas
+type ContactService struct {
+	manager   whatsapp.WhatsAppManager
+	cache     *ContactCache
+	sessionID uuid.UUID
+	logger    logger.Logger
+}
+
+// NewContactService cria uma nova instância do serviço de contatos
+func NewContactService(manager whatsapp.WhatsAppManager, cache *ContactCache, sessionID uuid.UUID, logger logger.Logger) *ContactService {
+	return &ContactService{
+		manager:   manager,
+		cache:     cache,
+		sessionID: sessionID,
+		logger:    logger.WithComponent("contact-service"),
+	}
+}
+
+// Check verifica se os números (apenas dígitos, com código do país) têm conta no WhatsApp.
+// Apenas os números ausentes do cache são consultados; o resultado segue a ordem de numbers.
+func (cs *ContactService) Check(ctx context.Context, numbers []string) ([]contact.CheckResult, error) {
+	results := make(map[string]contact.CheckResult, len(numbers))
+	var missing []string
+	for _, number := range numbers {
+		if cached, ok := cs.cache.get(cs.sessionID, cacheKindCheck, number); ok {
+			results[number] = cached.(contact.CheckResult)
+			continue
+		}
+		if _, queued := results[number]; !queued {
+			results[number] = contact.CheckResult{Number: number}
+			missing = append(missing, number)
+		}
+	}
+
+	if len(missing) > 0 {
+		client, err := cs.getWhatsmeowClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get whatsmeow client: %w", err)
+		}
+
+		phones := make([]string, len(missing))
+		for i, number := range missing {
+			phones[i] = "+" + number
+		}
+		responses, err := client.IsOnWhatsApp(phones)
+		if err != nil {
+			cs.logger.WithError(err).WithField("sessionId", cs.sessionID).Error().Msg("Failed to check numbers on WhatsApp")
+			return nil, fmt.Errorf("failed to check numbers: %w", err)
+		}
+
+		for _, resp := range responses {
+			number := strings.TrimPrefix(resp.Query, "+")
+			result := contact.CheckResult{Number: number, IsOnWhatsApp: resp.IsIn}
+			if resp.IsIn {
+				result.JID = resp.JID.String()
+			}
+			if resp.VerifiedName != nil {
+				result.IsBusiness = true
+				result.VerifiedName = resp.VerifiedName.Details.GetVerifiedName()
+			}
+			results[number] = result
+		}
+		// Números sem resposta também são guardados, como não registrados
+		for _, number := range missing {
+			cs.cache.set(cs.sessionID, cacheKindCheck, number, results[number])
+		}
+	}
+
+	ordered := make([]contact.CheckResult, len(numbers))
+	for i, number := range numbers {
+		ordered[i] = results[number]
+	}
+	return ordered, nil
+}
+
+// UserInfo consulta o recado, a foto atual, o nome comercial verificado e os dispositivos do contato.
+// Retorna contact.ErrNotOnWhatsApp quando o JID não tem conta.
+func (cs *ContactService) UserInfo(ctx context.Context, jid types.JID) (*contact.ContactInfoResponse, error) {
+	if cached, ok := cs.cache.get(cs.sessionID, cacheKindInfo, jid.String()); ok {
+		info := cached.(contact.ContactInfoResponse)
+		return &info, nil
+	}
+
+	client, err := cs.getWhatsmeowClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get whatsmeow client: %w", err)
+	}
+
+	infos, err := client.GetUserInfo([]types.JID{jid})
+	if err != nil {
+		cs.logger.WithError(err).WithField("jid", jid.String()).Error().Msg("Failed to get user info")
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+	userInfo, ok := infos[jid]
+	if !ok {
+		return nil, contact.ErrNotOnWhatsApp
+	}
+
+	info := contact.ContactInfoResponse{
+		JID:       jid.String(),
+		About:     userInfo.Status,
+		PictureID: userInfo.PictureID,
+		Devices:   make([]string, 0, len(userInfo.Devices)),
+	}
+	if userInfo.VerifiedName != nil {
+		info.IsBusiness = true
+		info.VerifiedName = userInfo.VerifiedName.Details.GetVerifiedName()
+	}
+	for _, device := range userInfo.Devices {
+		info.Devices = append(info.Devices, device.String())
+	}
+
+	cs.cache.set(cs.sessionID, cacheKindInfo, jid.String(), info)
+	return &info, nil
+}
+
+// ProfilePicture obtém a foto de perfil do contato, em miniatura (preview) ou resolução completa.
+// Contatos sem foto ou que a ocultaram também ficam em cache, retornando o erro correspondente.
+func (cs *ContactService) ProfilePicture(ctx context.Context, jid types.JID, preview bool) (*contact.ProfilePictureResponse, error) {
+	key := fmt.Sprintf("%s:%t", jid, preview)
+	if cached, ok := cs.cache.get(cs.sessionID, cacheKindPicture, key); ok {
+		if err, isErr := cached.(error); isErr {
+			return nil, err
+		}
+		picture := cached.(contact.ProfilePictureResponse)
+		return &picture, nil
+	}
+
+	client, err := cs.getWhatsmeowClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get whatsmeow client: %w", err)
+	}
+
+	info, err := client.GetProfilePictureInfo(jid, &whatsmeow.GetProfilePictureParams{Preview: preview})
+	switch {
+	case errors.Is(err, whatsmeow.ErrProfilePictureNotSet):
+		cs.cache.set(cs.sessionID, cacheKindPicture, key, contact.ErrProfilePictureNotSet)
+		return nil, contact.ErrProfilePictureNotSet
+	case errors.Is(err, whatsmeow.ErrProfilePictureUnauthorized):
+		cs.cache.set(cs.sessionID, cacheKindPicture, key, contact.ErrProfilePictureHidden)
+		return nil, contact.ErrProfilePictureHidden
+	case err != nil:
+		cs.logger.WithError(err).WithField("jid", jid.String()).Error().Msg("Failed to get profile picture")
+		return nil, fmt.Errorf("failed to get profile picture: %w", err)
+	case info == nil:
+		// O whatsmeow só retorna nil quando ExistingID é a foto atual, o que não é usado aqui
+		return nil, contact.ErrProfilePictureNotSet
+	}
+
+	picture := contact.ProfilePictureResponse{
+		JID:        jid.String(),
+		URL:        info.URL,
+		ID:         info.ID,
+		Type:       info.Type,
+		DirectPath: info.DirectPath,
+	}
+	cs.cache.set(cs.sessionID, cacheKindPicture, key, picture)
+	return &picture, nil
+}
+
+// BusinessProfile obtém o perfil comercial (endereço, e-mail, categorias e horários) do contato
+func (cs *ContactService) BusinessProfile(ctx context.Context, jid types.JID) (*contact.BusinessProfileResponse, error) {
+	if cached, ok := cs.cache.get(cs.sessionID, cacheKindBusiness, jid.String()); ok {
+		profile := cached.(contact.BusinessProfileResponse)
+		return &profile, nil
+	}
+
+	client, err := cs.getWhatsmeowClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get whatsmeow client: %w", err)
+	}
+
+	bp, err := client.GetBusinessProfile(jid)
+	if err != nil {
+		cs.logger.WithError(err).WithField("jid", jid.String()).Error().Msg("Failed to get business profile")
+		return nil, fmt.Errorf("failed to get business profile: %w", err)
+	}
+
+	profile := contact.BusinessProfileResponse{
+		JID:               jid.String(),
+		Address:           bp.Address,
+		Email:             bp.Email,
+		Categories:        make([]contact.BusinessCategory, 0, len(bp.Categories)),
+		ProfileOptions:    bp.ProfileOptions,
+		BusinessHoursZone: bp.BusinessHoursTimeZone,
+		BusinessHours:     make([]contact.BusinessHours, 0, len(bp.BusinessHours)),
+	}
+	for _, category := range bp.Categories {
+		profile.Categories = append(profile.Categories, contact.BusinessCategory{ID: category.ID, Name: category.Name})
+	}
+	for _, hours := range bp.BusinessHours {
+		profile.BusinessHours = append(profile.BusinessHours, contact.BusinessHours{
+			DayOfWeek: hours.DayOfWeek,
+			Mode:      hours.Mode,
+			OpenTime:  hours.OpenTime,
+			CloseTime: hours.CloseTime,
+		})
+	}
+
+	cs.cache.set(cs.sessionID, cacheKindBusiness, jid.String(), profile)
+	return &profile, nil
+}
+
+// getWhatsmeowClient obtém o cliente whatsmeow para a sessão
+func (cs *ContactService) getWhatsmeowClient() (*whatsmeow.Client, error) {
+	client, err := cs.manager.GetClient(cs.sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get WhatsApp client: %w", err)
+	}
+
+	if unifiedClient, ok := client.(interface {
+		GetWhatsmeowClient(sessionID uuid.UUID) (*whatsmeow.Client, error)
+	}); ok {
+		return unifiedClient.GetWhatsmeowClient(cs.sessionID)
+	}
+
+	return nil, fmt.Errorf("unable to get whatsmeow client for session %s", cs.sessionID)
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// DefaultEventBufferSize é a quantidade de eventos mantidos por sessão para retomar streams
+const DefaultEventBufferSize = 1000
+
+// EventBusImpl implementa whatsapp.EventStream em memória.
+// Cada evento publicado recebe um ID sequencial e fica disponível para Replay
+// até ser descartado pelo limite de eventos por sessão.
+type EventBusImpl struct {
+	mutex      sync.Mutex
+	handlers   []whatsapp.EventHandler
+	history    map[uuid.UUID][]whatsapp.Event
+	bufferSize int
+	lastID     uint64
+	logger     logger.Logger
+}
+
+// NewEventBus cria uma nova instância do EventBus em memória
+func NewEventBus(bufferSize int, log logger.Logger) *EventBusImpl {
+	if bufferSize <= 0 {
+		bufferSize = DefaultEventBufferSize
+	}
+
+	return &EventBusImpl{
+		history:    make(map[uuid.UUID][]whatsapp.Event),
+		bufferSize: bufferSize,
+		logger:     log.WithComponent("event-bus"),
+	}
+}
+
+// Subscribe registra um handler para todos os eventos.
+// O handler é chamado de forma síncrona durante a publicação e não deve bloquear;
+// para permitir Unsubscribe, deve ser comparável (ex.: ponteiro).
+func (b *EventBusImpl) Subscribe(handler whatsapp.EventHandler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.handlers = append(b.handlers, handler)
+}
+
+// Unsubscribe remove um handler registrado
+func (b *EventBusImpl) Unsubscribe(handler whatsapp.EventHandler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for i, h := range b.handlers {
+		if h == handler {
+			b.handlers = append(b.handlers[:i], b.handlers[i+1:]...)
+			return
+		}
+	}
+}
+
+// Publish atribui o ID ao evento, o guarda no histórico da sessão e o entrega aos handlers.
+// Os handlers são chamados com o lock mantido, garantindo a entrega na ordem dos IDs.
+func (b *EventBusImpl) Publish(event whatsapp.Event) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.lastID++
+	event.ID = b.lastID
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	history := b.history[event.SessionID]
+	if len(history) >= b.bufferSize {
+		copy(history, history[1:])
+		history = history[:len(history)-1]
+	}
+	b.history[event.SessionID] = append(history, event)
+
+	for _, handler := range b.handlers {
+		b.dispatch(handler, event)
+	}
+}
+
+// PublishAsync publica o evento em uma goroutine separada
+func (b *EventBusImpl) PublishAsync(event whatsapp.Event) {
+	go b.Publish(event)
+}
+
+// Replay retorna os eventos da sessão com ID maior que afterID ainda mantidos em memória
+func (b *EventBusImpl) Replay(sessionID uuid.UUID, afterID uint64) []whatsapp.Event {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	history := b.history[sessionID]
+	for i, event := range history {
+		if event.ID > afterID {
+			return append([]whatsapp.Event(nil), history[i:]...)
+		}
+	}
+	return nil
+}
+
+// RemoveSession descarta o histórico de eventos de uma sessão removida
+func (b *EventBusImpl) RemoveSession(sessionID uuid.UUID) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	delete(b.history, sessionID)
+}
+
+// dispatch entrega o evento a um handler, isolando panics para não afetar os demais
+func (b *EventBusImpl) dispatch(handler whatsapp.EventHandler, event whatsapp.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.logger.WithFields(map[string]interface{}{
+				"panic":     r,
+				"eventType": event.Type,
+				"sessionId": event.SessionID,
+			}).Error().Msg("Event handler panicked")
+		}
+	}()
+
+	handler.HandleEvent(event)
+}
@@ -0,0 +1,494 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"zmeow/internal/domain/whatsapp"
+)
+
+// NormalizeEvent converte os eventos do whatsmeow sem tratamento específico (presença, sincronização,
+// chats, contatos, chamadas, grupos, canais, etiquetas e segurança) no evento de domínio entregue ao
+// webhook e aos streams. Conexão, pareamento, mensagens e recibos são tratados pelo Translator do dispatcher.
+// Retorna false quando o evento não tem equivalente de domínio.
+func NormalizeEvent(evt interface{}) (whatsapp.EventType, map[string]interface{}, bool) {
+	switch e := evt.(type) {
+	// Conexão
+	case *events.KeepAliveTimeout:
+		return whatsapp.EventKeepAliveTimeout, map[string]interface{}{
+			"errorCount":  e.ErrorCount,
+			"lastSuccess": e.LastSuccess,
+			"timestamp":   time.Now(),
+		}, true
+	case *events.KeepAliveRestored:
+		return whatsapp.EventKeepAliveRestored, map[string]interface{}{
+			"timestamp": time.Now(),
+		}, true
+	case *events.StreamError:
+		return whatsapp.EventStreamError, map[string]interface{}{
+			"code":      e.Code,
+			"timestamp": time.Now(),
+		}, true
+	case *events.StreamReplaced:
+		return whatsapp.EventStreamReplaced, map[string]interface{}{
+			"timestamp": time.Now(),
+		}, true
+	case *events.TemporaryBan:
+		return whatsapp.EventTemporaryBan, map[string]interface{}{
+			"code":          int(e.Code),
+			"reason":        e.Code.String(),
+			"expireSeconds": int64(e.Expire.Seconds()),
+			"timestamp":     time.Now(),
+		}, true
+	case *events.QRScannedWithoutMultidevice:
+		return whatsapp.EventQRScannedWithoutMultidevice, map[string]interface{}{
+			"timestamp": time.Now(),
+		}, true
+
+	// Mensagens
+	case *events.UndecryptableMessage:
+		return whatsapp.EventUndecryptableMessage, map[string]interface{}{
+			"messageId":       e.Info.ID,
+			"chat":            e.Info.Chat.String(),
+			"sender":          e.Info.Sender.String(),
+			"fromMe":          e.Info.IsFromMe,
+			"isUnavailable":   e.IsUnavailable,
+			"unavailableType": string(e.UnavailableType),
+			"decryptFailMode": string(e.DecryptFailMode),
+			"timestamp":       e.Info.Timestamp,
+		}, true
+	case *events.MediaRetry:
+		data := map[string]interface{}{
+			"messageId": e.MessageID,
+			"chat":      e.ChatID.String(),
+			"sender":    jidString(&e.SenderID),
+			"fromMe":    e.FromMe,
+			"timestamp": e.Timestamp,
+		}
+		if e.Error != nil {
+			data["errorCode"] = e.Error.Code
+		}
+		return whatsapp.EventMediaRetry, data, true
+
+	// Presença
+	case *events.Presence:
+		data := map[string]interface{}{
+			"from":        e.From.String(),
+			"unavailable": e.Unavailable,
+			"timestamp":   time.Now(),
+		}
+		if !e.LastSeen.IsZero() {
+			data["lastSeen"] = e.LastSeen
+		}
+		return whatsapp.EventPresence, data, true
+	case *events.ChatPresence:
+		return whatsapp.EventChatPresence, map[string]interface{}{
+			"chat":      e.Chat.String(),
+			"sender":    e.Sender.String(),
+			"isGroup":   e.IsGroup,
+			"state":     string(e.State),
+			"media":     string(e.Media),
+			"timestamp": time.Now(),
+		}, true
+
+	// Sincronização
+	case *events.HistorySync:
+		return whatsapp.EventHistorySync, map[string]interface{}{
+			"syncType":      e.Data.GetSyncType().String(),
+			"conversations": len(e.Data.GetConversations()),
+			"chunkOrder":    e.Data.GetChunkOrder(),
+			"progress":      e.Data.GetProgress(),
+			"timestamp":     time.Now(),
+		}, true
+	case *events.AppState:
+		return whatsapp.EventAppState, map[string]interface{}{
+			"index":     e.Index,
+			"timestamp": time.Now(),
+		}, true
+	case *events.AppStateSyncComplete:
+		return whatsapp.EventAppStateSyncComplete, map[string]interface{}{
+			"name":      string(e.Name),
+			"timestamp": time.Now(),
+		}, true
+	case *events.OfflineSyncPreview:
+		return whatsapp.EventOfflineSyncPreview, map[string]interface{}{
+			"total":          e.Total,
+			"appDataChanges": e.AppDataChanges,
+			"messages":       e.Messages,
+			"notifications":  e.Notifications,
+			"receipts":       e.Receipts,
+			"timestamp":      time.Now(),
+		}, true
+	case *events.OfflineSyncCompleted:
+		return whatsapp.EventOfflineSyncCompleted, map[string]interface{}{
+			"count":     e.Count,
+			"timestamp": time.Now(),
+		}, true
+
+	// Configurações da conta
+	case *events.PushNameSetting:
+		return whatsapp.EventPushNameSetting, map[string]interface{}{
+			"name":         e.Action.GetName(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.PushName:
+		return whatsapp.EventPushName, map[string]interface{}{
+			"jid":         e.JID.String(),
+			"oldPushName": e.OldPushName,
+			"newPushName": e.NewPushName,
+			"timestamp":   time.Now(),
+		}, true
+	case *events.PrivacySettings:
+		return whatsapp.EventPrivacySettings, map[string]interface{}{
+			"groupAdd":     string(e.NewSettings.GroupAdd),
+			"lastSeen":     string(e.NewSettings.LastSeen),
+			"status":       string(e.NewSettings.Status),
+			"profile":      string(e.NewSettings.Profile),
+			"readReceipts": string(e.NewSettings.ReadReceipts),
+			"callAdd":      string(e.NewSettings.CallAdd),
+			"online":       string(e.NewSettings.Online),
+			"changed":      privacyChanges(e),
+			"timestamp":    time.Now(),
+		}, true
+	case *events.UnarchiveChatsSetting:
+		return whatsapp.EventUnarchiveChatsSetting, map[string]interface{}{
+			"unarchiveChats": e.Action.GetUnarchiveChats(),
+			"fromFullSync":   e.FromFullSync,
+			"timestamp":      e.Timestamp,
+		}, true
+
+	// Chats
+	case *events.Archive:
+		return whatsapp.EventArchive, map[string]interface{}{
+			"chat":         e.JID.String(),
+			"archived":     e.Action.GetArchived(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.ClearChat:
+		return whatsapp.EventClearChat, map[string]interface{}{
+			"chat":         e.JID.String(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.DeleteChat:
+		return whatsapp.EventDeleteChat, map[string]interface{}{
+			"chat":         e.JID.String(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.DeleteForMe:
+		return whatsapp.EventDeleteForMe, map[string]interface{}{
+			"chat":         e.ChatJID.String(),
+			"sender":       jidString(&e.SenderJID),
+			"fromMe":       e.IsFromMe,
+			"messageId":    e.MessageID,
+			"deleteMedia":  e.Action.GetDeleteMedia(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.MarkChatAsRead:
+		return whatsapp.EventMarkChatAsRead, map[string]interface{}{
+			"chat":         e.JID.String(),
+			"read":         e.Action.GetRead(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.Mute:
+		data := map[string]interface{}{
+			"chat":         e.JID.String(),
+			"muted":        e.Action.GetMuted(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}
+		if end := e.Action.GetMuteEndTimestamp(); end > 0 {
+			data["muteEndTimestamp"] = time.UnixMilli(end)
+		}
+		return whatsapp.EventMute, data, true
+	case *events.Pin:
+		return whatsapp.EventPin, map[string]interface{}{
+			"chat":         e.JID.String(),
+			"pinned":       e.Action.GetPinned(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.Star:
+		return whatsapp.EventStar, map[string]interface{}{
+			"chat":         e.ChatJID.String(),
+			"sender":       jidString(&e.SenderJID),
+			"fromMe":       e.IsFromMe,
+			"messageId":    e.MessageID,
+			"starred":      e.Action.GetStarred(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+
+	// Contatos
+	case *events.Contact:
+		return whatsapp.EventContact, map[string]interface{}{
+			"jid":          e.JID.String(),
+			"fullName":     e.Action.GetFullName(),
+			"firstName":    e.Action.GetFirstName(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.Blocklist:
+		changes := make([]map[string]interface{}, 0, len(e.Changes))
+		for _, change := range e.Changes {
+			changes = append(changes, map[string]interface{}{
+				"jid":    change.JID.String(),
+				"action": string(change.Action),
+			})
+		}
+		return whatsapp.EventBlocklist, map[string]interface{}{
+			"action":    string(e.Action),
+			"changes":   changes,
+			"timestamp": time.Now(),
+		}, true
+	case *events.Picture:
+		return whatsapp.EventPicture, map[string]interface{}{
+			"jid":       e.JID.String(),
+			"author":    jidString(&e.Author),
+			"removed":   e.Remove,
+			"pictureId": e.PictureID,
+			"timestamp": e.Timestamp,
+		}, true
+	case *events.UserAbout:
+		return whatsapp.EventUserAbout, map[string]interface{}{
+			"jid":       e.JID.String(),
+			"status":    e.Status,
+			"timestamp": e.Timestamp,
+		}, true
+	case *events.UserStatusMute:
+		return whatsapp.EventUserStatusMute, map[string]interface{}{
+			"jid":          e.JID.String(),
+			"muted":        e.Action.GetMuted(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.BusinessName:
+		return whatsapp.EventBusinessName, map[string]interface{}{
+			"jid":             e.JID.String(),
+			"oldBusinessName": e.OldBusinessName,
+			"newBusinessName": e.NewBusinessName,
+			"timestamp":       time.Now(),
+		}, true
+
+	// Chamadas
+	case *events.CallOffer:
+		return whatsapp.EventCallOffer, callData(e.BasicCallMeta), true
+	case *events.CallOfferNotice:
+		data := callData(e.BasicCallMeta)
+		data["media"] = e.Media
+		data["type"] = e.Type
+		return whatsapp.EventCallOfferNotice, data, true
+	case *events.CallPreAccept:
+		return whatsapp.EventCallPreAccept, callData(e.BasicCallMeta), true
+	case *events.CallAccept:
+		return whatsapp.EventCallAccept, callData(e.BasicCallMeta), true
+	case *events.CallReject:
+		return whatsapp.EventCallReject, callData(e.BasicCallMeta), true
+	case *events.CallTerminate:
+		data := callData(e.BasicCallMeta)
+		data["reason"] = e.Reason
+		return whatsapp.EventCallTerminate, data, true
+	case *events.CallTransport:
+		return whatsapp.EventCallTransport, callData(e.BasicCallMeta), true
+	case *events.CallRelayLatency:
+		return whatsapp.EventCallRelayLatency, callData(e.BasicCallMeta), true
+	case *events.UnknownCallEvent:
+		data := map[string]interface{}{
+			"timestamp": time.Now(),
+		}
+		if e.Node != nil {
+			data["tag"] = e.Node.Tag
+		}
+		return whatsapp.EventCallUnknown, data, true
+
+	// Grupos
+	case *events.GroupInfo:
+		// Entradas, saídas, promoções e rebaixamentos são entregues como group.participants
+		if len(e.Join) > 0 || len(e.Leave) > 0 || len(e.Promote) > 0 || len(e.Demote) > 0 {
+			return whatsapp.EventGroupParticipants, groupInfoData(e), true
+		}
+		return whatsapp.EventGroupInfo, groupInfoData(e), true
+	case *events.JoinedGroup:
+		return whatsapp.EventJoinedGroup, map[string]interface{}{
+			"jid":       e.JID.String(),
+			"name":      e.Name,
+			"topic":     e.Topic,
+			"owner":     jidString(&e.OwnerJID),
+			"reason":    e.Reason,
+			"type":      e.Type,
+			"sender":    jidString(e.Sender),
+			"notify":    e.Notify,
+			"size":      len(e.Participants),
+			"createdAt": e.GroupCreated,
+			"timestamp": time.Now(),
+		}, true
+
+	// Canais
+	case *events.NewsletterJoin:
+		return whatsapp.EventNewsletterJoin, map[string]interface{}{
+			"jid":         e.ID.String(),
+			"name":        e.ThreadMeta.Name.Text,
+			"description": e.ThreadMeta.Description.Text,
+			"subscribers": e.ThreadMeta.SubscriberCount,
+			"timestamp":   time.Now(),
+		}, true
+	case *events.NewsletterLeave:
+		return whatsapp.EventNewsletterLeave, map[string]interface{}{
+			"jid":       e.ID.String(),
+			"role":      string(e.Role),
+			"timestamp": time.Now(),
+		}, true
+	case *events.NewsletterLiveUpdate:
+		return whatsapp.EventNewsletterLiveUpdate, map[string]interface{}{
+			"jid":       e.JID.String(),
+			"messages":  len(e.Messages),
+			"timestamp": e.Time,
+		}, true
+	case *events.NewsletterMuteChange:
+		return whatsapp.EventNewsletterMuteChange, map[string]interface{}{
+			"jid":       e.ID.String(),
+			"mute":      string(e.Mute),
+			"timestamp": time.Now(),
+		}, true
+
+	// Etiquetas
+	case *events.LabelEdit:
+		return whatsapp.EventLabelEdit, map[string]interface{}{
+			"labelId":      e.LabelID,
+			"name":         e.Action.GetName(),
+			"color":        e.Action.GetColor(),
+			"deleted":      e.Action.GetDeleted(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.LabelAssociationChat:
+		return whatsapp.EventLabelAssociationChat, map[string]interface{}{
+			"chat":         e.JID.String(),
+			"labelId":      e.LabelID,
+			"labeled":      e.Action.GetLabeled(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+	case *events.LabelAssociationMessage:
+		return whatsapp.EventLabelAssociationMessage, map[string]interface{}{
+			"chat":         e.JID.String(),
+			"labelId":      e.LabelID,
+			"messageId":    e.MessageID,
+			"labeled":      e.Action.GetLabeled(),
+			"fromFullSync": e.FromFullSync,
+			"timestamp":    e.Timestamp,
+		}, true
+
+	// Segurança
+	case *events.IdentityChange:
+		return whatsapp.EventIdentityChange, map[string]interface{}{
+			"jid":       e.JID.String(),
+			"implicit":  e.Implicit,
+			"timestamp": e.Timestamp,
+		}, true
+	}
+
+	return "", nil, false
+}
+
+// callData monta os campos comuns dos eventos de chamada
+func callData(meta types.BasicCallMeta) map[string]interface{} {
+	return map[string]interface{}{
+		"callId":      meta.CallID,
+		"from":        meta.From.String(),
+		"callCreator": jidString(&meta.CallCreator),
+		"group":       jidString(&meta.GroupJID),
+		"timestamp":   meta.Timestamp,
+	}
+}
+
+// groupInfoData inclui apenas as alterações presentes no evento de grupo
+func groupInfoData(e *events.GroupInfo) map[string]interface{} {
+	data := map[string]interface{}{
+		"jid":       e.JID.String(),
+		"sender":    jidString(e.Sender),
+		"notify":    e.Notify,
+		"timestamp": e.Timestamp,
+	}
+	if e.Name != nil {
+		data["name"] = e.Name.Name
+	}
+	if e.Topic != nil {
+		data["topic"] = e.Topic.Topic
+	}
+	if e.Locked != nil {
+		data["locked"] = e.Locked.IsLocked
+	}
+	if e.Announce != nil {
+		data["announce"] = e.Announce.IsAnnounce
+	}
+	if e.Ephemeral != nil {
+		data["ephemeral"] = e.Ephemeral.IsEphemeral
+		data["disappearingTimer"] = e.Ephemeral.DisappearingTimer
+	}
+	if e.Delete != nil {
+		data["deleted"] = e.Delete.Deleted
+	}
+	if e.NewInviteLink != nil {
+		data["inviteLink"] = *e.NewInviteLink
+	}
+	if len(e.Join) > 0 {
+		data["join"] = jidStrings(e.Join)
+		data["joinReason"] = e.JoinReason
+	}
+	if len(e.Leave) > 0 {
+		data["leave"] = jidStrings(e.Leave)
+	}
+	if len(e.Promote) > 0 {
+		data["promote"] = jidStrings(e.Promote)
+	}
+	if len(e.Demote) > 0 {
+		data["demote"] = jidStrings(e.Demote)
+	}
+	return data
+}
+
+// privacyChanges lista as configurações de privacidade alteradas no evento
+func privacyChanges(e *events.PrivacySettings) []string {
+	changes := []string{}
+	for name, changed := range map[string]bool{
+		"groupAdd":     e.GroupAddChanged,
+		"lastSeen":     e.LastSeenChanged,
+		"status":       e.StatusChanged,
+		"profile":      e.ProfileChanged,
+		"readReceipts": e.ReadReceiptsChanged,
+		"online":       e.OnlineChanged,
+		"callAdd":      e.CallAddChanged,
+	} {
+		if changed {
+			changes = append(changes, name)
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// jidString retorna o JID como string, ou vazio quando ausente
+func jidString(jid *types.JID) string {
+	if jid == nil || jid.IsEmpty() {
+		return ""
+	}
+	return jid.String()
+}
+
+// jidStrings converte uma lista de JIDs em strings
+func jidStrings(jids []types.JID) []string {
+	result := make([]string, 0, len(jids))
+	for _, jid := range jids {
+		result = append(result, jid.String())
+	}
+	return result
+}
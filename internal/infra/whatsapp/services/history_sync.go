@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waHistorySync"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// maxUnixSeconds limita os horários recebidos da sincronização (silenciamento "para sempre" usa valores enormes)
+const maxUnixSeconds = 253402300799 // 9999-12-31T23:59:59Z
+
+// HistorySyncResult resume o que foi importado de um bloco da sincronização de histórico,
+// entregue no evento history.sync.progress
+type HistorySyncResult struct {
+	SyncType      string `json:"syncType"`
+	ChunkOrder    uint32 `json:"chunkOrder"`
+	Progress      uint32 `json:"progress"`
+	Enabled       bool   `json:"enabled"`
+	Days          int    `json:"days"`
+	Conversations int    `json:"conversations"`
+	Chats         int    `json:"chats"`
+	Contacts      int    `json:"contacts"`
+	Messages      int    `json:"messages"`
+	// Skipped conta as mensagens anteriores à janela configurada na sessão
+	Skipped int `json:"skipped"`
+	// Failed conta as conversas e mensagens que não puderam ser interpretadas ou gravadas
+	Failed int `json:"failed"`
+}
+
+// HistorySyncIngester grava os chats, contatos e mensagens recebidos na sincronização de histórico
+type HistorySyncIngester struct {
+	sessionRepo session.SessionRepository
+	messageRepo message.MessageRepository
+	chatRepo    chat.ChatRepository
+	contactRepo contact.ContactRepository
+	logger      logger.Logger
+}
+
+// NewHistorySyncIngester cria uma nova instância do HistorySyncIngester
+func NewHistorySyncIngester(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	contactRepo contact.ContactRepository,
+	log logger.Logger,
+) *HistorySyncIngester {
+	return &HistorySyncIngester{
+		sessionRepo: sessionRepo,
+		messageRepo: messageRepo,
+		chatRepo:    chatRepo,
+		contactRepo: contactRepo,
+		logger:      log.WithComponent("history-sync"),
+	}
+}
+
+// Ingest importa um bloco da sincronização de histórico, respeitando a janela de dias da sessão.
+// Falhas em conversas isoladas são contadas em Failed e não interrompem a importação do bloco.
+func (h *HistorySyncIngester) Ingest(ctx context.Context, client *whatsmeow.Client, sessionID uuid.UUID, evt *events.HistorySync) (*HistorySyncResult, error) {
+	result := &HistorySyncResult{
+		SyncType:      evt.Data.GetSyncType().String(),
+		ChunkOrder:    evt.Data.GetChunkOrder(),
+		Progress:      evt.Data.GetProgress(),
+		Conversations: len(evt.Data.GetConversations()),
+	}
+
+	sess, err := h.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return result, err
+	}
+	result.Days = sess.HistorySyncDays
+
+	cutoff, enabled := sess.HistoryCutoff(time.Now())
+	result.Enabled = enabled
+	if !enabled {
+		return result, nil
+	}
+	if client == nil {
+		return result, fmt.Errorf("whatsapp client not available")
+	}
+
+	var errs []error
+	for _, pushname := range evt.Data.GetPushnames() {
+		if pushname.GetPushname() == "" {
+			continue
+		}
+		if err := h.savePushname(ctx, sessionID, pushname); err != nil {
+			result.Failed++
+			errs = append(errs, err)
+			continue
+		}
+		result.Contacts++
+	}
+
+	for _, conv := range evt.Data.GetConversations() {
+		if err := h.ingestConversation(ctx, client, sessionID, conv, cutoff, result); err != nil {
+			result.Failed++
+			errs = append(errs, err)
+		}
+	}
+
+	h.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"syncType":   result.SyncType,
+		"chunkOrder": result.ChunkOrder,
+		"progress":   result.Progress,
+		"chats":      result.Chats,
+		"contacts":   result.Contacts,
+		"messages":   result.Messages,
+		"skipped":    result.Skipped,
+		"failed":     result.Failed,
+	}).Info().Msg("History sync chunk ingested")
+
+	return result, errors.Join(errs...)
+}
+
+// savePushname grava o nome público de um contato
+func (h *HistorySyncIngester) savePushname(ctx context.Context, sessionID uuid.UUID, pushname *waHistorySync.Pushname) error {
+	jid, err := types.ParseJID(pushname.GetID())
+	if err != nil {
+		return fmt.Errorf("invalid pushname %q: %w", pushname.GetID(), err)
+	}
+	return h.contactRepo.Upsert(ctx, &contact.Contact{
+		SessionID: sessionID,
+		JID:       jid.ToNonAD().String(),
+		PushName:  pushname.GetPushname(),
+	})
+}
+
+// ingestConversation grava as mensagens da conversa dentro da janela e o chat correspondente
+func (h *HistorySyncIngester) ingestConversation(ctx context.Context, client *whatsmeow.Client, sessionID uuid.UUID, conv *waHistorySync.Conversation, cutoff time.Time, result *HistorySyncResult) error {
+	chatJID, err := types.ParseJID(conv.GetID())
+	if err != nil {
+		return fmt.Errorf("invalid conversation JID %q: %w", conv.GetID(), err)
+	}
+	// Status são efêmeros e não fazem parte das conversas
+	if chatJID == types.StatusBroadcastJID {
+		return nil
+	}
+
+	lastMessageAt := unixTime(conv.GetConversationTimestamp())
+	msgs := make([]*message.Message, 0, len(conv.GetMessages()))
+	for _, item := range conv.GetMessages() {
+		evt, err := client.ParseWebMessage(chatJID, item.GetMessage())
+		if err != nil {
+			result.Failed++
+			continue
+		}
+		// Mensagens de sistema (criação de grupo, troca de número etc.) não têm conteúdo
+		if evt.Message == nil {
+			continue
+		}
+		if evt.Info.Timestamp.Before(cutoff) {
+			result.Skipped++
+			continue
+		}
+		msgs = append(msgs, message.NewMessage(sessionID, NormalizeMessage(evt)))
+		if lastMessageAt == nil || evt.Info.Timestamp.After(*lastMessageAt) {
+			ts := evt.Info.Timestamp
+			lastMessageAt = &ts
+		}
+	}
+
+	if err := h.messageRepo.SaveBatch(ctx, msgs); err != nil {
+		return fmt.Errorf("failed to save history messages of %s: %w", chatJID, err)
+	}
+	result.Messages += len(msgs)
+
+	name := conv.GetName()
+	if name == "" {
+		name = conv.GetDisplayName()
+	}
+	err = h.chatRepo.Upsert(ctx, &chat.Chat{
+		SessionID:     sessionID,
+		JID:           chatJID.String(),
+		Name:          name,
+		IsGroup:       chatJID.Server == types.GroupServer,
+		UnreadCount:   int(conv.GetUnreadCount()),
+		Archived:      conv.GetArchived(),
+		Pinned:        conv.GetPinned() > 0,
+		MuteEndTime:   unixTime(conv.GetMuteEndTime()),
+		MarkedUnread:  conv.GetMarkedAsUnread(),
+		LastMessageAt: lastMessageAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save chat %s: %w", chatJID, err)
+	}
+	result.Chats++
+	return nil
+}
+
+// unixTime converte segundos Unix em horário, retornando nil para zero
+func unixTime(seconds uint64) *time.Time {
+	if seconds == 0 {
+		return nil
+	}
+	t := time.Unix(int64(min(seconds, maxUnixSeconds)), 0)
+	return &t
+}
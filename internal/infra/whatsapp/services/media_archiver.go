@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+
+	"zmeow/internal/domain/media"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// mediaExtensions define a extensão usada na chave dos tipos de mídia mais comuns do WhatsApp
+var mediaExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+	"image/gif":       ".gif",
+	"video/mp4":       ".mp4",
+	"video/3gpp":      ".3gp",
+	"audio/ogg":       ".ogg",
+	"audio/mpeg":      ".mp3",
+	"audio/mp4":       ".m4a",
+	"audio/aac":       ".aac",
+	"application/pdf": ".pdf",
+}
+
+// MediaArchiver baixa as mídias recebidas e as grava no armazenamento configurado,
+// para as sessões com download automático habilitado
+type MediaArchiver struct {
+	storage     media.Storage
+	signer      *media.URLSigner
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewMediaArchiver cria uma nova instância do MediaArchiver
+func NewMediaArchiver(storage media.Storage, signer *media.URLSigner, sessionRepo session.SessionRepository, log logger.Logger) *MediaArchiver {
+	return &MediaArchiver{
+		storage:     storage,
+		signer:      signer,
+		sessionRepo: sessionRepo,
+		logger:      log.WithComponent("media-archiver"),
+	}
+}
+
+// Enabled verifica se a sessão habilitou o download automático de mídias
+func (a *MediaArchiver) Enabled(ctx context.Context, sessionID uuid.UUID) bool {
+	sess, err := a.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || sess == nil {
+		return false
+	}
+	return sess.AutoDownloadMedia
+}
+
+// Archive baixa a mídia da mensagem e a grava sob uma chave endereçada pelo SHA-256 do conteúdo,
+// preenchendo StorageKey e StoredURL em inbound.Media. Mídias já armazenadas não são reenviadas.
+func (a *MediaArchiver) Archive(ctx context.Context, client *whatsmeow.Client, sessionID uuid.UUID, msg *waE2E.Message, inbound *message.InboundMessage) error {
+	if inbound.Media == nil {
+		return nil
+	}
+	if client == nil {
+		return fmt.Errorf("whatsapp client not available")
+	}
+
+	downloadable := downloadableMedia(msg)
+	if downloadable == nil {
+		return message.ErrMessageHasNoMedia
+	}
+
+	file, err := os.CreateTemp("", "zmeow-archive-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+
+	if err := client.DownloadToFile(ctx, downloadable, file); err != nil {
+		return fmt.Errorf("failed to download media: %w", err)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return fmt.Errorf("failed to hash media: %w", err)
+	}
+
+	key := media.ObjectKey(sessionID, hex.EncodeToString(hash.Sum(nil)), mediaExtension(inbound.Media))
+
+	exists, err := a.storage.Exists(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to check stored media: %w", err)
+	}
+	if !exists {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := a.storage.Put(ctx, key, file, size, inbound.Media.MimeType); err != nil {
+			return fmt.Errorf("failed to store media: %w", err)
+		}
+	}
+
+	inbound.Media.StorageKey = key
+	inbound.Media.StoredURL = a.signer.URL(key)
+
+	a.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"messageId":  inbound.ID,
+		"storageKey": key,
+		"size":       size,
+		"reused":     exists,
+	}).Debug().Msg("Media archived")
+
+	return nil
+}
+
+// downloadableMedia retorna a mídia da mensagem já sem os wrappers (temporária, visualização única, etc.)
+func downloadableMedia(msg *waE2E.Message) whatsmeow.DownloadableMessage {
+	msg, _, _ = unwrapMessage(msg)
+	switch {
+	case msg == nil:
+		return nil
+	case msg.ImageMessage != nil:
+		return msg.GetImageMessage()
+	case msg.VideoMessage != nil:
+		return msg.GetVideoMessage()
+	case msg.AudioMessage != nil:
+		return msg.GetAudioMessage()
+	case msg.DocumentMessage != nil:
+		return msg.GetDocumentMessage()
+	case msg.StickerMessage != nil:
+		return msg.GetStickerMessage()
+	}
+	return nil
+}
+
+// mediaExtension escolhe a extensão da chave a partir do tipo MIME ou do nome do arquivo
+func mediaExtension(m *message.InboundMedia) string {
+	mimeType := strings.TrimSpace(strings.Split(m.MimeType, ";")[0])
+	if ext, ok := mediaExtensions[mimeType]; ok {
+		return ext
+	}
+	if ext := strings.ToLower(filepath.Ext(m.FileName)); ext != "" && len(ext) <= 11 && isAlphanumeric(ext[1:]) {
+		return ext
+	}
+	if exts, err := mime.ExtensionsByType(mimeType); err == nil && len(exts) > 0 && isAlphanumeric(exts[0][1:]) {
+		return exts[0]
+	}
+	return ".bin"
+}
+
+// isAlphanumeric verifica se a extensão contém apenas letras minúsculas e dígitos, como exigido nas chaves
+func isAlphanumeric(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
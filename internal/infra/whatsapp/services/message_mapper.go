@@ -0,0 +1,435 @@
+package services
+
+import (
+	"encoding/base64"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"zmeow/internal/domain/message"
+)
+
+// maxUnwrapDepth limita o desempacotamento de wrappers aninhados (ephemeral, view once, etc.)
+const maxUnwrapDepth = 5
+
+// NormalizeMessage converte um evento de mensagem do whatsmeow no DTO estável enviado nos webhooks
+func NormalizeMessage(evt *events.Message) *message.InboundMessage {
+	msg, ephemeral, viewOnce := unwrapMessage(evt.Message)
+
+	inbound := &message.InboundMessage{
+		Version:   message.InboundMessageVersion,
+		ID:        evt.Info.ID,
+		Chat:      evt.Info.Chat.String(),
+		Sender:    evt.Info.Sender.String(),
+		PushName:  evt.Info.PushName,
+		FromMe:    evt.Info.IsFromMe,
+		IsGroup:   evt.Info.IsGroup,
+		Timestamp: evt.Info.Timestamp,
+		Ephemeral: ephemeral || evt.IsEphemeral,
+		ViewOnce:  viewOnce || evt.IsViewOnce || evt.IsViewOnceV2 || evt.IsViewOnceV2Extension,
+	}
+
+	fillMessageContent(inbound, msg)
+	return inbound
+}
+
+// NormalizeSentMessage converte uma mensagem enviada pela sessão no mesmo DTO das mensagens recebidas
+func NormalizeSentMessage(messageID string, chat, sender types.JID, timestamp time.Time, msg *waE2E.Message) *message.InboundMessage {
+	return NormalizeMessage(&events.Message{
+		Info: types.MessageInfo{
+			MessageSource: types.MessageSource{
+				Chat:     chat,
+				Sender:   sender,
+				IsFromMe: true,
+				IsGroup:  chat.Server == types.GroupServer,
+			},
+			ID:        messageID,
+			Timestamp: timestamp,
+		},
+		Message: msg,
+	})
+}
+
+// DetectMessageType retorna o tipo normalizado de uma mensagem do WhatsApp
+func DetectMessageType(msg *waE2E.Message) message.InboundMessageType {
+	msg, _, _ = unwrapMessage(msg)
+
+	switch {
+	case msg == nil:
+		return message.InboundTypeUnknown
+	case msg.GetConversation() != "":
+		return message.InboundTypeText
+	case msg.ExtendedTextMessage != nil:
+		return message.InboundTypeExtendedText
+	case msg.ImageMessage != nil:
+		return message.InboundTypeImage
+	case msg.VideoMessage != nil:
+		return message.InboundTypeVideo
+	case msg.AudioMessage != nil:
+		if msg.GetAudioMessage().GetPTT() {
+			return message.InboundTypePTT
+		}
+		return message.InboundTypeAudio
+	case msg.DocumentMessage != nil:
+		return message.InboundTypeDocument
+	case msg.StickerMessage != nil:
+		return message.InboundTypeSticker
+	case msg.LiveLocationMessage != nil:
+		return message.InboundTypeLiveLocation
+	case msg.LocationMessage != nil:
+		if msg.GetLocationMessage().GetIsLive() {
+			return message.InboundTypeLiveLocation
+		}
+		return message.InboundTypeLocation
+	case msg.ContactMessage != nil:
+		return message.InboundTypeContact
+	case msg.ContactsArrayMessage != nil:
+		return message.InboundTypeContactsArray
+	case pollCreation(msg) != nil:
+		return message.InboundTypePollCreation
+	case msg.PollUpdateMessage != nil:
+		return message.InboundTypePollVote
+	case msg.ReactionMessage != nil:
+		return message.InboundTypeReaction
+	case msg.ProtocolMessage != nil:
+		switch msg.GetProtocolMessage().GetType() {
+		case waE2E.ProtocolMessage_MESSAGE_EDIT:
+			return message.InboundTypeEdit
+		case waE2E.ProtocolMessage_REVOKE:
+			return message.InboundTypeRevoke
+		}
+		return message.InboundTypeUnknown
+	case msg.ButtonsResponseMessage != nil, msg.TemplateButtonReplyMessage != nil:
+		return message.InboundTypeButtonReply
+	case msg.ListResponseMessage != nil:
+		return message.InboundTypeListReply
+	case msg.ButtonsMessage != nil:
+		return message.InboundTypeButtons
+	case msg.ListMessage != nil:
+		return message.InboundTypeList
+	}
+
+	return message.InboundTypeUnknown
+}
+
+// unwrapMessage remove os wrappers de mensagem temporária, visualização única e documento com legenda
+func unwrapMessage(msg *waE2E.Message) (inner *waE2E.Message, ephemeral, viewOnce bool) {
+	for i := 0; i < maxUnwrapDepth && msg != nil; i++ {
+		switch {
+		case msg.EphemeralMessage != nil:
+			ephemeral = true
+			msg = msg.GetEphemeralMessage().GetMessage()
+		case msg.ViewOnceMessage != nil:
+			viewOnce = true
+			msg = msg.GetViewOnceMessage().GetMessage()
+		case msg.ViewOnceMessageV2 != nil:
+			viewOnce = true
+			msg = msg.GetViewOnceMessageV2().GetMessage()
+		case msg.ViewOnceMessageV2Extension != nil:
+			viewOnce = true
+			msg = msg.GetViewOnceMessageV2Extension().GetMessage()
+		case msg.DocumentWithCaptionMessage != nil:
+			msg = msg.GetDocumentWithCaptionMessage().GetMessage()
+		case msg.EditedMessage != nil:
+			msg = msg.GetEditedMessage().GetMessage()
+		default:
+			return msg, ephemeral, viewOnce
+		}
+	}
+	return msg, ephemeral, viewOnce
+}
+
+// pollCreation retorna a criação de enquete em qualquer uma das versões do protocolo
+func pollCreation(msg *waE2E.Message) *waE2E.PollCreationMessage {
+	switch {
+	case msg.PollCreationMessage != nil:
+		return msg.GetPollCreationMessage()
+	case msg.PollCreationMessageV2 != nil:
+		return msg.GetPollCreationMessageV2()
+	case msg.PollCreationMessageV3 != nil:
+		return msg.GetPollCreationMessageV3()
+	}
+	return nil
+}
+
+// fillMessageContent preenche o tipo, o texto e o bloco específico do tipo da mensagem
+func fillMessageContent(inbound *message.InboundMessage, msg *waE2E.Message) {
+	msg, _, _ = unwrapMessage(msg)
+	inbound.Type = DetectMessageType(msg)
+
+	switch inbound.Type {
+	case message.InboundTypeText:
+		inbound.Text = msg.GetConversation()
+
+	case message.InboundTypeExtendedText:
+		ext := msg.GetExtendedTextMessage()
+		inbound.Text = ext.GetText()
+		inbound.Context = mapContextInfo(ext.GetContextInfo())
+
+	case message.InboundTypeImage:
+		img := msg.GetImageMessage()
+		inbound.Text = img.GetCaption()
+		inbound.Context = mapContextInfo(img.GetContextInfo())
+		inbound.ViewOnce = inbound.ViewOnce || img.GetViewOnce()
+		inbound.Media = &message.InboundMedia{
+			MimeType:      img.GetMimetype(),
+			FileLength:    img.GetFileLength(),
+			FileSHA256:    encodeBytes(img.GetFileSHA256()),
+			FileEncSHA256: encodeBytes(img.GetFileEncSHA256()),
+			MediaKey:      encodeBytes(img.GetMediaKey()),
+			URL:           img.GetURL(),
+			DirectPath:    img.GetDirectPath(),
+			Width:         img.GetWidth(),
+			Height:        img.GetHeight(),
+		}
+
+	case message.InboundTypeVideo:
+		video := msg.GetVideoMessage()
+		inbound.Text = video.GetCaption()
+		inbound.Context = mapContextInfo(video.GetContextInfo())
+		inbound.ViewOnce = inbound.ViewOnce || video.GetViewOnce()
+		inbound.Media = &message.InboundMedia{
+			MimeType:      video.GetMimetype(),
+			FileLength:    video.GetFileLength(),
+			FileSHA256:    encodeBytes(video.GetFileSHA256()),
+			FileEncSHA256: encodeBytes(video.GetFileEncSHA256()),
+			MediaKey:      encodeBytes(video.GetMediaKey()),
+			URL:           video.GetURL(),
+			DirectPath:    video.GetDirectPath(),
+			Width:         video.GetWidth(),
+			Height:        video.GetHeight(),
+			Seconds:       video.GetSeconds(),
+			GIFPlayback:   video.GetGifPlayback(),
+		}
+
+	case message.InboundTypeAudio, message.InboundTypePTT:
+		audio := msg.GetAudioMessage()
+		inbound.Context = mapContextInfo(audio.GetContextInfo())
+		inbound.ViewOnce = inbound.ViewOnce || audio.GetViewOnce()
+		inbound.Media = &message.InboundMedia{
+			MimeType:      audio.GetMimetype(),
+			FileLength:    audio.GetFileLength(),
+			FileSHA256:    encodeBytes(audio.GetFileSHA256()),
+			FileEncSHA256: encodeBytes(audio.GetFileEncSHA256()),
+			MediaKey:      encodeBytes(audio.GetMediaKey()),
+			URL:           audio.GetURL(),
+			DirectPath:    audio.GetDirectPath(),
+			Seconds:       audio.GetSeconds(),
+			PTT:           audio.GetPTT(),
+		}
+
+	case message.InboundTypeDocument:
+		doc := msg.GetDocumentMessage()
+		inbound.Text = doc.GetCaption()
+		inbound.Context = mapContextInfo(doc.GetContextInfo())
+		inbound.Media = &message.InboundMedia{
+			MimeType:      doc.GetMimetype(),
+			FileName:      doc.GetFileName(),
+			FileLength:    doc.GetFileLength(),
+			FileSHA256:    encodeBytes(doc.GetFileSHA256()),
+			FileEncSHA256: encodeBytes(doc.GetFileEncSHA256()),
+			MediaKey:      encodeBytes(doc.GetMediaKey()),
+			URL:           doc.GetURL(),
+			DirectPath:    doc.GetDirectPath(),
+			PageCount:     doc.GetPageCount(),
+		}
+
+	case message.InboundTypeSticker:
+		sticker := msg.GetStickerMessage()
+		inbound.Context = mapContextInfo(sticker.GetContextInfo())
+		inbound.Media = &message.InboundMedia{
+			MimeType:      sticker.GetMimetype(),
+			FileLength:    sticker.GetFileLength(),
+			FileSHA256:    encodeBytes(sticker.GetFileSHA256()),
+			FileEncSHA256: encodeBytes(sticker.GetFileEncSHA256()),
+			MediaKey:      encodeBytes(sticker.GetMediaKey()),
+			URL:           sticker.GetURL(),
+			DirectPath:    sticker.GetDirectPath(),
+			Width:         sticker.GetWidth(),
+			Height:        sticker.GetHeight(),
+			Animated:      sticker.GetIsAnimated(),
+		}
+
+	case message.InboundTypeLocation, message.InboundTypeLiveLocation:
+		if live := msg.GetLiveLocationMessage(); live != nil {
+			inbound.Text = live.GetCaption()
+			inbound.Context = mapContextInfo(live.GetContextInfo())
+			inbound.Location = &message.InboundLocation{
+				Latitude:       live.GetDegreesLatitude(),
+				Longitude:      live.GetDegreesLongitude(),
+				Live:           true,
+				AccuracyMeters: live.GetAccuracyInMeters(),
+				SpeedMps:       live.GetSpeedInMps(),
+				Sequence:       live.GetSequenceNumber(),
+			}
+			break
+		}
+		loc := msg.GetLocationMessage()
+		inbound.Context = mapContextInfo(loc.GetContextInfo())
+		inbound.Location = &message.InboundLocation{
+			Latitude:       loc.GetDegreesLatitude(),
+			Longitude:      loc.GetDegreesLongitude(),
+			Name:           loc.GetName(),
+			Address:        loc.GetAddress(),
+			URL:            loc.GetURL(),
+			Live:           loc.GetIsLive(),
+			AccuracyMeters: loc.GetAccuracyInMeters(),
+			SpeedMps:       loc.GetSpeedInMps(),
+		}
+
+	case message.InboundTypeContact:
+		contact := msg.GetContactMessage()
+		inbound.Context = mapContextInfo(contact.GetContextInfo())
+		inbound.Contacts = []message.InboundContact{mapContact(contact)}
+
+	case message.InboundTypeContactsArray:
+		contacts := msg.GetContactsArrayMessage()
+		inbound.Text = contacts.GetDisplayName()
+		inbound.Context = mapContextInfo(contacts.GetContextInfo())
+		inbound.Contacts = make([]message.InboundContact, 0, len(contacts.GetContacts()))
+		for _, contact := range contacts.GetContacts() {
+			inbound.Contacts = append(inbound.Contacts, mapContact(contact))
+		}
+
+	case message.InboundTypePollCreation:
+		poll := pollCreation(msg)
+		inbound.Text = poll.GetName()
+		inbound.Context = mapContextInfo(poll.GetContextInfo())
+		options := make([]string, 0, len(poll.GetOptions()))
+		for _, option := range poll.GetOptions() {
+			options = append(options, option.GetOptionName())
+		}
+		inbound.Poll = &message.InboundPoll{
+			Name:                   poll.GetName(),
+			Options:                options,
+			SelectableOptionsCount: poll.GetSelectableOptionsCount(),
+		}
+
+	case message.InboundTypePollVote:
+		vote := msg.GetPollUpdateMessage()
+		inbound.PollVote = &message.InboundPollVote{
+			PollMessageID: vote.GetPollCreationMessageKey().GetID(),
+			VotedAt:       millisToTime(vote.GetSenderTimestampMS()),
+		}
+
+	case message.InboundTypeReaction:
+		reaction := msg.GetReactionMessage()
+		inbound.Text = reaction.GetText()
+		inbound.Reaction = &message.InboundReaction{
+			MessageID: reaction.GetKey().GetID(),
+			FromMe:    reaction.GetKey().GetFromMe(),
+			Emoji:     reaction.GetText(),
+			Removed:   reaction.GetText() == "",
+		}
+
+	case message.InboundTypeEdit:
+		protocol := msg.GetProtocolMessage()
+		edited := &message.InboundMessage{}
+		fillMessageContent(edited, protocol.GetEditedMessage())
+		inbound.Text = edited.Text
+		inbound.Context = edited.Context
+		inbound.Edit = &message.InboundEdit{
+			MessageID: protocol.GetKey().GetID(),
+			Text:      edited.Text,
+		}
+
+	case message.InboundTypeRevoke:
+		protocol := msg.GetProtocolMessage()
+		inbound.Revoke = &message.InboundRevoke{
+			MessageID: protocol.GetKey().GetID(),
+			FromMe:    protocol.GetKey().GetFromMe(),
+		}
+
+	case message.InboundTypeButtonReply:
+		if template := msg.GetTemplateButtonReplyMessage(); template != nil {
+			inbound.Text = template.GetSelectedDisplayText()
+			inbound.Context = mapContextInfo(template.GetContextInfo())
+			inbound.ButtonReply = &message.InboundButtonReply{
+				ID:          template.GetSelectedID(),
+				DisplayText: template.GetSelectedDisplayText(),
+			}
+			break
+		}
+		reply := msg.GetButtonsResponseMessage()
+		inbound.Text = reply.GetSelectedDisplayText()
+		inbound.Context = mapContextInfo(reply.GetContextInfo())
+		inbound.ButtonReply = &message.InboundButtonReply{
+			ID:          reply.GetSelectedButtonID(),
+			DisplayText: reply.GetSelectedDisplayText(),
+		}
+
+	case message.InboundTypeListReply:
+		reply := msg.GetListResponseMessage()
+		inbound.Text = reply.GetTitle()
+		inbound.Context = mapContextInfo(reply.GetContextInfo())
+		inbound.ListReply = &message.InboundListReply{
+			RowID:       reply.GetSingleSelectReply().GetSelectedRowID(),
+			Title:       reply.GetTitle(),
+			Description: reply.GetDescription(),
+		}
+
+	case message.InboundTypeButtons:
+		buttons := msg.GetButtonsMessage()
+		inbound.Text = buttons.GetContentText()
+		inbound.Context = mapContextInfo(buttons.GetContextInfo())
+
+	case message.InboundTypeList:
+		list := msg.GetListMessage()
+		inbound.Text = list.GetDescription()
+		inbound.Context = mapContextInfo(list.GetContextInfo())
+	}
+}
+
+// mapContextInfo extrai a mensagem citada, as menções e o encaminhamento do ContextInfo
+func mapContextInfo(info *waE2E.ContextInfo) *message.InboundMessageContext {
+	if info == nil {
+		return nil
+	}
+
+	ctx := &message.InboundMessageContext{
+		QuotedMessageID:   info.GetStanzaID(),
+		QuotedParticipant: info.GetParticipant(),
+		Mentions:          info.GetMentionedJID(),
+		Forwarded:         info.GetIsForwarded(),
+		ForwardingScore:   info.GetForwardingScore(),
+	}
+
+	if quoted := info.GetQuotedMessage(); quoted != nil {
+		quotedInbound := &message.InboundMessage{}
+		fillMessageContent(quotedInbound, quoted)
+		ctx.QuotedType = quotedInbound.Type
+		ctx.QuotedText = quotedInbound.Text
+	}
+
+	if ctx.QuotedMessageID == "" && len(ctx.Mentions) == 0 && !ctx.Forwarded {
+		return nil
+	}
+	return ctx
+}
+
+// mapContact converte um contato compartilhado
+func mapContact(contact *waE2E.ContactMessage) message.InboundContact {
+	return message.InboundContact{
+		DisplayName: contact.GetDisplayName(),
+		VCard:       contact.GetVcard(),
+	}
+}
+
+// encodeBytes codifica hashes e chaves de mídia em base64, como no protocolo do WhatsApp
+func encodeBytes(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// millisToTime converte um timestamp em milissegundos, retornando nil quando ausente
+func millisToTime(ms int64) *time.Time {
+	if ms == 0 {
+		return nil
+	}
+	t := time.UnixMilli(ms)
+	return &t
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waCommon"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"google.golang.org/protobuf/proto"
+
+	"zmeow/internal/domain/message"
+)
+
+func TestNormalizeMessageVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      *waE2E.Message
+		wantType message.InboundMessageType
+		wantText string
+		check    func(t *testing.T, inbound *message.InboundMessage)
+	}{
+		{
+			name:     "text",
+			msg:      &waE2E.Message{Conversation: proto.String("Olá")},
+			wantType: message.InboundTypeText,
+			wantText: "Olá",
+		},
+		{
+			name: "extended text with quoted reply",
+			msg: &waE2E.Message{ExtendedTextMessage: &waE2E.ExtendedTextMessage{
+				Text: proto.String("Respondendo"),
+				ContextInfo: &waE2E.ContextInfo{
+					StanzaID:      proto.String("QUOTED1"),
+					Participant:   proto.String("5511888888888@s.whatsapp.net"),
+					QuotedMessage: &waE2E.Message{Conversation: proto.String("Original")},
+				},
+			}},
+			wantType: message.InboundTypeExtendedText,
+			wantText: "Respondendo",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Context == nil || inbound.Context.QuotedMessageID != "QUOTED1" ||
+					inbound.Context.QuotedType != message.InboundTypeText || inbound.Context.QuotedText != "Original" {
+					t.Fatalf("Context = %+v, want the quoted text message QUOTED1", inbound.Context)
+				}
+			},
+		},
+		{
+			name: "image with caption",
+			msg: &waE2E.Message{ImageMessage: &waE2E.ImageMessage{
+				Caption:    proto.String("Foto"),
+				Mimetype:   proto.String("image/jpeg"),
+				FileSHA256: []byte{1, 2, 3},
+				Width:      proto.Uint32(640),
+			}},
+			wantType: message.InboundTypeImage,
+			wantText: "Foto",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Media == nil || inbound.Media.MimeType != "image/jpeg" || inbound.Media.FileSHA256 != "AQID" || inbound.Media.Width != 640 {
+					t.Fatalf("Media = %+v", inbound.Media)
+				}
+			},
+		},
+		{
+			name:     "view once image",
+			msg:      &waE2E.Message{ViewOnceMessageV2: &waE2E.FutureProofMessage{Message: &waE2E.Message{ImageMessage: &waE2E.ImageMessage{Caption: proto.String("Uma vez")}}}},
+			wantType: message.InboundTypeImage,
+			wantText: "Uma vez",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if !inbound.ViewOnce {
+					t.Fatal("ViewOnce = false for a view once wrapper")
+				}
+			},
+		},
+		{
+			name:     "video",
+			msg:      &waE2E.Message{VideoMessage: &waE2E.VideoMessage{Caption: proto.String("Vídeo"), Seconds: proto.Uint32(12), GifPlayback: proto.Bool(true)}},
+			wantType: message.InboundTypeVideo,
+			wantText: "Vídeo",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Media == nil || inbound.Media.Seconds != 12 || !inbound.Media.GIFPlayback {
+					t.Fatalf("Media = %+v", inbound.Media)
+				}
+			},
+		},
+		{
+			name:     "audio",
+			msg:      &waE2E.Message{AudioMessage: &waE2E.AudioMessage{Mimetype: proto.String("audio/mpeg"), Seconds: proto.Uint32(30)}},
+			wantType: message.InboundTypeAudio,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Media == nil || inbound.Media.Seconds != 30 || inbound.Media.PTT {
+					t.Fatalf("Media = %+v", inbound.Media)
+				}
+			},
+		},
+		{
+			name:     "voice note",
+			msg:      &waE2E.Message{AudioMessage: &waE2E.AudioMessage{PTT: proto.Bool(true)}},
+			wantType: message.InboundTypePTT,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Media == nil || !inbound.Media.PTT {
+					t.Fatalf("Media = %+v, want PTT", inbound.Media)
+				}
+			},
+		},
+		{
+			name:     "document",
+			msg:      &waE2E.Message{DocumentMessage: &waE2E.DocumentMessage{Caption: proto.String("Contrato"), FileName: proto.String("contrato.pdf"), PageCount: proto.Uint32(3)}},
+			wantType: message.InboundTypeDocument,
+			wantText: "Contrato",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Media == nil || inbound.Media.FileName != "contrato.pdf" || inbound.Media.PageCount != 3 {
+					t.Fatalf("Media = %+v", inbound.Media)
+				}
+			},
+		},
+		{
+			name:     "document with caption wrapper",
+			msg:      &waE2E.Message{DocumentWithCaptionMessage: &waE2E.FutureProofMessage{Message: &waE2E.Message{DocumentMessage: &waE2E.DocumentMessage{Caption: proto.String("Anexo")}}}},
+			wantType: message.InboundTypeDocument,
+			wantText: "Anexo",
+		},
+		{
+			name:     "sticker",
+			msg:      &waE2E.Message{StickerMessage: &waE2E.StickerMessage{Mimetype: proto.String("image/webp"), IsAnimated: proto.Bool(true)}},
+			wantType: message.InboundTypeSticker,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Media == nil || inbound.Media.MimeType != "image/webp" || !inbound.Media.Animated {
+					t.Fatalf("Media = %+v", inbound.Media)
+				}
+			},
+		},
+		{
+			name:     "location",
+			msg:      &waE2E.Message{LocationMessage: &waE2E.LocationMessage{DegreesLatitude: proto.Float64(-23.55), DegreesLongitude: proto.Float64(-46.63), Name: proto.String("Sé")}},
+			wantType: message.InboundTypeLocation,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Location == nil || inbound.Location.Latitude != -23.55 || inbound.Location.Longitude != -46.63 || inbound.Location.Name != "Sé" || inbound.Location.Live {
+					t.Fatalf("Location = %+v", inbound.Location)
+				}
+			},
+		},
+		{
+			name:     "live location",
+			msg:      &waE2E.Message{LiveLocationMessage: &waE2E.LiveLocationMessage{DegreesLatitude: proto.Float64(1), Caption: proto.String("A caminho"), SequenceNumber: proto.Int64(4)}},
+			wantType: message.InboundTypeLiveLocation,
+			wantText: "A caminho",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Location == nil || !inbound.Location.Live || inbound.Location.Sequence != 4 {
+					t.Fatalf("Location = %+v", inbound.Location)
+				}
+			},
+		},
+		{
+			name:     "contact",
+			msg:      &waE2E.Message{ContactMessage: &waE2E.ContactMessage{DisplayName: proto.String("Ana"), Vcard: proto.String("BEGIN:VCARD\nEND:VCARD")}},
+			wantType: message.InboundTypeContact,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if len(inbound.Contacts) != 1 || inbound.Contacts[0].DisplayName != "Ana" || inbound.Contacts[0].VCard == "" {
+					t.Fatalf("Contacts = %+v", inbound.Contacts)
+				}
+			},
+		},
+		{
+			name: "contacts array",
+			msg: &waE2E.Message{ContactsArrayMessage: &waE2E.ContactsArrayMessage{
+				DisplayName: proto.String("2 contatos"),
+				Contacts:    []*waE2E.ContactMessage{{DisplayName: proto.String("Ana")}, {DisplayName: proto.String("Bia")}},
+			}},
+			wantType: message.InboundTypeContactsArray,
+			wantText: "2 contatos",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if len(inbound.Contacts) != 2 || inbound.Contacts[1].DisplayName != "Bia" {
+					t.Fatalf("Contacts = %+v", inbound.Contacts)
+				}
+			},
+		},
+		{
+			name: "reaction",
+			msg: &waE2E.Message{ReactionMessage: &waE2E.ReactionMessage{
+				Key:  &waCommon.MessageKey{ID: proto.String("TARGET1"), FromMe: proto.Bool(true)},
+				Text: proto.String("👍"),
+			}},
+			wantType: message.InboundTypeReaction,
+			wantText: "👍",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Reaction == nil || inbound.Reaction.MessageID != "TARGET1" || !inbound.Reaction.FromMe || inbound.Reaction.Removed {
+					t.Fatalf("Reaction = %+v", inbound.Reaction)
+				}
+			},
+		},
+		{
+			name:     "removed reaction",
+			msg:      &waE2E.Message{ReactionMessage: &waE2E.ReactionMessage{Key: &waCommon.MessageKey{ID: proto.String("TARGET1")}, Text: proto.String("")}},
+			wantType: message.InboundTypeReaction,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Reaction == nil || !inbound.Reaction.Removed {
+					t.Fatalf("Reaction = %+v, want removed", inbound.Reaction)
+				}
+			},
+		},
+		{
+			name: "poll",
+			msg: &waE2E.Message{PollCreationMessageV3: &waE2E.PollCreationMessage{
+				Name:                   proto.String("Almoço?"),
+				Options:                []*waE2E.PollCreationMessage_Option{{OptionName: proto.String("Sim")}, {OptionName: proto.String("Não")}},
+				SelectableOptionsCount: proto.Uint32(1),
+			}},
+			wantType: message.InboundTypePollCreation,
+			wantText: "Almoço?",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Poll == nil || len(inbound.Poll.Options) != 2 || inbound.Poll.Options[1] != "Não" || inbound.Poll.SelectableOptionsCount != 1 {
+					t.Fatalf("Poll = %+v", inbound.Poll)
+				}
+			},
+		},
+		{
+			name: "poll vote",
+			msg: &waE2E.Message{PollUpdateMessage: &waE2E.PollUpdateMessage{
+				PollCreationMessageKey: &waCommon.MessageKey{ID: proto.String("POLL1")},
+				SenderTimestampMS:      proto.Int64(1700000000000),
+			}},
+			wantType: message.InboundTypePollVote,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.PollVote == nil || inbound.PollVote.PollMessageID != "POLL1" || inbound.PollVote.VotedAt == nil || !inbound.PollVote.VotedAt.Equal(time.UnixMilli(1700000000000)) {
+					t.Fatalf("PollVote = %+v", inbound.PollVote)
+				}
+			},
+		},
+		{
+			name: "edit",
+			msg: &waE2E.Message{ProtocolMessage: &waE2E.ProtocolMessage{
+				Type:          waE2E.ProtocolMessage_MESSAGE_EDIT.Enum(),
+				Key:           &waCommon.MessageKey{ID: proto.String("EDITED1")},
+				EditedMessage: &waE2E.Message{Conversation: proto.String("Texto corrigido")},
+			}},
+			wantType: message.InboundTypeEdit,
+			wantText: "Texto corrigido",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Edit == nil || inbound.Edit.MessageID != "EDITED1" || inbound.Edit.Text != "Texto corrigido" {
+					t.Fatalf("Edit = %+v", inbound.Edit)
+				}
+			},
+		},
+		{
+			name: "revoke",
+			msg: &waE2E.Message{ProtocolMessage: &waE2E.ProtocolMessage{
+				Type: waE2E.ProtocolMessage_REVOKE.Enum(),
+				Key:  &waCommon.MessageKey{ID: proto.String("REVOKED1"), FromMe: proto.Bool(true)},
+			}},
+			wantType: message.InboundTypeRevoke,
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.Revoke == nil || inbound.Revoke.MessageID != "REVOKED1" || !inbound.Revoke.FromMe {
+					t.Fatalf("Revoke = %+v", inbound.Revoke)
+				}
+			},
+		},
+		{
+			name:     "other protocol message",
+			msg:      &waE2E.Message{ProtocolMessage: &waE2E.ProtocolMessage{Type: waE2E.ProtocolMessage_EPHEMERAL_SETTING.Enum()}},
+			wantType: message.InboundTypeUnknown,
+		},
+		{
+			name:     "button reply",
+			msg:      &waE2E.Message{ButtonsResponseMessage: &waE2E.ButtonsResponseMessage{SelectedButtonID: proto.String("yes"), Response: &waE2E.ButtonsResponseMessage_SelectedDisplayText{SelectedDisplayText: "Sim"}}},
+			wantType: message.InboundTypeButtonReply,
+			wantText: "Sim",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.ButtonReply == nil || inbound.ButtonReply.ID != "yes" {
+					t.Fatalf("ButtonReply = %+v", inbound.ButtonReply)
+				}
+			},
+		},
+		{
+			name: "list reply",
+			msg: &waE2E.Message{ListResponseMessage: &waE2E.ListResponseMessage{
+				Title:             proto.String("Plano B"),
+				SingleSelectReply: &waE2E.ListResponseMessage_SingleSelectReply{SelectedRowID: proto.String("row-b")},
+			}},
+			wantType: message.InboundTypeListReply,
+			wantText: "Plano B",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if inbound.ListReply == nil || inbound.ListReply.RowID != "row-b" {
+					t.Fatalf("ListReply = %+v", inbound.ListReply)
+				}
+			},
+		},
+		{
+			name:     "ephemeral text",
+			msg:      &waE2E.Message{EphemeralMessage: &waE2E.FutureProofMessage{Message: &waE2E.Message{Conversation: proto.String("Some")}}},
+			wantType: message.InboundTypeText,
+			wantText: "Some",
+			check: func(t *testing.T, inbound *message.InboundMessage) {
+				if !inbound.Ephemeral {
+					t.Fatal("Ephemeral = false for an ephemeral wrapper")
+				}
+			},
+		},
+		{
+			name:     "empty message",
+			msg:      &waE2E.Message{},
+			wantType: message.InboundTypeUnknown,
+		},
+		{
+			name:     "nil message",
+			wantType: message.InboundTypeUnknown,
+		},
+	}
+
+	chat := types.NewJID("5511999999999", types.DefaultUserServer)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectMessageType(tt.msg); got != tt.wantType {
+				t.Fatalf("DetectMessageType() = %q, want %q", got, tt.wantType)
+			}
+
+			inbound := NormalizeMessage(&events.Message{
+				Info:    types.MessageInfo{MessageSource: types.MessageSource{Chat: chat, Sender: chat}, ID: "MSG1"},
+				Message: tt.msg,
+			})
+			if inbound.Type != tt.wantType {
+				t.Fatalf("NormalizeMessage().Type = %q, want %q", inbound.Type, tt.wantType)
+			}
+			if inbound.Text != tt.wantText {
+				t.Fatalf("NormalizeMessage().Text = %q, want %q", inbound.Text, tt.wantText)
+			}
+			if inbound.ID != "MSG1" || inbound.Chat != chat.String() || inbound.Version != message.InboundMessageVersion {
+				t.Fatalf("NormalizeMessage() envelope = %q %q v%d", inbound.ID, inbound.Chat, inbound.Version)
+			}
+			if tt.check != nil {
+				tt.check(t, inbound)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+
+	"zmeow/internal/domain/message"
+	"zmeow/pkg/logger"
+)
+
+// ReceiptStatus converte o tipo de recibo do WhatsApp no status de entrega correspondente.
+// Recibos que não alteram o status de mensagens enviadas (read-self, sender, etc.) retornam false.
+func ReceiptStatus(receiptType types.ReceiptType) (message.Status, bool) {
+	switch receiptType {
+	case types.ReceiptTypeDelivered:
+		return message.StatusDelivered, true
+	case types.ReceiptTypeRead:
+		return message.StatusRead, true
+	case types.ReceiptTypePlayed:
+		return message.StatusPlayed, true
+	case types.ReceiptTypeServerError:
+		return message.StatusServerError, true
+	case types.ReceiptTypeRetry:
+		return message.StatusRetry, true
+	}
+	return "", false
+}
+
+// MessageStatusTracker aplica os recibos do WhatsApp ao status das mensagens enviadas
+type MessageStatusTracker struct {
+	repo   message.MessageRepository
+	logger logger.Logger
+}
+
+// NewMessageStatusTracker cria uma nova instância do MessageStatusTracker
+func NewMessageStatusTracker(repo message.MessageRepository, log logger.Logger) *MessageStatusTracker {
+	return &MessageStatusTracker{
+		repo:   repo,
+		logger: log.WithComponent("message-status"),
+	}
+}
+
+// ApplyReceipt atualiza o status por destinatário e o status agregado das mensagens do recibo,
+// retornando apenas as mudanças efetivas. Mensagens fora do histórico são ignoradas.
+func (t *MessageStatusTracker) ApplyReceipt(ctx context.Context, sessionID uuid.UUID, evt *events.Receipt) []message.StatusChange {
+	if t.repo == nil || evt.IsFromMe {
+		return nil
+	}
+
+	status, ok := ReceiptStatus(evt.Type)
+	if !ok {
+		return nil
+	}
+
+	recipient := evt.Sender.ToNonAD().String()
+	var changes []message.StatusChange
+
+	for _, messageID := range evt.MessageIDs {
+		change, err := t.applyStatus(ctx, sessionID, messageID, recipient, status, evt)
+		if err != nil {
+			if !errors.Is(err, message.ErrMessageNotFound) {
+				t.logger.WithError(err).WithFields(map[string]interface{}{
+					"sessionId": sessionID,
+					"messageId": messageID,
+					"status":    status,
+				}).Error().Msg("Failed to apply message receipt")
+			}
+			continue
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+
+	return changes
+}
+
+// applyStatus aplica o status a uma única mensagem; retorna nil quando nada mudou
+func (t *MessageStatusTracker) applyStatus(ctx context.Context, sessionID uuid.UUID, messageID, recipient string, status message.Status, evt *events.Receipt) (*message.StatusChange, error) {
+	msg, err := t.repo.GetByMessageID(ctx, sessionID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Direction != message.DirectionOutbound {
+		return nil, nil
+	}
+
+	var previous message.Status
+	receipt, err := t.repo.GetReceipt(ctx, sessionID, messageID, recipient)
+	switch {
+	case err == nil:
+		previous = receipt.Status
+		if !previous.CanTransitionTo(status) {
+			return nil, nil
+		}
+	case errors.Is(err, message.ErrReceiptNotFound):
+		receipt = &message.MessageReceipt{
+			SessionID:    sessionID,
+			MessageID:    messageID,
+			RecipientJID: recipient,
+		}
+	default:
+		return nil, err
+	}
+
+	receipt.Status = status
+	receipt.UpdatedAt = evt.Timestamp
+	if err := t.repo.SaveReceipt(ctx, receipt); err != nil {
+		return nil, err
+	}
+
+	// O status agregado reflete o recibo mais avançado entre os destinatários
+	messageStatus := msg.Status
+	if messageStatus.CanTransitionTo(status) {
+		if err := t.repo.UpdateStatus(ctx, sessionID, messageID, status, evt.Timestamp); err != nil {
+			return nil, err
+		}
+		messageStatus = status
+	}
+
+	return &message.StatusChange{
+		MessageID:      messageID,
+		Chat:           msg.ChatJID,
+		Recipient:      recipient,
+		Status:         status,
+		PreviousStatus: previous,
+		MessageStatus:  messageStatus,
+		Timestamp:      evt.Timestamp,
+	}, nil
+}
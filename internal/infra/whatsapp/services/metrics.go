@@ -0,0 +1,117 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/whatsapp"
+)
+
+// sessionMetrics acumula os contadores de uma sessão
+type sessionMetrics struct {
+	messagesReceived int64
+	messagesSent     int64
+	errorCount       int64
+	lastActivity     time.Time
+	connectedAt      *time.Time
+}
+
+// EventMetrics implementa whatsapp.MetricsService em memória a partir dos eventos das sessões.
+// Os contadores são reiniciados quando o processo reinicia.
+type EventMetrics struct {
+	mutex     sync.RWMutex
+	sessions  map[uuid.UUID]*sessionMetrics
+	startedAt time.Time
+}
+
+// NewEventMetrics cria uma nova instância do EventMetrics
+func NewEventMetrics() *EventMetrics {
+	return &EventMetrics{
+		sessions:  make(map[uuid.UUID]*sessionMetrics),
+		startedAt: time.Now(),
+	}
+}
+
+// RecordEvent atualiza os contadores da sessão de acordo com o tipo do evento
+func (m *EventMetrics) RecordEvent(sessionID uuid.UUID, eventType string, data map[string]interface{}) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	stats, exists := m.sessions[sessionID]
+	if !exists {
+		stats = &sessionMetrics{}
+		m.sessions[sessionID] = stats
+	}
+
+	now := time.Now()
+	stats.lastActivity = now
+
+	switch whatsapp.EventType(eventType) {
+	case whatsapp.EventMessage:
+		if fromMe, _ := data["fromMe"].(bool); fromMe {
+			stats.messagesSent++
+		} else {
+			stats.messagesReceived++
+		}
+	case whatsapp.EventConnected:
+		stats.connectedAt = &now
+	case whatsapp.EventDisconnected, whatsapp.EventLoggedOut:
+		stats.connectedAt = nil
+	case whatsapp.EventError, whatsapp.EventPairError, whatsapp.EventStreamError,
+		whatsapp.EventUndecryptableMessage, whatsapp.EventTemporaryBan:
+		stats.errorCount++
+	}
+
+	return nil
+}
+
+// GetSessionStats retorna as estatísticas de uma sessão; sessões sem eventos retornam contadores zerados
+func (m *EventMetrics) GetSessionStats(sessionID uuid.UUID) (*whatsapp.SessionStats, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	result := &whatsapp.SessionStats{SessionID: sessionID}
+	stats, exists := m.sessions[sessionID]
+	if !exists {
+		return result, nil
+	}
+
+	result.MessagesReceived = stats.messagesReceived
+	result.MessagesSent = stats.messagesSent
+	result.ErrorCount = stats.errorCount
+	result.LastActivity = stats.lastActivity
+	if stats.connectedAt != nil {
+		result.ConnectionTime = int64(time.Since(*stats.connectedAt).Seconds())
+	}
+	return result, nil
+}
+
+// GetGlobalStats retorna as estatísticas agregadas de todas as sessões com eventos
+func (m *EventMetrics) GetGlobalStats() (*whatsapp.GlobalStats, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	result := &whatsapp.GlobalStats{
+		TotalSessions: int64(len(m.sessions)),
+		UptimeSeconds: int64(time.Since(m.startedAt).Seconds()),
+	}
+	for _, stats := range m.sessions {
+		if stats.connectedAt != nil {
+			result.ConnectedSessions++
+			result.ActiveSessions++
+		}
+		result.TotalMessages += stats.messagesReceived + stats.messagesSent
+		result.TotalErrors += stats.errorCount
+	}
+	return result, nil
+}
+
+// RemoveSession descarta os contadores de uma sessão removida
+func (m *EventMetrics) RemoveSession(sessionID uuid.UUID) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.sessions, sessionID)
+}
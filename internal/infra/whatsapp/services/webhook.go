@@ -4,14 +4,28 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
 	"zmeow/pkg/logger"
+	"zmeow/pkg/webhookverify"
+)
+
+const (
+	// HeaderWebhookDelivery identifica a entrega, permitindo deduplicação no receptor
+	HeaderWebhookDelivery = "X-Webhook-Delivery"
+	// HeaderWebhookEvent informa o tipo do evento entregue
+	HeaderWebhookEvent = "X-Webhook-Event"
 )
 
 // WebhookPayload representa o payload de um webhook
@@ -23,19 +37,14 @@ type WebhookPayload struct {
 }
 
 // WebhookConfig representa a configuração de webhook para uma sessão
-type WebhookConfig struct {
-	SessionID uuid.UUID `json:"sessionId"`
-	URL       string    `json:"url"`
-	Secret    string    `json:"secret,omitempty"`
-	Enabled   bool      `json:"enabled"`
-	Retries   int       `json:"retries"`
-	Timeout   int       `json:"timeout"` // em segundos
-}
+type WebhookConfig = whatsapp.WebhookConfig
 
 // WebhookServiceImpl implementa o serviço de webhooks
 type WebhookServiceImpl struct {
-	configs    map[uuid.UUID]*WebhookConfig
+	configs    map[uuid.UUID][]*WebhookConfig // webhooks de cada sessão, do mais antigo ao mais recente
 	httpClient *http.Client
+	security   whatsapp.SecurityService
+	dispatcher *webhookDispatcher
 	mutex      sync.RWMutex
 	logger     logger.Logger
 }
@@ -43,20 +52,53 @@ type WebhookServiceImpl struct {
 // NewWebhookService cria uma nova instância do WebhookService
 func NewWebhookService(log logger.Logger) *WebhookServiceImpl {
 	return &WebhookServiceImpl{
-		configs: make(map[uuid.UUID]*WebhookConfig),
+		configs: make(map[uuid.UUID][]*WebhookConfig),
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		logger: log.WithComponent("webhook-service"),
+		security: NewSecurityService(log),
+		logger:   log.WithComponent("webhook-service"),
 	}
 }
 
-// SetWebhookConfig configura webhook para uma sessão
+// NewWebhookServiceWithOutbox cria um WebhookService que persiste as entregas no outbox
+// e as envia por um pool de workers, sobrevivendo a reinícios do processo
+func NewWebhookServiceWithOutbox(log logger.Logger, repo webhook.DeliveryRepository, options WebhookOutboxOptions) *WebhookServiceImpl {
+	ws := NewWebhookService(log)
+	ws.dispatcher = newWebhookDispatcher(ws, repo, options, ws.logger)
+	return ws
+}
+
+// Start inicia os workers de entrega do outbox, quando configurado
+func (ws *WebhookServiceImpl) Start() {
+	if ws.dispatcher != nil {
+		ws.dispatcher.start()
+	}
+}
+
+// LoadConfigs carrega em memória as configurações persistidas, substituindo as atuais
+func (ws *WebhookServiceImpl) LoadConfigs(configs []*WebhookConfig) {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	ws.configs = make(map[uuid.UUID][]*WebhookConfig)
+	for _, config := range configs {
+		ws.configs[config.SessionID] = append(ws.configs[config.SessionID], config)
+	}
+
+	ws.logger.WithField("count", len(configs)).Info().Msg("Webhook configurations loaded")
+}
+
+// SetWebhookConfig cria ou atualiza um webhook da sessão
 func (ws *WebhookServiceImpl) SetWebhookConfig(config *WebhookConfig) error {
 	if config.SessionID == uuid.Nil {
 		return fmt.Errorf("session ID cannot be empty")
 	}
 
+	if config.ID == uuid.Nil {
+		return fmt.Errorf("webhook ID cannot be empty")
+	}
+
 	if config.URL == "" {
 		return fmt.Errorf("webhook URL cannot be empty")
 	}
@@ -72,10 +114,22 @@ func (ws *WebhookServiceImpl) SetWebhookConfig(config *WebhookConfig) error {
 	ws.mutex.Lock()
 	defer ws.mutex.Unlock()
 
-	ws.configs[config.SessionID] = config
+	configs := ws.configs[config.SessionID]
+	replaced := false
+	for i, existing := range configs {
+		if existing.ID == config.ID {
+			configs[i] = config
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		ws.configs[config.SessionID] = append(configs, config)
+	}
 
 	ws.logger.WithFields(map[string]interface{}{
 		"sessionId": config.SessionID,
+		"webhookId": config.ID,
 		"url":       config.URL,
 		"enabled":   config.Enabled,
 	}).Info().Msg("Webhook configuration updated")
@@ -83,14 +137,11 @@ func (ws *WebhookServiceImpl) SetWebhookConfig(config *WebhookConfig) error {
 	return nil
 }
 
-// GetWebhookConfig retorna a configuração de webhook de uma sessão
-func (ws *WebhookServiceImpl) GetWebhookConfig(sessionID uuid.UUID) (*WebhookConfig, error) {
-	ws.mutex.RLock()
-	defer ws.mutex.RUnlock()
-
-	config, exists := ws.configs[sessionID]
+// GetWebhookConfig retorna a configuração de um webhook da sessão
+func (ws *WebhookServiceImpl) GetWebhookConfig(sessionID, webhookID uuid.UUID) (*WebhookConfig, error) {
+	config, exists := ws.getConfig(sessionID, webhookID)
 	if !exists {
-		return nil, fmt.Errorf("webhook config not found for session %s", sessionID)
+		return nil, fmt.Errorf("webhook config %s not found for session %s", webhookID, sessionID)
 	}
 
 	// Retornar cópia
@@ -98,30 +149,49 @@ func (ws *WebhookServiceImpl) GetWebhookConfig(sessionID uuid.UUID) (*WebhookCon
 	return &configCopy, nil
 }
 
-// RemoveWebhookConfig remove a configuração de webhook de uma sessão
-func (ws *WebhookServiceImpl) RemoveWebhookConfig(sessionID uuid.UUID) error {
+// RemoveWebhookConfig remove um webhook da sessão
+func (ws *WebhookServiceImpl) RemoveWebhookConfig(sessionID, webhookID uuid.UUID) error {
 	ws.mutex.Lock()
 	defer ws.mutex.Unlock()
 
-	delete(ws.configs, sessionID)
+	configs := ws.configs[sessionID]
+	for i, config := range configs {
+		if config.ID == webhookID {
+			configs = append(configs[:i:i], configs[i+1:]...)
+			break
+		}
+	}
+	if len(configs) == 0 {
+		delete(ws.configs, sessionID)
+	} else {
+		ws.configs[sessionID] = configs
+	}
 
-	ws.logger.WithField("sessionId", sessionID).Info().Msg("Webhook configuration removed")
+	ws.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"webhookId": webhookID,
+	}).Info().Msg("Webhook configuration removed")
 	return nil
 }
 
-// SendWebhook envia um webhook para uma sessão
+// SendWebhook envia o evento a cada webhook habilitado da sessão que o assina
 func (ws *WebhookServiceImpl) SendWebhook(sessionID uuid.UUID, event string, data map[string]interface{}) error {
 	ws.mutex.RLock()
-	config, exists := ws.configs[sessionID]
+	var targets []*WebhookConfig
+	for _, config := range ws.configs[sessionID] {
+		if config.Enabled && config.Subscribes(event) {
+			targets = append(targets, config)
+		}
+	}
+	configured := len(ws.configs[sessionID]) > 0
 	ws.mutex.RUnlock()
 
-	if !exists {
+	if !configured {
 		ws.logger.WithField("sessionId", sessionID).Debug().Msg("No webhook configured for session")
 		return nil // Não é um erro se não há webhook configurado
 	}
 
-	if !config.Enabled {
-		ws.logger.WithField("sessionId", sessionID).Debug().Msg("Webhook disabled for session")
+	if len(targets) == 0 {
 		return nil
 	}
 
@@ -133,12 +203,58 @@ func (ws *WebhookServiceImpl) SendWebhook(sessionID uuid.UUID, event string, dat
 		Data:      data,
 	}
 
+	var errs []error
+	for _, config := range targets {
+		if err := ws.deliver(config, payload); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// deliver envia o payload a um webhook, pelo outbox quando configurado
+func (ws *WebhookServiceImpl) deliver(config *WebhookConfig, payload *WebhookPayload) error {
+	// Com outbox, a entrega é persistida e enviada pelos workers
+	if ws.dispatcher != nil {
+		jsonData, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal webhook payload: %w", err)
+		}
+		return ws.dispatcher.enqueue(config, payload, jsonData)
+	}
+
 	// Enviar webhook de forma assíncrona
 	go ws.sendWebhookAsync(config, payload)
 
 	return nil
 }
 
+// getConfig retorna a configuração atual de um webhook da sessão. A configuração retornada não é alterada depois:
+// as mudanças substituem a configuração por outra instância.
+func (ws *WebhookServiceImpl) getConfig(sessionID, webhookID uuid.UUID) (*WebhookConfig, bool) {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	for _, config := range ws.configs[sessionID] {
+		if config.ID == webhookID {
+			return config, true
+		}
+	}
+	return nil, false
+}
+
+// getPrimaryConfig retorna o webhook mais antigo da sessão, usado pelas entregas anteriores aos múltiplos webhooks
+func (ws *WebhookServiceImpl) getPrimaryConfig(sessionID uuid.UUID) (*WebhookConfig, bool) {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	if configs := ws.configs[sessionID]; len(configs) > 0 {
+		return configs[0], true
+	}
+	return nil, false
+}
+
 // sendWebhookAsync envia webhook de forma assíncrona com retry
 func (ws *WebhookServiceImpl) sendWebhookAsync(config *WebhookConfig, payload *WebhookPayload) {
 	var lastErr error
@@ -185,20 +301,33 @@ func (ws *WebhookServiceImpl) sendWebhookHTTP(config *WebhookConfig, payload *We
 		return fmt.Errorf("failed to marshal webhook payload: %w", err)
 	}
 
+	_, _, err = ws.postWebhook(config, jsonData, nil)
+	return err
+}
+
+// postWebhook envia o corpo assinado ao receptor e retorna o status e o corpo da resposta
+func (ws *WebhookServiceImpl) postWebhook(config *WebhookConfig, body []byte, headers map[string]string) (int, string, error) {
 	// Criar request
-	req, err := http.NewRequest("POST", config.URL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequest("POST", config.URL, bytes.NewBuffer(body))
 	if err != nil {
-		return fmt.Errorf("failed to create webhook request: %w", err)
+		return 0, "", fmt.Errorf("failed to create webhook request: %w", err)
 	}
 
-	// Configurar headers
+	// Configurar headers; os estáticos do webhook vêm antes para não sobrescrever os do envio
+	for key, value := range config.Headers {
+		req.Header.Set(key, value)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "ZMeow-Webhook/1.0")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 
-	// Adicionar assinatura se secret estiver configurado
-	if config.Secret != "" {
-		signature := ws.generateSignature(jsonData, config.Secret)
-		req.Header.Set("X-Webhook-Signature", signature)
+	// Assinar a entrega sobre "timestamp.corpo" com os segredos válidos
+	timestamp := time.Now().Unix()
+	req.Header.Set(webhookverify.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if signature := ws.generateSignature(config, timestamp, body); signature != "" {
+		req.Header.Set(webhookverify.HeaderSignature, signature)
 	}
 
 	// Configurar timeout específico
@@ -209,33 +338,55 @@ func (ws *WebhookServiceImpl) sendWebhookHTTP(config *WebhookConfig, payload *We
 	// Enviar request
 	resp, err := ws.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send webhook request: %w", err)
+		return 0, "", fmt.Errorf("failed to send webhook request: %w", err)
 	}
 	defer resp.Body.Close()
 
+	// Guardar o início da resposta para inspeção
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
 	// Verificar status code
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("webhook returned non-success status: %d", resp.StatusCode)
+		return resp.StatusCode, string(respBody), fmt.Errorf("webhook returned non-success status: %d", resp.StatusCode)
 	}
 
-	return nil
+	return resp.StatusCode, string(respBody), nil
 }
 
-// generateSignature gera assinatura HMAC para o webhook
-func (ws *WebhookServiceImpl) generateSignature(_ []byte, _ string) string {
-	// TODO: Implementar assinatura HMAC-SHA256
-	// Por enquanto, retorna um placeholder
-	return "sha256=placeholder"
+// generateSignature gera a assinatura HMAC-SHA256 da entrega.
+// Durante uma rotação, inclui uma assinatura para cada segredo ainda válido, separadas por vírgula.
+func (ws *WebhookServiceImpl) generateSignature(config *WebhookConfig, timestamp int64, body []byte) string {
+	secrets := config.SigningSecrets(time.Unix(timestamp, 0))
+	if len(secrets) == 0 {
+		return ""
+	}
+
+	signedPayload := webhookverify.SignedPayload(timestamp, body)
+	signatures := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		signatures = append(signatures, ws.security.GenerateSignature(signedPayload, secret))
+	}
+
+	return strings.Join(signatures, ", ")
 }
 
-// SendTestWebhook envia um webhook de teste
-func (ws *WebhookServiceImpl) SendTestWebhook(sessionID uuid.UUID) error {
-	testData := map[string]interface{}{
-		"message": "This is a test webhook",
-		"test":    true,
+// SendTestWebhook envia um webhook de teste, independente dos eventos assinados pelo webhook
+func (ws *WebhookServiceImpl) SendTestWebhook(sessionID, webhookID uuid.UUID) error {
+	config, exists := ws.getConfig(sessionID, webhookID)
+	if !exists {
+		return fmt.Errorf("webhook config %s not found for session %s", webhookID, sessionID)
 	}
 
-	return ws.SendWebhook(sessionID, "test", testData)
+	return ws.deliver(config, &WebhookPayload{
+		SessionID: sessionID,
+		Event:     "test",
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"message":   "This is a test webhook",
+			"test":      true,
+			"webhookId": webhookID,
+		},
+	})
 }
 
 // GetWebhookStats retorna estatísticas de webhooks
@@ -244,13 +395,17 @@ func (ws *WebhookServiceImpl) GetWebhookStats() map[string]interface{} {
 	defer ws.mutex.RUnlock()
 
 	stats := map[string]interface{}{
-		"totalConfigs":   len(ws.configs),
+		"sessions":       len(ws.configs),
+		"totalConfigs":   0,
 		"enabledConfigs": 0,
 	}
 
-	for _, config := range ws.configs {
-		if config.Enabled {
-			stats["enabledConfigs"] = stats["enabledConfigs"].(int) + 1
+	for _, configs := range ws.configs {
+		for _, config := range configs {
+			stats["totalConfigs"] = stats["totalConfigs"].(int) + 1
+			if config.Enabled {
+				stats["enabledConfigs"] = stats["enabledConfigs"].(int) + 1
+			}
 		}
 	}
 
@@ -297,45 +452,55 @@ func (ws *WebhookServiceImpl) ValidateWebhookURL(url string) error {
 	return nil
 }
 
-// EnableWebhook habilita webhook para uma sessão
-func (ws *WebhookServiceImpl) EnableWebhook(sessionID uuid.UUID) error {
-	ws.mutex.Lock()
-	defer ws.mutex.Unlock()
-
-	config, exists := ws.configs[sessionID]
-	if !exists {
-		return fmt.Errorf("webhook config not found for session %s", sessionID)
-	}
-
-	config.Enabled = true
+// EnableWebhook habilita um webhook da sessão
+func (ws *WebhookServiceImpl) EnableWebhook(sessionID, webhookID uuid.UUID) error {
+	return ws.setEnabled(sessionID, webhookID, true)
+}
 
-	ws.logger.WithField("sessionId", sessionID).Info().Msg("Webhook enabled")
-	return nil
+// DisableWebhook desabilita um webhook da sessão
+func (ws *WebhookServiceImpl) DisableWebhook(sessionID, webhookID uuid.UUID) error {
+	return ws.setEnabled(sessionID, webhookID, false)
 }
 
-// DisableWebhook desabilita webhook para uma sessão
-func (ws *WebhookServiceImpl) DisableWebhook(sessionID uuid.UUID) error {
+// setEnabled altera o estado de um webhook em memória. A configuração é trocada por uma cópia alterada, como em
+// SetWebhookConfig, porque as entregas em andamento leem a configuração anterior fora do lock.
+func (ws *WebhookServiceImpl) setEnabled(sessionID, webhookID uuid.UUID, enabled bool) error {
 	ws.mutex.Lock()
 	defer ws.mutex.Unlock()
 
-	config, exists := ws.configs[sessionID]
-	if !exists {
-		return fmt.Errorf("webhook config not found for session %s", sessionID)
-	}
+	for i, config := range ws.configs[sessionID] {
+		if config.ID == webhookID {
+			updated := *config
+			updated.Enabled = enabled
+			ws.configs[sessionID][i] = &updated
 
-	config.Enabled = false
+			ws.logger.WithFields(map[string]interface{}{
+				"sessionId": sessionID,
+				"webhookId": webhookID,
+				"enabled":   enabled,
+			}).Info().Msg("Webhook state changed")
+			return nil
+		}
+	}
 
-	ws.logger.WithField("sessionId", sessionID).Info().Msg("Webhook disabled")
-	return nil
+	return fmt.Errorf("webhook config %s not found for session %s", webhookID, sessionID)
 }
 
 // Close encerra o WebhookService
 func (ws *WebhookServiceImpl) Close() {
+	// Parar os workers antes de limpar as configurações usadas nas entregas
+	if ws.dispatcher != nil {
+		ws.dispatcher.shutdown()
+	}
+
 	ws.mutex.Lock()
 	defer ws.mutex.Unlock()
 
-	count := len(ws.configs)
-	ws.configs = make(map[uuid.UUID]*WebhookConfig)
+	count := 0
+	for _, configs := range ws.configs {
+		count += len(configs)
+	}
+	ws.configs = make(map[uuid.UUID][]*WebhookConfig)
 
 	ws.logger.WithField("clearedCount", count).Info().Msg("WebhookService closed")
 }
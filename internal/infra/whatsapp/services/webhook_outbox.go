@@ -0,0 +1,286 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// WebhookOutboxOptions define os parâmetros dos workers de entrega do outbox
+type WebhookOutboxOptions struct {
+	Workers      int
+	PollInterval time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	BatchSize    int
+}
+
+// applyDefaults preenche as opções não informadas com valores padrão
+func (o *WebhookOutboxOptions) applyDefaults() {
+	if o.Workers <= 0 {
+		o.Workers = 4
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = time.Second
+	}
+	if o.BaseBackoff <= 0 {
+		o.BaseBackoff = 2 * time.Second
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = 10 * time.Minute
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = o.Workers * 4
+	}
+}
+
+// webhookDispatcher distribui as entregas pendentes do outbox entre um pool de workers.
+// Apenas a entrega mais antiga de cada webhook é despachada por vez, preservando a ordem por webhook
+// sem que um webhook com falhas atrase os demais da sessão. O controle de entregas em andamento fica
+// em memória, então apenas uma instância da API deve consumir o outbox.
+type webhookDispatcher struct {
+	service  *WebhookServiceImpl
+	repo     webhook.DeliveryRepository
+	options  WebhookOutboxOptions
+	jobs     chan *webhook.Delivery
+	wake     chan struct{}
+	stop     chan struct{}
+	inFlight map[uuid.UUID]struct{}
+	mutex    sync.Mutex
+	wg       sync.WaitGroup
+	once     sync.Once
+	logger   logger.Logger
+}
+
+// newWebhookDispatcher cria um novo dispatcher do outbox
+func newWebhookDispatcher(service *WebhookServiceImpl, repo webhook.DeliveryRepository, options WebhookOutboxOptions, log logger.Logger) *webhookDispatcher {
+	options.applyDefaults()
+	return &webhookDispatcher{
+		service:  service,
+		repo:     repo,
+		options:  options,
+		jobs:     make(chan *webhook.Delivery, options.Workers),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		inFlight: make(map[uuid.UUID]struct{}),
+		logger:   log,
+	}
+}
+
+// start inicia o loop de despacho e os workers
+func (d *webhookDispatcher) start() {
+	for i := 0; i < d.options.Workers; i++ {
+		d.wg.Add(1)
+		go d.worker()
+	}
+
+	d.wg.Add(1)
+	go d.loop()
+
+	d.logger.WithFields(map[string]interface{}{
+		"workers":      d.options.Workers,
+		"pollInterval": d.options.PollInterval.String(),
+	}).Info().Msg("Webhook outbox dispatcher started")
+}
+
+// shutdown encerra o dispatcher aguardando as entregas em andamento
+func (d *webhookDispatcher) shutdown() {
+	d.once.Do(func() {
+		close(d.stop)
+		d.wg.Wait()
+		d.logger.Info().Msg("Webhook outbox dispatcher stopped")
+	})
+}
+
+// notify acorda o loop de despacho sem bloquear
+func (d *webhookDispatcher) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// loop consulta periodicamente o outbox e despacha as entregas vencidas
+func (d *webhookDispatcher) loop() {
+	defer d.wg.Done()
+	defer close(d.jobs)
+
+	ticker := time.NewTicker(d.options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+
+		d.dispatchDue()
+	}
+}
+
+// dispatchDue envia aos workers a próxima entrega de cada webhook que não tenha outra em andamento
+func (d *webhookDispatcher) dispatchDue() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	deliveries, err := d.repo.ListDueHeads(ctx, time.Now(), d.options.BatchSize)
+	if err != nil {
+		d.logger.WithError(err).Error().Msg("Failed to load pending webhook deliveries")
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if !d.acquire(orderingKey(delivery)) {
+			continue
+		}
+
+		select {
+		case d.jobs <- delivery:
+		case <-d.stop:
+			d.release(orderingKey(delivery))
+			return
+		}
+	}
+}
+
+// orderingKey identifica a fila ordenada da entrega: o webhook, ou a sessão nas entregas antigas sem webhook
+func orderingKey(delivery *webhook.Delivery) uuid.UUID {
+	if delivery.WebhookID != uuid.Nil {
+		return delivery.WebhookID
+	}
+	return delivery.SessionID
+}
+
+// acquire marca a fila como em andamento, retornando false se já houver uma entrega sendo processada
+func (d *webhookDispatcher) acquire(key uuid.UUID) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if _, busy := d.inFlight[key]; busy {
+		return false
+	}
+	d.inFlight[key] = struct{}{}
+	return true
+}
+
+// release libera a fila para a próxima entrega
+func (d *webhookDispatcher) release(key uuid.UUID) {
+	d.mutex.Lock()
+	delete(d.inFlight, key)
+	d.mutex.Unlock()
+}
+
+// worker processa entregas até o canal de jobs ser fechado
+func (d *webhookDispatcher) worker() {
+	defer d.wg.Done()
+
+	for delivery := range d.jobs {
+		d.process(delivery)
+		d.release(orderingKey(delivery))
+		d.notify()
+	}
+}
+
+// process executa uma tentativa de entrega e persiste o resultado
+func (d *webhookDispatcher) process(delivery *webhook.Delivery) {
+	log := d.logger.WithFields(map[string]interface{}{
+		"deliveryId": delivery.ID,
+		"sessionId":  delivery.SessionID,
+		"webhookId":  delivery.WebhookID,
+		"event":      delivery.Event,
+		"attempt":    delivery.Attempts + 1,
+	})
+
+	var config *WebhookConfig
+	var exists bool
+	if delivery.WebhookID != uuid.Nil {
+		config, exists = d.service.getConfig(delivery.SessionID, delivery.WebhookID)
+	} else {
+		config, exists = d.service.getPrimaryConfig(delivery.SessionID)
+	}
+
+	switch {
+	case !exists:
+		delivery.MarkDead("webhook not configured for session")
+	case !config.Enabled:
+		delivery.MarkDead("webhook disabled")
+	default:
+		started := time.Now()
+		statusCode, responseBody, err := d.service.postWebhook(config, delivery.Payload, map[string]string{
+			HeaderWebhookDelivery: delivery.ID.String(),
+			HeaderWebhookEvent:    delivery.Event,
+		})
+		delivery.RecordAttempt(config.URL, statusCode, responseBody, err, time.Since(started))
+
+		if err == nil {
+			delivery.MarkDelivered()
+			log.WithField("statusCode", statusCode).Debug().Msg("Webhook delivered")
+			break
+		}
+
+		delivery.ScheduleRetry(d.backoff(delivery.Attempts))
+		if delivery.Status == webhook.DeliveryStatusDead {
+			log.WithError(err).Error().Msg("Webhook delivery moved to dead-letter after all retries")
+		} else {
+			log.WithError(err).WithField("nextAttemptAt", delivery.NextAttemptAt).Warn().Msg("Webhook delivery failed, retry scheduled")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := d.repo.Update(ctx, delivery); err != nil {
+		log.WithError(err).Error().Msg("Failed to persist webhook delivery result")
+	}
+}
+
+// backoff calcula o atraso exponencial com jitter para a próxima tentativa
+func (d *webhookDispatcher) backoff(attempts int) time.Duration {
+	delay := d.options.MaxBackoff
+	if attempts < 32 {
+		if exp := d.options.BaseBackoff << uint(attempts-1); exp > 0 && exp < delay {
+			delay = exp
+		}
+	}
+
+	// Jitter: metade fixa e metade aleatória, evitando rajadas sincronizadas
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// enqueue persiste uma nova entrega no outbox e acorda o dispatcher
+func (d *webhookDispatcher) enqueue(config *WebhookConfig, payload *WebhookPayload, body []byte) error {
+	maxAttempts := config.Retries
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	delivery := &webhook.Delivery{
+		SessionID:   config.SessionID,
+		WebhookID:   config.ID,
+		Event:       payload.Event,
+		Payload:     body,
+		Status:      webhook.DeliveryStatusPending,
+		MaxAttempts: maxAttempts,
+		URL:         config.URL,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := d.repo.Enqueue(ctx, delivery); err != nil {
+		return fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+
+	d.notify()
+	return nil
+}
@@ -0,0 +1,396 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// memoryDeliveryRepository é um outbox em memória com a mesma semântica de fila por webhook do repositório Postgres
+type memoryDeliveryRepository struct {
+	webhook.DeliveryRepository
+	mutex      sync.Mutex
+	deliveries []*webhook.Delivery
+	seq        int64
+}
+
+func (r *memoryDeliveryRepository) Enqueue(ctx context.Context, delivery *webhook.Delivery) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.seq++
+	delivery.ID = uuid.New()
+	delivery.Seq = r.seq
+	delivery.NextAttemptAt = time.Now()
+	stored := *delivery
+	r.deliveries = append(r.deliveries, &stored)
+	return nil
+}
+
+func (r *memoryDeliveryRepository) ListDueHeads(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	heads := make(map[uuid.UUID]*webhook.Delivery)
+	for _, delivery := range r.deliveries {
+		if delivery.Status != webhook.DeliveryStatusPending {
+			continue
+		}
+		key := orderingKey(delivery)
+		if head, ok := heads[key]; !ok || delivery.Seq < head.Seq {
+			heads[key] = delivery
+		}
+	}
+
+	var due []*webhook.Delivery
+	for _, head := range heads {
+		if !head.NextAttemptAt.After(now) {
+			copied := *head
+			due = append(due, &copied)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(due[j].NextAttemptAt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (r *memoryDeliveryRepository) Update(ctx context.Context, delivery *webhook.Delivery) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, stored := range r.deliveries {
+		if stored.ID == delivery.ID {
+			copied := *delivery
+			r.deliveries[i] = &copied
+		}
+	}
+	return nil
+}
+
+func (r *memoryDeliveryRepository) Requeue(ctx context.Context, sessionID uuid.UUID, ids []uuid.UUID, status webhook.DeliveryStatus) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	requeued := 0
+	for _, delivery := range r.deliveries {
+		if delivery.SessionID != sessionID || delivery.Status == webhook.DeliveryStatusPending {
+			continue
+		}
+		if status != "" && delivery.Status != status {
+			continue
+		}
+		if len(ids) > 0 && !containsID(ids, delivery.ID) {
+			continue
+		}
+		delivery.Status = webhook.DeliveryStatusPending
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = time.Now()
+		delivery.DeliveredAt = nil
+		requeued++
+	}
+	return requeued, nil
+}
+
+// get retorna uma cópia do estado persistido da entrega
+func (r *memoryDeliveryRepository) get(id uuid.UUID) webhook.Delivery {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, delivery := range r.deliveries {
+		if delivery.ID == id {
+			return *delivery
+		}
+	}
+	return webhook.Delivery{}
+}
+
+// makeDue antecipa a próxima tentativa da entrega para agora
+func (r *memoryDeliveryRepository) makeDue(id uuid.UUID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, delivery := range r.deliveries {
+		if delivery.ID == id {
+			delivery.NextAttemptAt = time.Now()
+		}
+	}
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// recordingReceiver registra a ordem das entregas recebidas e responde com o status configurado
+type recordingReceiver struct {
+	mutex    sync.Mutex
+	status   int
+	received []string
+}
+
+func (rr *recordingReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rr.mutex.Lock()
+	rr.received = append(rr.received, r.Header.Get(HeaderWebhookDelivery))
+	status := rr.status
+	rr.mutex.Unlock()
+	w.WriteHeader(status)
+}
+
+func (rr *recordingReceiver) setStatus(status int) {
+	rr.mutex.Lock()
+	rr.status = status
+	rr.mutex.Unlock()
+}
+
+func (rr *recordingReceiver) deliveries() []string {
+	rr.mutex.Lock()
+	defer rr.mutex.Unlock()
+	return append([]string(nil), rr.received...)
+}
+
+// newTestOutboxService cria um WebhookService com outbox em memória e um webhook habilitado por receptor
+func newTestOutboxService(t *testing.T, options WebhookOutboxOptions, receivers ...http.Handler) (*WebhookServiceImpl, *memoryDeliveryRepository, uuid.UUID, []*WebhookConfig) {
+	t.Helper()
+
+	nop := zerolog.Nop()
+	repo := &memoryDeliveryRepository{}
+	ws := NewWebhookServiceWithOutbox(logger.NewZerologLogger(&nop), repo, options)
+	sessionID := uuid.New()
+
+	var configs []*WebhookConfig
+	for _, receiver := range receivers {
+		server := httptest.NewServer(receiver)
+		t.Cleanup(server.Close)
+
+		config := &WebhookConfig{ID: uuid.New(), SessionID: sessionID, URL: server.URL, Enabled: true, Retries: 3, Timeout: 5}
+		if err := ws.SetWebhookConfig(config); err != nil {
+			t.Fatalf("SetWebhookConfig() error = %v", err)
+		}
+		configs = append(configs, config)
+	}
+	return ws, repo, sessionID, configs
+}
+
+// enqueueEvent grava uma entrega do evento para o webhook e retorna seu ID
+func enqueueEvent(t *testing.T, ws *WebhookServiceImpl, config *WebhookConfig, event string) uuid.UUID {
+	t.Helper()
+
+	payload := &WebhookPayload{SessionID: config.SessionID, Event: event, Timestamp: time.Now()}
+	if err := ws.dispatcher.enqueue(config, payload, []byte(`{"event":"`+event+`"}`)); err != nil {
+		t.Fatalf("enqueue() error = %v", err)
+	}
+
+	repo := ws.dispatcher.repo.(*memoryDeliveryRepository)
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+	return repo.deliveries[len(repo.deliveries)-1].ID
+}
+
+// waitFor aguarda a condição até o limite do teste
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestWebhookDispatcherBackoffBounds(t *testing.T) {
+	const base, max = time.Second, time.Minute
+	nop := zerolog.Nop()
+	d := newWebhookDispatcher(nil, nil, WebhookOutboxOptions{BaseBackoff: base, MaxBackoff: max}, logger.NewZerologLogger(&nop))
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 6, want: 32 * time.Second},
+		{attempts: 7, want: max},
+		{attempts: 31, want: max},
+		{attempts: 32, want: max},
+		{attempts: 100, want: max},
+	}
+
+	for _, tt := range tests {
+		// O atraso fica entre metade e o total do exponencial, limitado a MaxBackoff
+		seen := make(map[time.Duration]bool)
+		for i := 0; i < 200; i++ {
+			got := d.backoff(tt.attempts)
+			if got < tt.want/2 || got > tt.want {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempts, got, tt.want/2, tt.want)
+			}
+			seen[got] = true
+		}
+		if len(seen) < 2 {
+			t.Fatalf("backoff(%d) returned the same delay 200 times, want jitter", tt.attempts)
+		}
+	}
+}
+
+func TestWebhookDispatcherDeadLetterAfterMaxAttempts(t *testing.T) {
+	receiver := &recordingReceiver{status: http.StatusInternalServerError}
+	ws, repo, _, configs := newTestOutboxService(t, WebhookOutboxOptions{}, receiver)
+	id := enqueueEvent(t, ws, configs[0], "message")
+
+	// Processa cada tentativa ignorando o backoff; as tentativas seguem até MaxAttempts (Retries)
+	later := time.Now().Add(24 * time.Hour)
+	for attempt := 1; attempt <= configs[0].Retries; attempt++ {
+		heads, _ := repo.ListDueHeads(context.Background(), later, 10)
+		if len(heads) != 1 {
+			t.Fatalf("attempt %d: ListDueHeads() returned %d deliveries, want 1", attempt, len(heads))
+		}
+		ws.dispatcher.process(heads[0])
+
+		stored := repo.get(id)
+		if stored.Attempts != attempt {
+			t.Fatalf("attempt %d: Attempts = %d", attempt, stored.Attempts)
+		}
+		wantStatus := webhook.DeliveryStatusPending
+		if attempt == configs[0].Retries {
+			wantStatus = webhook.DeliveryStatusDead
+		}
+		if stored.Status != wantStatus {
+			t.Fatalf("attempt %d: Status = %q, want %q", attempt, stored.Status, wantStatus)
+		}
+		if wantStatus == webhook.DeliveryStatusPending && !stored.NextAttemptAt.After(time.Now()) {
+			t.Fatalf("attempt %d: retry was not scheduled in the future", attempt)
+		}
+	}
+
+	if heads, _ := repo.ListDueHeads(context.Background(), later, 10); len(heads) != 0 {
+		t.Fatalf("dead delivery is still due: %d deliveries", len(heads))
+	}
+	if got := len(receiver.deliveries()); got != configs[0].Retries {
+		t.Fatalf("receiver got %d requests, want %d", got, configs[0].Retries)
+	}
+}
+
+func TestWebhookDispatcherKeepsOrderWhileHeadRetries(t *testing.T) {
+	failing := &recordingReceiver{status: http.StatusServiceUnavailable}
+	healthy := &recordingReceiver{status: http.StatusOK}
+	ws, repo, _, configs := newTestOutboxService(t, WebhookOutboxOptions{
+		Workers:      4,
+		PollInterval: 10 * time.Millisecond,
+		BaseBackoff:  time.Hour,
+	}, failing, healthy)
+
+	first := enqueueEvent(t, ws, configs[0], "message")
+	second := enqueueEvent(t, ws, configs[0], "receipt")
+	other := enqueueEvent(t, ws, configs[1], "message")
+
+	ws.Start()
+	defer ws.Close()
+
+	// O outro webhook não espera pelo que está falhando
+	waitFor(t, "the healthy webhook delivery", func() bool {
+		return repo.get(other).Status == webhook.DeliveryStatusDelivered
+	})
+	waitFor(t, "the first attempt of the head", func() bool {
+		return repo.get(first).Attempts == 1
+	})
+
+	// Enquanto a primeira entrega aguarda o retry, a seguinte do mesmo webhook não é enviada
+	time.Sleep(100 * time.Millisecond)
+	if got := failing.deliveries(); len(got) != 1 || got[0] != first.String() {
+		t.Fatalf("failing webhook received %v, want only the head %s", got, first)
+	}
+	if stored := repo.get(second); stored.Attempts != 0 || stored.Status != webhook.DeliveryStatusPending {
+		t.Fatalf("later delivery = %q with %d attempts, want pending without attempts", stored.Status, stored.Attempts)
+	}
+
+	// Quando o receptor volta, o retry da primeira sai antes da seguinte
+	failing.setStatus(http.StatusOK)
+	repo.makeDue(first)
+	ws.dispatcher.notify()
+	waitFor(t, "the later delivery", func() bool {
+		return repo.get(second).Status == webhook.DeliveryStatusDelivered
+	})
+
+	want := []string{first.String(), first.String(), second.String()}
+	got := failing.deliveries()
+	if len(got) != len(want) {
+		t.Fatalf("failing webhook received %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("failing webhook received %v, want %v", got, want)
+		}
+	}
+}
+
+func TestWebhookDispatcherAcquireSerializesWebhook(t *testing.T) {
+	nop := zerolog.Nop()
+	d := newWebhookDispatcher(nil, nil, WebhookOutboxOptions{}, logger.NewZerologLogger(&nop))
+	webhookID := uuid.New()
+
+	if !d.acquire(webhookID) {
+		t.Fatal("acquire() on an idle webhook = false")
+	}
+	if d.acquire(webhookID) {
+		t.Fatal("acquire() while a delivery is in flight = true")
+	}
+	if !d.acquire(uuid.New()) {
+		t.Fatal("acquire() on another webhook = false")
+	}
+	d.release(webhookID)
+	if !d.acquire(webhookID) {
+		t.Fatal("acquire() after release = false")
+	}
+}
+
+func TestWebhookDispatcherDeliversRequeuedDelivery(t *testing.T) {
+	receiver := &recordingReceiver{status: http.StatusInternalServerError}
+	ws, repo, sessionID, configs := newTestOutboxService(t, WebhookOutboxOptions{}, receiver)
+	id := enqueueEvent(t, ws, configs[0], "message")
+
+	later := time.Now().Add(24 * time.Hour)
+	for i := 0; i < configs[0].Retries; i++ {
+		heads, _ := repo.ListDueHeads(context.Background(), later, 10)
+		ws.dispatcher.process(heads[0])
+	}
+	if status := repo.get(id).Status; status != webhook.DeliveryStatusDead {
+		t.Fatalf("Status = %q, want %q", status, webhook.DeliveryStatusDead)
+	}
+
+	// O replay devolve a entrega ao outbox com as tentativas zeradas
+	receiver.setStatus(http.StatusOK)
+	if requeued, _ := repo.Requeue(context.Background(), sessionID, []uuid.UUID{id}, webhook.DeliveryStatusDead); requeued != 1 {
+		t.Fatalf("Requeue() = %d, want 1", requeued)
+	}
+	heads, _ := repo.ListDueHeads(context.Background(), time.Now(), 10)
+	if len(heads) != 1 || heads[0].ID != id {
+		t.Fatalf("ListDueHeads() after requeue = %v, want the replayed delivery", heads)
+	}
+	ws.dispatcher.process(heads[0])
+
+	stored := repo.get(id)
+	if stored.Status != webhook.DeliveryStatusDelivered || stored.Attempts != 1 {
+		t.Fatalf("replayed delivery = %q with %d attempts, want delivered after 1 attempt", stored.Status, stored.Attempts)
+	}
+	if len(stored.AttemptLog) != configs[0].Retries+1 {
+		t.Fatalf("AttemptLog has %d entries, want %d", len(stored.AttemptLog), configs[0].Retries+1)
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/pkg/logger"
+)
+
+func newTestWebhookService() *WebhookServiceImpl {
+	nop := zerolog.Nop()
+	return NewWebhookService(logger.NewZerologLogger(&nop))
+}
+
+func TestWebhookServiceToggleReplacesConfig(t *testing.T) {
+	ws := newTestWebhookService()
+	sessionID, webhookID := uuid.New(), uuid.New()
+	if err := ws.SetWebhookConfig(&WebhookConfig{ID: webhookID, SessionID: sessionID, URL: "https://example.com/hook", Enabled: true}); err != nil {
+		t.Fatalf("SetWebhookConfig() error = %v", err)
+	}
+
+	// Uma entrega em andamento mantém a configuração que leu, sem ver a alteração pela metade
+	held, _ := ws.getConfig(sessionID, webhookID)
+	if err := ws.DisableWebhook(sessionID, webhookID); err != nil {
+		t.Fatalf("DisableWebhook() error = %v", err)
+	}
+	if !held.Enabled {
+		t.Fatal("DisableWebhook() changed the configuration held by an in-flight delivery")
+	}
+
+	current, err := ws.GetWebhookConfig(sessionID, webhookID)
+	if err != nil {
+		t.Fatalf("GetWebhookConfig() error = %v", err)
+	}
+	if current.Enabled {
+		t.Fatal("GetWebhookConfig().Enabled = true after DisableWebhook()")
+	}
+
+	if err := ws.EnableWebhook(sessionID, uuid.New()); err == nil {
+		t.Fatal("EnableWebhook() for an unknown webhook returned nil error")
+	}
+}
+
+func TestWebhookServiceToggleWhileDelivering(t *testing.T) {
+	ws := newTestWebhookService()
+	sessionID, webhookID := uuid.New(), uuid.New()
+	ws.SetWebhookConfig(&WebhookConfig{
+		ID:        webhookID,
+		SessionID: sessionID,
+		URL:       "https://example.com/hook",
+		Enabled:   true,
+		Headers:   map[string]string{"X-Tenant": "acme"},
+	})
+
+	// Com -race, uma alteração da configuração compartilhada é detectada aqui
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			if i%2 == 0 {
+				ws.DisableWebhook(sessionID, webhookID)
+			} else {
+				ws.EnableWebhook(sessionID, webhookID)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			if config, ok := ws.getConfig(sessionID, webhookID); ok {
+				_ = config.Enabled && config.URL != "" && config.Headers["X-Tenant"] != ""
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+func TestWebhookServiceSendWebhookFanOut(t *testing.T) {
+	nop := zerolog.Nop()
+	repo := &memoryDeliveryRepository{}
+	ws := NewWebhookServiceWithOutbox(logger.NewZerologLogger(&nop), repo, WebhookOutboxOptions{})
+	sessionID := uuid.New()
+
+	webhooks := map[string]*WebhookConfig{
+		"message":  {Events: []string{"message"}, Enabled: true},
+		"prefix":   {Events: []string{"message.*"}, Enabled: true},
+		"all":      {Enabled: true},
+		"presence": {Events: []string{"presence"}, Enabled: true},
+		"disabled": {Events: []string{"message"}, Enabled: false},
+	}
+	names := make(map[uuid.UUID]string)
+	for name, config := range webhooks {
+		config.ID = uuid.New()
+		config.SessionID = sessionID
+		config.URL = "https://example.com/" + name
+		if err := ws.SetWebhookConfig(config); err != nil {
+			t.Fatalf("SetWebhookConfig(%s) error = %v", name, err)
+		}
+		names[config.ID] = name
+	}
+
+	tests := []struct {
+		event string
+		want  []string
+	}{
+		{event: "message", want: []string{"all", "message", "prefix"}},
+		{event: "message.status", want: []string{"all", "prefix"}},
+		{event: "presence", want: []string{"all", "presence"}},
+		{event: "call.offer", want: []string{"all"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.event, func(t *testing.T) {
+			repo.mutex.Lock()
+			repo.deliveries = nil
+			repo.mutex.Unlock()
+
+			if err := ws.SendWebhook(sessionID, tt.event, map[string]interface{}{"id": "MSG1"}); err != nil {
+				t.Fatalf("SendWebhook() error = %v", err)
+			}
+
+			repo.mutex.Lock()
+			var got []string
+			for _, delivery := range repo.deliveries {
+				if delivery.Event != tt.event {
+					t.Fatalf("delivery event = %q, want %q", delivery.Event, tt.event)
+				}
+				got = append(got, names[delivery.WebhookID])
+			}
+			repo.mutex.Unlock()
+
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("SendWebhook(%q) delivered to %v, want %v", tt.event, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookServiceSendWebhookWithoutWebhooks(t *testing.T) {
+	nop := zerolog.Nop()
+	repo := &memoryDeliveryRepository{}
+	ws := NewWebhookServiceWithOutbox(logger.NewZerologLogger(&nop), repo, WebhookOutboxOptions{})
+
+	if err := ws.SendWebhook(uuid.New(), "message", nil); err != nil {
+		t.Fatalf("SendWebhook() without webhooks error = %v", err)
+	}
+	if len(repo.deliveries) != 0 {
+		t.Fatalf("SendWebhook() without webhooks enqueued %d deliveries", len(repo.deliveries))
+	}
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"zmeow/internal/domain/auth"
+	"zmeow/pkg/logger"
+)
+
+// AuthenticateUseCase implementa o caso de uso para validar uma chave de API
+type AuthenticateUseCase struct {
+	keyRepo  auth.APIKeyRepository
+	adminKey string
+	logger   logger.Logger
+}
+
+// NewAuthenticateUseCase cria uma nova instância do caso de uso.
+// adminKey é a chave de bootstrap definida via configuração (pode ser vazia).
+func NewAuthenticateUseCase(keyRepo auth.APIKeyRepository, adminKey string, logger logger.Logger) *AuthenticateUseCase {
+	return &AuthenticateUseCase{
+		keyRepo:  keyRepo,
+		adminKey: adminKey,
+		logger:   logger.WithComponent("authenticate-usecase"),
+	}
+}
+
+// Execute valida a chave informada e retorna a chave correspondente
+func (uc *AuthenticateUseCase) Execute(ctx context.Context, rawKey string) (*auth.APIKey, error) {
+	if rawKey == "" {
+		return nil, auth.ErrAPIKeyMissing
+	}
+
+	// Chave de bootstrap configurada via ambiente
+	if uc.adminKey != "" && subtle.ConstantTimeCompare([]byte(rawKey), []byte(uc.adminKey)) == 1 {
+		return &auth.APIKey{
+			Name:     "bootstrap",
+			Role:     auth.KeyRoleAdmin,
+			IsActive: true,
+		}, nil
+	}
+
+	key, err := uc.keyRepo.GetByHash(ctx, auth.HashKey(rawKey))
+	if err != nil {
+		if err == auth.ErrAPIKeyNotFound {
+			return nil, auth.ErrAPIKeyInvalid
+		}
+		uc.logger.WithError(err).Error().Msg("Failed to look up api key")
+		return nil, err
+	}
+
+	if key.IsRevoked() {
+		return nil, auth.ErrAPIKeyRevoked
+	}
+
+	// Registrar uso sem bloquear a requisição
+	go func() {
+		if err := uc.keyRepo.UpdateLastUsed(context.Background(), key.ID); err != nil {
+			uc.logger.WithError(err).Warn().Msg("Failed to update api key last used")
+		}
+	}()
+
+	return key, nil
+}
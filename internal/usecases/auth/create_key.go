@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/auth"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// CreateAPIKeyUseCase implementa o caso de uso para criar uma chave de API
+type CreateAPIKeyUseCase struct {
+	keyRepo     auth.APIKeyRepository
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewCreateAPIKeyUseCase cria uma nova instância do caso de uso
+func NewCreateAPIKeyUseCase(
+	keyRepo auth.APIKeyRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		keyRepo:     keyRepo,
+		sessionRepo: sessionRepo,
+		logger:      logger.WithComponent("create-apikey-usecase"),
+	}
+}
+
+// CreateAPIKeyRequest representa os dados necessários para criar uma chave
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,min=3,max=100" example:"integração-crm"`
+	Role      string     `json:"role" validate:"required,oneof=admin session" example:"session"`
+	SessionID *uuid.UUID `json:"sessionId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// APIKeyWithSecret representa uma chave recém-gerada; o segredo só é exibido uma vez
+type APIKeyWithSecret struct {
+	*auth.APIKey
+	Key string `json:"key" example:"zmk_3f2a..."`
+}
+
+// Execute executa o caso de uso para criar uma chave
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, req CreateAPIKeyRequest) (*APIKeyWithSecret, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, session.NewValidationError("name", req.Name, "name is required")
+	}
+
+	role := auth.KeyRole(req.Role)
+	switch role {
+	case auth.KeyRoleAdmin:
+		req.SessionID = nil
+	case auth.KeyRoleSession:
+		if req.SessionID == nil || *req.SessionID == uuid.Nil {
+			return nil, auth.ErrSessionIDRequired
+		}
+		if _, err := uc.sessionRepo.GetByID(ctx, *req.SessionID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, auth.ErrInvalidKeyRole
+	}
+
+	rawKey, err := auth.GenerateKey()
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to generate api key")
+		return nil, err
+	}
+
+	key := &auth.APIKey{
+		Name:      req.Name,
+		Role:      role,
+		SessionID: req.SessionID,
+		KeyHash:   auth.HashKey(rawKey),
+		KeyPrefix: auth.DisplayPrefix(rawKey),
+	}
+
+	if err := uc.keyRepo.Create(ctx, key); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to create api key in database")
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"keyId":     key.ID,
+		"role":      key.Role,
+		"sessionId": key.SessionID,
+	}).Info().Msg("API key created successfully")
+
+	return &APIKeyWithSecret{APIKey: key, Key: rawKey}, nil
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+
+	"zmeow/internal/domain/auth"
+	"zmeow/pkg/logger"
+)
+
+// ListAPIKeysUseCase implementa o caso de uso para listar chaves de API
+type ListAPIKeysUseCase struct {
+	keyRepo auth.APIKeyRepository
+	logger  logger.Logger
+}
+
+// NewListAPIKeysUseCase cria uma nova instância do caso de uso
+func NewListAPIKeysUseCase(keyRepo auth.APIKeyRepository, logger logger.Logger) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		keyRepo: keyRepo,
+		logger:  logger.WithComponent("list-apikeys-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para listar as chaves
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context) ([]*auth.APIKey, error) {
+	keys, err := uc.keyRepo.List(ctx)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list api keys")
+		return nil, err
+	}
+	return keys, nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/auth"
+	"zmeow/pkg/logger"
+)
+
+// RevokeAPIKeyUseCase implementa o caso de uso para revogar uma chave de API
+type RevokeAPIKeyUseCase struct {
+	keyRepo auth.APIKeyRepository
+	logger  logger.Logger
+}
+
+// NewRevokeAPIKeyUseCase cria uma nova instância do caso de uso
+func NewRevokeAPIKeyUseCase(keyRepo auth.APIKeyRepository, logger logger.Logger) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		keyRepo: keyRepo,
+		logger:  logger.WithComponent("revoke-apikey-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para revogar uma chave
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, keyID uuid.UUID) error {
+	key, err := uc.keyRepo.GetByID(ctx, keyID)
+	if err != nil {
+		return err
+	}
+
+	if key.IsRevoked() {
+		return nil
+	}
+
+	key.Revoke()
+	if err := uc.keyRepo.Update(ctx, key); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to revoke api key")
+		return err
+	}
+
+	uc.logger.WithField("keyId", key.ID).Info().Msg("API key revoked successfully")
+	return nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/auth"
+	"zmeow/pkg/logger"
+)
+
+// RotateAPIKeyUseCase implementa o caso de uso para rotacionar o segredo de uma chave
+type RotateAPIKeyUseCase struct {
+	keyRepo auth.APIKeyRepository
+	logger  logger.Logger
+}
+
+// NewRotateAPIKeyUseCase cria uma nova instância do caso de uso
+func NewRotateAPIKeyUseCase(keyRepo auth.APIKeyRepository, logger logger.Logger) *RotateAPIKeyUseCase {
+	return &RotateAPIKeyUseCase{
+		keyRepo: keyRepo,
+		logger:  logger.WithComponent("rotate-apikey-usecase"),
+	}
+}
+
+// Execute gera um novo segredo para a chave; o segredo anterior deixa de ser aceito imediatamente
+func (uc *RotateAPIKeyUseCase) Execute(ctx context.Context, keyID uuid.UUID) (*APIKeyWithSecret, error) {
+	key, err := uc.keyRepo.GetByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.IsRevoked() {
+		return nil, auth.ErrAPIKeyRevoked
+	}
+
+	rawKey, err := auth.GenerateKey()
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to generate api key")
+		return nil, err
+	}
+
+	now := time.Now()
+	key.KeyHash = auth.HashKey(rawKey)
+	key.KeyPrefix = auth.DisplayPrefix(rawKey)
+	key.RotatedAt = &now
+
+	if err := uc.keyRepo.Update(ctx, key); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to rotate api key")
+		return nil, err
+	}
+
+	uc.logger.WithField("keyId", key.ID).Info().Msg("API key rotated successfully")
+
+	return &APIKeyWithSecret{APIKey: key, Key: rawKey}, nil
+}
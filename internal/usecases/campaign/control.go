@@ -0,0 +1,65 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/campaign"
+	"zmeow/pkg/logger"
+)
+
+// ControlCampaignUseCase implementa os casos de uso para pausar, retomar e cancelar uma campanha
+type ControlCampaignUseCase struct {
+	campaignRepo campaign.Repository
+	runner       *Runner
+	logger       logger.Logger
+}
+
+// NewControlCampaignUseCase cria uma nova instância do caso de uso
+func NewControlCampaignUseCase(campaignRepo campaign.Repository, runner *Runner, logger logger.Logger) *ControlCampaignUseCase {
+	return &ControlCampaignUseCase{
+		campaignRepo: campaignRepo,
+		runner:       runner,
+		logger:       logger.WithComponent("control-campaign-usecase"),
+	}
+}
+
+// Pause suspende os envios de uma campanha em andamento; o envio em curso, se houver, é concluído
+func (uc *ControlCampaignUseCase) Pause(ctx context.Context, sessionID, campaignID uuid.UUID) (*campaign.Campaign, error) {
+	return uc.transition(ctx, sessionID, campaignID, []campaign.Status{campaign.StatusRunning}, campaign.StatusPaused)
+}
+
+// Resume retoma os envios de uma campanha pausada
+func (uc *ControlCampaignUseCase) Resume(ctx context.Context, sessionID, campaignID uuid.UUID) (*campaign.Campaign, error) {
+	c, err := uc.transition(ctx, sessionID, campaignID, []campaign.Status{campaign.StatusPaused}, campaign.StatusRunning)
+	if err != nil {
+		return nil, err
+	}
+	uc.runner.Notify()
+	return c, nil
+}
+
+// Cancel encerra uma campanha em andamento ou pausada, cancelando os destinatários ainda na fila
+func (uc *ControlCampaignUseCase) Cancel(ctx context.Context, sessionID, campaignID uuid.UUID) (*campaign.Campaign, error) {
+	return uc.transition(ctx, sessionID, campaignID, []campaign.Status{campaign.StatusRunning, campaign.StatusPaused}, campaign.StatusCanceled)
+}
+
+// transition aplica a mudança de status e registra o resultado
+func (uc *ControlCampaignUseCase) transition(ctx context.Context, sessionID, campaignID uuid.UUID, from []campaign.Status, to campaign.Status) (*campaign.Campaign, error) {
+	c, err := uc.campaignRepo.Transition(ctx, sessionID, campaignID, from, to)
+	if err != nil {
+		if err != campaign.ErrCampaignNotFound && err != campaign.ErrInvalidTransition {
+			uc.logger.WithError(err).Error().Msg("Failed to change campaign status")
+		}
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"campaignId": campaignID,
+		"status":     to,
+	}).Info().Msg("Campaign status changed")
+
+	return c, nil
+}
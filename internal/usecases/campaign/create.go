@@ -0,0 +1,159 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/campaign"
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/session"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/pkg/logger"
+)
+
+// CreateOptions define os limites aplicados às novas campanhas
+type CreateOptions struct {
+	// RatePerMinute é o ritmo das campanhas que não informam o próprio
+	RatePerMinute    int
+	MaxRatePerMinute int
+	MaxRecipients    int
+}
+
+// CreateCampaignUseCase implementa o caso de uso para criar uma campanha
+type CreateCampaignUseCase struct {
+	campaignRepo    campaign.Repository
+	sessionRepo     session.SessionRepository
+	dispatcher      *messageUseCases.SendDispatcher
+	runner          *Runner
+	numberValidator *messageUseCases.NumberValidator
+	options         CreateOptions
+	logger          logger.Logger
+}
+
+// NewCreateCampaignUseCase cria uma nova instância do caso de uso
+func NewCreateCampaignUseCase(
+	campaignRepo campaign.Repository,
+	sessionRepo session.SessionRepository,
+	dispatcher *messageUseCases.SendDispatcher,
+	runner *Runner,
+	options CreateOptions,
+	logger logger.Logger,
+) *CreateCampaignUseCase {
+	return &CreateCampaignUseCase{
+		campaignRepo:    campaignRepo,
+		sessionRepo:     sessionRepo,
+		dispatcher:      dispatcher,
+		runner:          runner,
+		numberValidator: messageUseCases.NewNumberValidator(),
+		options:         options,
+		logger:          logger.WithComponent("create-campaign-usecase"),
+	}
+}
+
+// MaxRecipients retorna o limite de destinatários por campanha
+func (uc *CreateCampaignUseCase) MaxRecipients() int {
+	return uc.options.MaxRecipients
+}
+
+// Execute valida o modelo e os destinatários e grava a campanha, que começa a ser enviada em seguida
+func (uc *CreateCampaignUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req campaign.CreateCampaignRequest) (*campaign.Campaign, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", outbound.ErrInvalidPayload)
+	}
+	if !req.Kind.IsValid() {
+		return nil, fmt.Errorf("%w: %s", outbound.ErrInvalidKind, req.Kind)
+	}
+
+	rate := req.RatePerMinute
+	if rate == 0 {
+		rate = uc.options.RatePerMinute
+	}
+	if rate < 1 || (uc.options.MaxRatePerMinute > 0 && rate > uc.options.MaxRatePerMinute) {
+		return nil, fmt.Errorf("%w: must be between 1 and %d", campaign.ErrInvalidRate, uc.options.MaxRatePerMinute)
+	}
+
+	variables, err := campaign.TemplateVariables(req.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := uc.buildRecipients(req.Recipients, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validar o modelo renderizado para o primeiro destinatário, como o endpoint de envio do tipo faria
+	payload, err := campaign.Render(req.Template, recipients[0].Number, recipients[0].Variables)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.dispatcher.ValidatePayload(req.Kind, payload); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	c := &campaign.Campaign{
+		SessionID:     sessionID,
+		Name:          name,
+		Kind:          req.Kind,
+		Template:      req.Template,
+		RatePerMinute: rate,
+	}
+	if err := uc.campaignRepo.Create(ctx, c, recipients); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to create campaign")
+		return nil, err
+	}
+
+	uc.runner.Notify()
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":     sessionID,
+		"campaignId":    c.ID,
+		"kind":          c.Kind,
+		"recipients":    c.Recipients,
+		"ratePerMinute": c.RatePerMinute,
+	}).Info().Msg("Campaign created")
+
+	return c, nil
+}
+
+// buildRecipients valida os destinatários, descarta os números repetidos e verifica as variáveis do modelo
+func (uc *CreateCampaignUseCase) buildRecipients(inputs []campaign.RecipientInput, variables []string) ([]*campaign.Recipient, error) {
+	if len(inputs) == 0 {
+		return nil, campaign.ErrNoRecipients
+	}
+	if uc.options.MaxRecipients > 0 && len(inputs) > uc.options.MaxRecipients {
+		return nil, fmt.Errorf("%w: limit is %d", campaign.ErrTooManyRecipients, uc.options.MaxRecipients)
+	}
+
+	seen := make(map[string]struct{}, len(inputs))
+	recipients := make([]*campaign.Recipient, 0, len(inputs))
+	for i, input := range inputs {
+		number := strings.TrimSpace(input.Number)
+		if !uc.numberValidator.IsValidNumber(number) {
+			return nil, fmt.Errorf("%w: recipient %d has invalid number %q", campaign.ErrInvalidRecipient, i+1, input.Number)
+		}
+		if missing := campaign.MissingVariables(variables, input.Variables); len(missing) > 0 {
+			return nil, fmt.Errorf("%w: recipient %d is missing %s", campaign.ErrMissingVariable, i+1, strings.Join(missing, ", "))
+		}
+
+		key := uc.numberValidator.NormalizeNumber(number)
+		if _, duplicated := seen[key]; duplicated {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		recipients = append(recipients, &campaign.Recipient{
+			Number:    number,
+			Variables: input.Variables,
+		})
+	}
+	return recipients, nil
+}
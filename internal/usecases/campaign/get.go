@@ -0,0 +1,89 @@
+package campaign
+
+import (
+	"context"
+	"math"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/campaign"
+	"zmeow/pkg/logger"
+)
+
+// GetCampaignUseCase implementa o caso de uso para consultar uma campanha
+type GetCampaignUseCase struct {
+	campaignRepo campaign.Repository
+	logger       logger.Logger
+}
+
+// NewGetCampaignUseCase cria uma nova instância do caso de uso
+func NewGetCampaignUseCase(campaignRepo campaign.Repository, logger logger.Logger) *GetCampaignUseCase {
+	return &GetCampaignUseCase{
+		campaignRepo: campaignRepo,
+		logger:       logger.WithComponent("get-campaign-usecase"),
+	}
+}
+
+// Execute retorna a campanha da sessão
+func (uc *GetCampaignUseCase) Execute(ctx context.Context, sessionID, campaignID uuid.UUID) (*campaign.Campaign, error) {
+	c, err := uc.campaignRepo.GetByID(ctx, sessionID, campaignID)
+	if err != nil {
+		if err != campaign.ErrCampaignNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get campaign")
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCampaignReportUseCase implementa o caso de uso para consultar o andamento de uma campanha
+type GetCampaignReportUseCase struct {
+	campaignRepo campaign.Repository
+	logger       logger.Logger
+}
+
+// NewGetCampaignReportUseCase cria uma nova instância do caso de uso
+func NewGetCampaignReportUseCase(campaignRepo campaign.Repository, logger logger.Logger) *GetCampaignReportUseCase {
+	return &GetCampaignReportUseCase{
+		campaignRepo: campaignRepo,
+		logger:       logger.WithComponent("campaign-report-usecase"),
+	}
+}
+
+// Execute retorna a campanha com a quantidade de destinatários por status e os percentuais de andamento
+func (uc *GetCampaignReportUseCase) Execute(ctx context.Context, sessionID, campaignID uuid.UUID) (*campaign.CampaignReport, error) {
+	c, err := uc.campaignRepo.GetByID(ctx, sessionID, campaignID)
+	if err != nil {
+		if err != campaign.ErrCampaignNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get campaign")
+		}
+		return nil, err
+	}
+
+	counts, err := uc.campaignRepo.CountRecipients(ctx, campaignID)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to count campaign recipients")
+		return nil, err
+	}
+
+	sent := counts[campaign.RecipientSent] + counts[campaign.RecipientDelivered] + counts[campaign.RecipientRead]
+	delivered := counts[campaign.RecipientDelivered] + counts[campaign.RecipientRead]
+	processed := sent + counts[campaign.RecipientFailed] + counts[campaign.RecipientCanceled]
+
+	return &campaign.CampaignReport{
+		Campaign:     c,
+		Counts:       counts,
+		Processed:    processed,
+		Progress:     percentage(processed, c.Recipients),
+		DeliveryRate: percentage(delivered, sent),
+		ReadRate:     percentage(counts[campaign.RecipientRead], sent),
+	}, nil
+}
+
+// percentage retorna part/total em percentual com uma casa decimal
+func percentage(part, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(part)*1000/float64(total)) / 10
+}
@@ -0,0 +1,138 @@
+package campaign
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/campaign"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+const (
+	defaultCampaignLimit  = 50
+	maxCampaignLimit      = 200
+	defaultRecipientLimit = 100
+	maxRecipientLimit     = 1000
+)
+
+// ListCampaignsUseCase implementa o caso de uso para listar as campanhas de uma sessão
+type ListCampaignsUseCase struct {
+	campaignRepo campaign.Repository
+	sessionRepo  session.SessionRepository
+	logger       logger.Logger
+}
+
+// NewListCampaignsUseCase cria uma nova instância do caso de uso
+func NewListCampaignsUseCase(
+	campaignRepo campaign.Repository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *ListCampaignsUseCase {
+	return &ListCampaignsUseCase{
+		campaignRepo: campaignRepo,
+		sessionRepo:  sessionRepo,
+		logger:       logger.WithComponent("list-campaigns-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para listar as campanhas, das mais recentes às mais antigas
+func (uc *ListCampaignsUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req campaign.ListCampaignsRequest) (*campaign.CampaignListResponse, error) {
+	status := campaign.Status(req.Status)
+	if status != "" && !status.IsValid() {
+		return nil, campaign.ErrInvalidStatus
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := pagination(req.Limit, req.Offset, defaultCampaignLimit, maxCampaignLimit)
+
+	campaigns, total, err := uc.campaignRepo.List(ctx, campaign.Filter{
+		SessionID: sessionID,
+		Status:    status,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list campaigns from database")
+		return nil, err
+	}
+
+	if campaigns == nil {
+		campaigns = []*campaign.Campaign{}
+	}
+
+	return &campaign.CampaignListResponse{
+		Campaigns: campaigns,
+		Total:     total,
+		Limit:     limit,
+		Offset:    offset,
+	}, nil
+}
+
+// ListRecipientsUseCase implementa o caso de uso para listar os destinatários de uma campanha
+type ListRecipientsUseCase struct {
+	campaignRepo campaign.Repository
+	logger       logger.Logger
+}
+
+// NewListRecipientsUseCase cria uma nova instância do caso de uso
+func NewListRecipientsUseCase(campaignRepo campaign.Repository, logger logger.Logger) *ListRecipientsUseCase {
+	return &ListRecipientsUseCase{
+		campaignRepo: campaignRepo,
+		logger:       logger.WithComponent("list-campaign-recipients-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para listar os destinatários na ordem da lista, opcionalmente por status
+func (uc *ListRecipientsUseCase) Execute(ctx context.Context, sessionID, campaignID uuid.UUID, req campaign.ListRecipientsRequest) (*campaign.RecipientListResponse, error) {
+	status := campaign.RecipientStatus(req.Status)
+	if status != "" && !status.IsValid() {
+		return nil, campaign.ErrInvalidStatus
+	}
+
+	if _, err := uc.campaignRepo.GetByID(ctx, sessionID, campaignID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := pagination(req.Limit, req.Offset, defaultRecipientLimit, maxRecipientLimit)
+
+	recipients, total, err := uc.campaignRepo.ListRecipients(ctx, campaign.RecipientFilter{
+		CampaignID: campaignID,
+		Status:     status,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list campaign recipients from database")
+		return nil, err
+	}
+
+	if recipients == nil {
+		recipients = []*campaign.Recipient{}
+	}
+
+	return &campaign.RecipientListResponse{
+		Recipients: recipients,
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+	}, nil
+}
+
+// pagination aplica o limite padrão e máximo da listagem
+func pagination(limit, offset, defaultLimit, maxLimit int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
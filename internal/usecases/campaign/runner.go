@@ -0,0 +1,230 @@
+package campaign
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/campaign"
+	"zmeow/internal/domain/whatsapp"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/internal/usecases/worker"
+	"zmeow/pkg/logger"
+)
+
+// RunnerOptions define o ritmo e as tentativas de envio das campanhas
+type RunnerOptions struct {
+	// Jitter é o atraso aleatório máximo somado ao intervalo entre envios
+	Jitter       time.Duration
+	MaxAttempts  int
+	PollInterval time.Duration
+}
+
+// applyDefaults preenche as opções não informadas com valores padrão
+func (o *RunnerOptions) applyDefaults() {
+	if o.Jitter < 0 {
+		o.Jitter = 0
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 2
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = 2 * time.Second
+	}
+}
+
+// Runner envia as campanhas em andamento com um worker por sessão. As campanhas de uma sessão são enviadas
+// da mais antiga à mais recente, no ritmo de cada uma; sessões desconectadas aguardam a reconexão.
+// O ritmo é aplicado pelo worker.Pacer compartilhado com a fila de envio e os agendamentos.
+type Runner struct {
+	repo            campaign.Repository
+	whatsappManager whatsapp.WhatsAppManager
+	dispatcher      *messageUseCases.SendDispatcher
+	pacer           *worker.Pacer
+	options         RunnerOptions
+	loop            *worker.Loop
+	logger          logger.Logger
+}
+
+// NewRunner cria uma nova instância do Runner
+func NewRunner(
+	repo campaign.Repository,
+	whatsappManager whatsapp.WhatsAppManager,
+	dispatcher *messageUseCases.SendDispatcher,
+	pacer *worker.Pacer,
+	options RunnerOptions,
+	log logger.Logger,
+) *Runner {
+	options.applyDefaults()
+	r := &Runner{
+		repo:            repo,
+		whatsappManager: whatsappManager,
+		dispatcher:      dispatcher,
+		pacer:           pacer,
+		options:         options,
+		logger:          log.WithComponent("campaign-runner"),
+	}
+	r.loop = worker.NewLoop(options.PollInterval, r.startActiveSessions)
+	return r
+}
+
+// Start marca como falhos os destinatários interrompidos na última execução e inicia o loop das campanhas
+func (r *Runner) Start() {
+	worker.FailInterrupted(r.repo.FailInterrupted, r.logger)
+	r.loop.Start()
+
+	r.logger.WithFields(map[string]interface{}{
+		"jitter":      r.options.Jitter.String(),
+		"maxAttempts": r.options.MaxAttempts,
+	}).Info().Msg("Campaign runner started")
+}
+
+// Stop encerra o runner aguardando os envios em andamento
+func (r *Runner) Stop() {
+	if r.loop.Stop() {
+		r.logger.Info().Msg("Campaign runner stopped")
+	}
+}
+
+// Notify acorda o loop do runner sem bloquear
+func (r *Runner) Notify() {
+	r.loop.Notify()
+}
+
+// startActiveSessions conclui as campanhas sem pendências e inicia um worker para cada sessão conectada
+// com campanhas em andamento e sem worker ativo
+func (r *Runner) startActiveSessions() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if count, err := r.repo.CompleteFinished(ctx); err != nil {
+		r.logger.WithError(err).Error().Msg("Failed to complete finished campaigns")
+	} else if count > 0 {
+		r.logger.WithField("count", count).Info().Msg("Campaigns completed")
+	}
+
+	sessionIDs, err := r.repo.ListActiveSessions(ctx)
+	if err != nil {
+		r.logger.WithError(err).Error().Msg("Failed to load sessions with running campaigns")
+		return
+	}
+
+	for _, sessionID := range sessionIDs {
+		if !r.whatsappManager.IsConnected(sessionID) {
+			continue
+		}
+
+		sessionID := sessionID
+		r.loop.GoSession(sessionID, func() { r.worker(sessionID) })
+	}
+}
+
+// worker envia para os destinatários na fila das campanhas da sessão, um por vez, até a fila esvaziar,
+// as campanhas serem pausadas ou a sessão desconectar
+func (r *Runner) worker(sessionID uuid.UUID) {
+	campaigns := make(map[uuid.UUID]*campaign.Campaign)
+	for {
+		if !r.whatsappManager.IsConnected(sessionID) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		recipient, err := r.repo.NextRecipient(ctx, sessionID)
+		cancel()
+		if err != nil {
+			r.logger.WithError(err).WithField("sessionId", sessionID).Error().Msg("Failed to load next campaign recipient")
+			return
+		}
+		if recipient == nil {
+			return
+		}
+
+		c, ok := campaigns[recipient.CampaignID]
+		if !ok {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			c, err = r.repo.GetByID(ctx, sessionID, recipient.CampaignID)
+			cancel()
+			if err != nil {
+				r.logger.WithError(err).WithField("campaignId", recipient.CampaignID).Error().Msg("Failed to load campaign")
+				return
+			}
+			campaigns[c.ID] = c
+		}
+
+		if !r.pacer.Wait(sessionID, worker.PerMinute(c.RatePerMinute, r.options.Jitter), r.loop.Stopping()) {
+			return
+		}
+
+		r.process(c, recipient)
+	}
+}
+
+// process envia a mensagem da campanha ao destinatário e persiste o resultado
+func (r *Runner) process(c *campaign.Campaign, recipient *campaign.Recipient) {
+	log := r.logger.WithFields(map[string]interface{}{
+		"campaignId":  c.ID,
+		"sessionId":   c.SessionID,
+		"recipientId": recipient.ID,
+		"attempt":     recipient.Attempts + 1,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	claimed, err := r.repo.ClaimRecipient(ctx, recipient)
+	cancel()
+	if err != nil {
+		log.WithError(err).Error().Msg("Failed to claim campaign recipient")
+		return
+	}
+	if !claimed {
+		// A campanha foi pausada ou cancelada depois da consulta
+		return
+	}
+
+	// Um modelo que não renderiza ou não passa nas validações do envio para este destinatário falha de vez,
+	// sem novas tentativas
+	payload, err := campaign.Render(c.Template, recipient.Number, recipient.Variables)
+	if err == nil {
+		err = r.dispatcher.ValidatePayload(c.Kind, payload)
+	}
+	if err != nil {
+		recipient.MarkFailed(err.Error())
+		log.WithError(err).Warn().Msg("Invalid campaign message")
+		r.persist(recipient, log)
+		return
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Minute)
+	response, err := r.dispatcher.Dispatch(ctx, c.SessionID, c.Kind, payload)
+	cancel()
+
+	r.pacer.Sent(c.SessionID)
+
+	switch {
+	case err == nil:
+		recipient.MarkSent(response.ID)
+		log.WithField("messageId", response.ID).Debug().Msg("Campaign message sent")
+	case messageUseCases.IsPermanentSendError(err):
+		recipient.MarkFailed(err.Error())
+		log.WithError(err).Warn().Msg("Campaign message rejected")
+	default:
+		if !r.whatsappManager.IsConnected(c.SessionID) {
+			// A sessão caiu durante o envio: a tentativa não conta e o destinatário aguarda a reconexão
+			recipient.Attempts--
+		}
+		recipient.Requeue(err.Error(), r.options.MaxAttempts)
+		log.WithError(err).Warn().Msg("Campaign message failed")
+	}
+
+	r.persist(recipient, log)
+}
+
+// persist grava o estado do destinatário, registrando a falha no log
+func (r *Runner) persist(recipient *campaign.Recipient, log logger.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := r.repo.UpdateRecipient(ctx, recipient); err != nil {
+		log.WithError(err).Error().Msg("Failed to persist campaign recipient")
+	}
+}
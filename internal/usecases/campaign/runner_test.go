@@ -0,0 +1,120 @@
+package campaign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/campaign"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/internal/usecases/worker"
+	"zmeow/pkg/logger"
+)
+
+// recipientRepository guarda o último estado gravado do destinatário
+type recipientRepository struct {
+	campaign.Repository
+	updated *campaign.Recipient
+}
+
+func (r *recipientRepository) ClaimRecipient(ctx context.Context, recipient *campaign.Recipient) (bool, error) {
+	recipient.Status = campaign.RecipientSending
+	recipient.Attempts++
+	return true, nil
+}
+
+func (r *recipientRepository) UpdateRecipient(ctx context.Context, recipient *campaign.Recipient) error {
+	copied := *recipient
+	r.updated = &copied
+	return nil
+}
+
+// newTestDispatcher cria um SendDispatcher cujos envios param na validação
+func newTestDispatcher(log logger.Logger) *messageUseCases.SendDispatcher {
+	return messageUseCases.NewSendDispatcher(
+		messageUseCases.NewSendTextMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendMediaMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendLocationMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendContactMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendStickerMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendButtonsMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendListMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendPollMessageUseCase(nil, nil, nil, log),
+	)
+}
+
+func TestRunnerFailsInvalidMessages(t *testing.T) {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+
+	tests := []struct {
+		name      string
+		kind      outbound.Kind
+		template  string
+		variables map[string]string
+	}{
+		{name: "empty text after rendering", kind: outbound.KindText, template: `{"text": "{{message}}"}`, variables: map[string]string{"message": ""}},
+		{name: "media without type", kind: outbound.KindMedia, template: `{"media": "https://example.com/{{file}}"}`, variables: map[string]string{"file": "a.jpg"}},
+		{name: "missing variable", kind: outbound.KindText, template: `{"text": "{{name}}"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &recipientRepository{}
+			runner := NewRunner(repo, nil, newTestDispatcher(log), worker.NewPacer(worker.Pace{Interval: time.Second}), RunnerOptions{MaxAttempts: 3}, log)
+
+			c := &campaign.Campaign{ID: uuid.New(), SessionID: uuid.New(), Kind: tt.kind, Template: json.RawMessage(tt.template), RatePerMinute: 60}
+			recipient := &campaign.Recipient{ID: uuid.New(), CampaignID: c.ID, Number: "5511999999999", Variables: tt.variables, Status: campaign.RecipientQueued}
+
+			runner.process(c, recipient)
+
+			if repo.updated == nil {
+				t.Fatal("recipient was not persisted")
+			}
+			if repo.updated.Status != campaign.RecipientFailed {
+				t.Fatalf("recipient status = %q, want %q (error %q)", repo.updated.Status, campaign.RecipientFailed, repo.updated.LastError)
+			}
+		})
+	}
+}
+
+func TestCreateCampaignValidatesTemplate(t *testing.T) {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+	// Os casos falham na validação, antes de qualquer acesso ao banco
+	uc := NewCreateCampaignUseCase(nil, nil, newTestDispatcher(log), nil, CreateOptions{RatePerMinute: 20, MaxRatePerMinute: 60, MaxRecipients: 10}, log)
+	recipients := []campaign.RecipientInput{{Number: "5511999999999", Variables: map[string]string{"name": "Ana"}}}
+
+	tests := []struct {
+		name     string
+		kind     outbound.Kind
+		template string
+		wantErr  error
+	}{
+		{name: "text template without text", kind: outbound.KindText, template: `{"caption": "{{name}}"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "location out of range", kind: outbound.KindLocation, template: `{"latitude": 100, "longitude": 0, "name": "{{name}}"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "template that is not an object", kind: outbound.KindText, template: `"{{name}}"`, wantErr: campaign.ErrInvalidTemplate},
+		{name: "unknown kind", kind: outbound.Kind("fax"), template: `{"text": "oi"}`, wantErr: outbound.ErrInvalidKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), uuid.New(), campaign.CreateCampaignRequest{
+				Name:       "Teste",
+				Kind:       tt.kind,
+				Template:   json.RawMessage(tt.template),
+				Recipients: recipients,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow/types"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	messageUsecases "zmeow/internal/usecases/message"
+	"zmeow/pkg/logger"
+)
+
+// chatAction reúne as dependências e etapas comuns das operações em chats: validar o destino,
+// conferir a sessão, localizar a última mensagem do chat e montar a resposta com o estado atualizado
+type chatAction struct {
+	sessionRepo     session.SessionRepository
+	messageRepo     message.MessageRepository
+	chatRepo        chat.ChatRepository
+	whatsappManager whatsapp.WhatsAppManager
+	logger          logger.Logger
+	numberValidator *messageUsecases.NumberValidator
+}
+
+func newChatAction(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) chatAction {
+	return chatAction{
+		sessionRepo:     sessionRepo,
+		messageRepo:     messageRepo,
+		chatRepo:        chatRepo,
+		whatsappManager: whatsappManager,
+		logger:          logger,
+		numberValidator: messageUsecases.NewNumberValidator(),
+	}
+}
+
+// prepare valida o chat de destino e verifica se a sessão existe e está conectada
+func (a *chatAction) prepare(ctx context.Context, sessionID uuid.UUID, number, groupJid string) (types.JID, error) {
+	if err := a.numberValidator.ValidateDestination(number, groupJid); err != nil {
+		return types.EmptyJID, fmt.Errorf("%w: %v", message.ErrInvalidDestination, err)
+	}
+	jid, err := types.ParseJID(a.numberValidator.GetDestination(number, groupJid))
+	if err != nil {
+		return types.EmptyJID, fmt.Errorf("%w: %v", message.ErrInvalidDestination, err)
+	}
+
+	if _, err := a.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		a.logger.WithError(err).Error().Msg("Failed to get session")
+		return types.EmptyJID, err
+	}
+	if !a.whatsappManager.IsConnected(sessionID) {
+		return types.EmptyJID, session.ErrSessionNotConnected
+	}
+	return jid, nil
+}
+
+// lastMessage retorna a mensagem mais recente do chat no histórico, ou nil quando não há nenhuma
+func (a *chatAction) lastMessage(ctx context.Context, sessionID uuid.UUID, jid types.JID) *message.Message {
+	messages, err := a.messageRepo.List(ctx, message.MessageFilter{
+		SessionID: sessionID,
+		ChatJID:   jid.String(),
+		Limit:     1,
+	})
+	if err != nil {
+		a.logger.WithError(err).Warn().Msg("Failed to get last chat message, sending action without message range")
+		return nil
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages[0]
+}
+
+// respond registra a operação no log e retorna o estado do chat após a atualização local
+func (a *chatAction) respond(ctx context.Context, sessionID uuid.UUID, jid types.JID, action string, updateErr error) *chat.ChatActionResponse {
+	// O patch já foi aceito pelo WhatsApp e também chega como evento; a falha local só é registrada
+	if updateErr != nil {
+		a.logger.WithError(updateErr).Error().Msg("Failed to update chat in database")
+	}
+
+	a.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"chat":      jid.String(),
+		"action":    action,
+	}).Info().Msg("Chat action applied")
+
+	response := &chat.ChatActionResponse{
+		Chat:      jid.String(),
+		Action:    action,
+		Timestamp: time.Now(),
+	}
+	if state, err := a.chatRepo.GetByJID(ctx, sessionID, jid.String()); err == nil {
+		response.State = state
+	}
+	return response
+}
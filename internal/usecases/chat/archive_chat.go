@@ -0,0 +1,52 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// ArchiveChatUseCase implementa o caso de uso para arquivar ou desarquivar um chat
+type ArchiveChatUseCase struct {
+	chatAction
+}
+
+// NewArchiveChatUseCase cria uma nova instância do caso de uso
+func NewArchiveChatUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *ArchiveChatUseCase {
+	return &ArchiveChatUseCase{
+		chatAction: newChatAction(sessionRepo, messageRepo, chatRepo, whatsappManager, logger),
+	}
+}
+
+// Execute arquiva ou desarquiva o chat em todos os dispositivos da conta
+func (uc *ArchiveChatUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req chat.ArchiveChatRequest) (*chat.ChatActionResponse, error) {
+	jid, err := uc.prepare(ctx, sessionID, req.Number, req.GroupJid)
+	if err != nil {
+		return nil, err
+	}
+
+	chatService := services.NewChatService(uc.whatsappManager, sessionID, uc.logger)
+	if err := chatService.Archive(ctx, jid, req.Archive, uc.lastMessage(ctx, sessionID, jid)); err != nil {
+		return nil, err
+	}
+
+	action := "archive"
+	if !req.Archive {
+		action = "unarchive"
+	}
+	updateErr := uc.chatRepo.SetArchived(ctx, sessionID, jid.String(), req.Archive)
+	return uc.respond(ctx, sessionID, jid, action, updateErr), nil
+}
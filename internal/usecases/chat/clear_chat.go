@@ -0,0 +1,52 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// ClearChatUseCase implementa o caso de uso para limpar as mensagens de um chat
+type ClearChatUseCase struct {
+	chatAction
+}
+
+// NewClearChatUseCase cria uma nova instância do caso de uso
+func NewClearChatUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *ClearChatUseCase {
+	return &ClearChatUseCase{
+		chatAction: newChatAction(sessionRepo, messageRepo, chatRepo, whatsappManager, logger),
+	}
+}
+
+// Execute apaga as mensagens do chat em todos os dispositivos e no histórico, mantendo a conversa
+func (uc *ClearChatUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req chat.ChatTargetRequest) (*chat.ChatActionResponse, error) {
+	jid, err := uc.prepare(ctx, sessionID, req.Number, req.GroupJid)
+	if err != nil {
+		return nil, err
+	}
+
+	chatService := services.NewChatService(uc.whatsappManager, sessionID, uc.logger)
+	if err := chatService.Clear(ctx, jid, uc.lastMessage(ctx, sessionID, jid)); err != nil {
+		return nil, err
+	}
+
+	updateErr := errors.Join(
+		uc.messageRepo.DeleteByChat(ctx, sessionID, jid.String()),
+		uc.chatRepo.Clear(ctx, sessionID, jid.String()),
+	)
+	return uc.respond(ctx, sessionID, jid, "clear", updateErr), nil
+}
@@ -0,0 +1,52 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// DeleteChatUseCase implementa o caso de uso para apagar um chat
+type DeleteChatUseCase struct {
+	chatAction
+}
+
+// NewDeleteChatUseCase cria uma nova instância do caso de uso
+func NewDeleteChatUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *DeleteChatUseCase {
+	return &DeleteChatUseCase{
+		chatAction: newChatAction(sessionRepo, messageRepo, chatRepo, whatsappManager, logger),
+	}
+}
+
+// Execute apaga o chat e suas mensagens em todos os dispositivos, no histórico e na lista de chats
+func (uc *DeleteChatUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req chat.ChatTargetRequest) (*chat.ChatActionResponse, error) {
+	jid, err := uc.prepare(ctx, sessionID, req.Number, req.GroupJid)
+	if err != nil {
+		return nil, err
+	}
+
+	chatService := services.NewChatService(uc.whatsappManager, sessionID, uc.logger)
+	if err := chatService.Delete(ctx, jid, uc.lastMessage(ctx, sessionID, jid)); err != nil {
+		return nil, err
+	}
+
+	updateErr := errors.Join(
+		uc.messageRepo.DeleteByChat(ctx, sessionID, jid.String()),
+		uc.chatRepo.Delete(ctx, sessionID, jid.String()),
+	)
+	return uc.respond(ctx, sessionID, jid, "delete", updateErr), nil
+}
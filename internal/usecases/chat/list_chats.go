@@ -0,0 +1,108 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+// ListChatsUseCase implementa o caso de uso para listar os chats da sessão
+type ListChatsUseCase struct {
+	sessionRepo session.SessionRepository
+	chatRepo    chat.ChatRepository
+	logger      logger.Logger
+}
+
+// NewListChatsUseCase cria uma nova instância do caso de uso
+func NewListChatsUseCase(
+	sessionRepo session.SessionRepository,
+	chatRepo chat.ChatRepository,
+	logger logger.Logger,
+) *ListChatsUseCase {
+	return &ListChatsUseCase{
+		sessionRepo: sessionRepo,
+		chatRepo:    chatRepo,
+		logger:      logger,
+	}
+}
+
+// Execute executa o caso de uso para listar os chats da sessão
+func (uc *ListChatsUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req chat.ListChatsRequest) (*chat.ChatListResponse, error) {
+	filter, err := uc.buildFilter(sessionID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	// Buscar um item a mais para saber se há próxima página
+	limit := filter.Limit
+	filter.Limit = limit + 1
+
+	chats, err := uc.chatRepo.List(ctx, filter)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list chats from database")
+		return nil, err
+	}
+
+	hasMore := len(chats) > limit
+	if hasMore {
+		chats = chats[:limit]
+	}
+	if chats == nil {
+		chats = []*chat.Chat{}
+	}
+
+	return &chat.ChatListResponse{
+		Chats:      chats,
+		TotalCount: len(chats),
+		HasMore:    hasMore,
+	}, nil
+}
+
+// buildFilter valida a requisição e monta o filtro de consulta
+func (uc *ListChatsUseCase) buildFilter(sessionID uuid.UUID, req chat.ListChatsRequest) (chat.ChatFilter, error) {
+	var isGroup *bool
+	switch req.Type {
+	case "":
+	case chat.TypeGroup, chat.TypeIndividual:
+		group := req.Type == chat.TypeGroup
+		isGroup = &group
+	default:
+		return chat.ChatFilter{}, chat.ErrInvalidChatType
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	return chat.ChatFilter{
+		SessionID: sessionID,
+		Archived:  req.Archived,
+		Unread:    req.Unread,
+		IsGroup:   isGroup,
+		Search:    strings.TrimSpace(req.Search),
+		Limit:     limit,
+		Offset:    offset,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// MarkChatUnreadUseCase implementa o caso de uso para marcar um chat como não lido ou lido
+type MarkChatUnreadUseCase struct {
+	chatAction
+}
+
+// NewMarkChatUnreadUseCase cria uma nova instância do caso de uso
+func NewMarkChatUnreadUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *MarkChatUnreadUseCase {
+	return &MarkChatUnreadUseCase{
+		chatAction: newChatAction(sessionRepo, messageRepo, chatRepo, whatsappManager, logger),
+	}
+}
+
+// Execute marca o chat como não lido (ou novamente como lido) em todos os dispositivos da conta
+func (uc *MarkChatUnreadUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req chat.MarkChatUnreadRequest) (*chat.ChatActionResponse, error) {
+	jid, err := uc.prepare(ctx, sessionID, req.Number, req.GroupJid)
+	if err != nil {
+		return nil, err
+	}
+
+	chatService := services.NewChatService(uc.whatsappManager, sessionID, uc.logger)
+	if err := chatService.MarkRead(ctx, jid, !req.Unread, uc.lastMessage(ctx, sessionID, jid)); err != nil {
+		return nil, err
+	}
+
+	action := "mark_unread"
+	if !req.Unread {
+		action = "mark_read"
+	}
+	updateErr := uc.chatRepo.SetRead(ctx, sessionID, jid.String(), !req.Unread)
+	return uc.respond(ctx, sessionID, jid, action, updateErr), nil
+}
@@ -0,0 +1,64 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// MuteChatUseCase implementa o caso de uso para silenciar ou reativar as notificações de um chat
+type MuteChatUseCase struct {
+	chatAction
+}
+
+// NewMuteChatUseCase cria uma nova instância do caso de uso
+func NewMuteChatUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *MuteChatUseCase {
+	return &MuteChatUseCase{
+		chatAction: newChatAction(sessionRepo, messageRepo, chatRepo, whatsappManager, logger),
+	}
+}
+
+// Execute silencia o chat pela duração informada (0 = para sempre) ou reativa as notificações
+func (uc *MuteChatUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req chat.MuteChatRequest) (*chat.ChatActionResponse, error) {
+	if req.DurationSeconds < 0 {
+		return nil, chat.ErrInvalidMuteDuration
+	}
+
+	jid, err := uc.prepare(ctx, sessionID, req.Number, req.GroupJid)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	chatService := services.NewChatService(uc.whatsappManager, sessionID, uc.logger)
+	if err := chatService.Mute(ctx, jid, req.Mute, duration); err != nil {
+		return nil, err
+	}
+
+	var muteEndTime *time.Time
+	action := "unmute"
+	if req.Mute {
+		action = "mute"
+		end := chat.MutedForever
+		if duration > 0 {
+			end = time.Now().Add(duration)
+		}
+		muteEndTime = &end
+	}
+	updateErr := uc.chatRepo.SetMuteEndTime(ctx, sessionID, jid.String(), muteEndTime)
+	return uc.respond(ctx, sessionID, jid, action, updateErr), nil
+}
@@ -0,0 +1,52 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/chat"
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// PinChatUseCase implementa o caso de uso para fixar ou desafixar um chat
+type PinChatUseCase struct {
+	chatAction
+}
+
+// NewPinChatUseCase cria uma nova instância do caso de uso
+func NewPinChatUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	chatRepo chat.ChatRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *PinChatUseCase {
+	return &PinChatUseCase{
+		chatAction: newChatAction(sessionRepo, messageRepo, chatRepo, whatsappManager, logger),
+	}
+}
+
+// Execute fixa ou desafixa o chat em todos os dispositivos da conta
+func (uc *PinChatUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req chat.PinChatRequest) (*chat.ChatActionResponse, error) {
+	jid, err := uc.prepare(ctx, sessionID, req.Number, req.GroupJid)
+	if err != nil {
+		return nil, err
+	}
+
+	chatService := services.NewChatService(uc.whatsappManager, sessionID, uc.logger)
+	if err := chatService.Pin(ctx, jid, req.Pin); err != nil {
+		return nil, err
+	}
+
+	action := "pin"
+	if !req.Pin {
+		action = "unpin"
+	}
+	updateErr := uc.chatRepo.SetPinned(ctx, sessionID, jid.String(), req.Pin)
+	return uc.respond(ctx, sessionID, jid, action, updateErr), nil
+}
@@ -0,0 +1,73 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow/types"
+
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// CheckContactsUseCase implementa o caso de uso para verificar se números têm conta no WhatsApp
+type CheckContactsUseCase struct {
+	contactLookup
+}
+
+// NewCheckContactsUseCase cria uma nova instância do caso de uso
+func NewCheckContactsUseCase(
+	sessionRepo session.SessionRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	cache *services.ContactCache,
+	logger logger.Logger,
+) *CheckContactsUseCase {
+	return &CheckContactsUseCase{
+		contactLookup: newContactLookup(sessionRepo, whatsappManager, cache, logger),
+	}
+}
+
+// Execute verifica os números em lote, retornando o JID canônico de cada conta encontrada
+func (uc *CheckContactsUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req contact.CheckContactsRequest) (*contact.CheckContactsResponse, error) {
+	if len(req.Numbers) == 0 {
+		return nil, contact.ErrNumbersRequired
+	}
+	if len(req.Numbers) > contact.MaxCheckNumbers {
+		return nil, contact.ErrTooManyNumbers
+	}
+
+	numbers := make([]string, len(req.Numbers))
+	for i, number := range req.Numbers {
+		jid, err := uc.resolve(number)
+		if err != nil {
+			return nil, err
+		}
+		// A verificação é feita pelo telefone; JIDs @lid não o revelam
+		if jid.Server != types.DefaultUserServer {
+			return nil, fmt.Errorf("%w: %s", contact.ErrInvalidNumber, number)
+		}
+		numbers[i] = jid.User
+	}
+
+	if err := uc.checkSession(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	results, err := uc.service(sessionID).Check(ctx, numbers)
+	if err != nil {
+		return nil, err
+	}
+	// Devolver cada resultado com o número como foi informado, para facilitar a correlação
+	for i := range results {
+		results[i].Number = req.Numbers[i]
+	}
+
+	return &contact.CheckContactsResponse{
+		Results:    results,
+		TotalCount: len(results),
+	}, nil
+}
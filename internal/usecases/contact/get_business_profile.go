@@ -0,0 +1,58 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// GetBusinessProfileUseCase implementa o caso de uso para obter o perfil comercial de um contato
+type GetBusinessProfileUseCase struct {
+	contactLookup
+}
+
+// NewGetBusinessProfileUseCase cria uma nova instância do caso de uso
+func NewGetBusinessProfileUseCase(
+	sessionRepo session.SessionRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	cache *services.ContactCache,
+	logger logger.Logger,
+) *GetBusinessProfileUseCase {
+	return &GetBusinessProfileUseCase{
+		contactLookup: newContactLookup(sessionRepo, whatsappManager, cache, logger),
+	}
+}
+
+// Execute obtém o perfil comercial do contato; contas pessoais retornam contact.ErrNotBusiness
+func (uc *GetBusinessProfileUseCase) Execute(ctx context.Context, sessionID uuid.UUID, number string) (*contact.BusinessProfileResponse, error) {
+	jid, err := uc.resolve(number)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSession(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	// O servidor não distingue conta pessoal de perfil inexistente; o nome verificado identifica as contas comerciais
+	contactService := uc.service(sessionID)
+	info, err := contactService.UserInfo(ctx, jid)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsBusiness {
+		return nil, contact.ErrNotBusiness
+	}
+
+	profile, err := contactService.BusinessProfile(ctx, jid)
+	if err != nil {
+		return nil, err
+	}
+	profile.VerifiedName = info.VerifiedName
+	return profile, nil
+}
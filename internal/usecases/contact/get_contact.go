@@ -0,0 +1,67 @@
+package contact
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// GetContactUseCase implementa o caso de uso para consultar um contato
+type GetContactUseCase struct {
+	contactLookup
+	contactRepo contact.ContactRepository
+}
+
+// NewGetContactUseCase cria uma nova instância do caso de uso
+func NewGetContactUseCase(
+	sessionRepo session.SessionRepository,
+	contactRepo contact.ContactRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	cache *services.ContactCache,
+	logger logger.Logger,
+) *GetContactUseCase {
+	return &GetContactUseCase{
+		contactLookup: newContactLookup(sessionRepo, whatsappManager, cache, logger),
+		contactRepo:   contactRepo,
+	}
+}
+
+// Execute consulta o recado, a foto atual e os dados comerciais do contato no WhatsApp,
+// complementados pelos nomes sincronizados na sessão
+func (uc *GetContactUseCase) Execute(ctx context.Context, sessionID uuid.UUID, number string) (*contact.ContactInfoResponse, error) {
+	jid, err := uc.resolve(number)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSession(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	info, err := uc.service(sessionID).UserInfo(ctx, jid)
+	if err != nil {
+		return nil, err
+	}
+
+	synced, err := uc.contactRepo.GetByJID(ctx, sessionID, jid.String())
+	switch {
+	case err == nil:
+		info.Name = synced.DisplayName()
+		info.PushName = synced.PushName
+		info.FullName = synced.FullName
+		info.BusinessName = synced.BusinessName
+	case !errors.Is(err, contact.ErrContactNotFound):
+		uc.logger.WithError(err).Warn().Msg("Failed to get synced contact names")
+	}
+	if info.Name == "" {
+		info.Name = info.VerifiedName
+	}
+
+	return info, nil
+}
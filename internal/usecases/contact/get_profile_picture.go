@@ -0,0 +1,43 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// GetProfilePictureUseCase implementa o caso de uso para obter a foto de perfil de um contato
+type GetProfilePictureUseCase struct {
+	contactLookup
+}
+
+// NewGetProfilePictureUseCase cria uma nova instância do caso de uso
+func NewGetProfilePictureUseCase(
+	sessionRepo session.SessionRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	cache *services.ContactCache,
+	logger logger.Logger,
+) *GetProfilePictureUseCase {
+	return &GetProfilePictureUseCase{
+		contactLookup: newContactLookup(sessionRepo, whatsappManager, cache, logger),
+	}
+}
+
+// Execute obtém a URL da foto de perfil, em miniatura (preview) ou resolução completa
+func (uc *GetProfilePictureUseCase) Execute(ctx context.Context, sessionID uuid.UUID, number string, preview bool) (*contact.ProfilePictureResponse, error) {
+	jid, err := uc.resolve(number)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSession(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	return uc.service(sessionID).ProfilePicture(ctx, jid, preview)
+}
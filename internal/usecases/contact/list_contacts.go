@@ -0,0 +1,82 @@
+package contact
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+const (
+	defaultListLimit = 100
+	maxListLimit     = 500
+)
+
+// ListContactsUseCase implementa o caso de uso para listar os contatos sincronizados da sessão
+type ListContactsUseCase struct {
+	sessionRepo session.SessionRepository
+	contactRepo contact.ContactRepository
+	logger      logger.Logger
+}
+
+// NewListContactsUseCase cria uma nova instância do caso de uso
+func NewListContactsUseCase(
+	sessionRepo session.SessionRepository,
+	contactRepo contact.ContactRepository,
+	logger logger.Logger,
+) *ListContactsUseCase {
+	return &ListContactsUseCase{
+		sessionRepo: sessionRepo,
+		contactRepo: contactRepo,
+		logger:      logger,
+	}
+}
+
+// Execute executa o caso de uso para listar os contatos sincronizados da sessão
+func (uc *ListContactsUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req contact.ListContactsRequest) (*contact.ContactListResponse, error) {
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Buscar um item a mais para saber se há próxima página
+	contacts, err := uc.contactRepo.List(ctx, contact.ContactFilter{
+		SessionID: sessionID,
+		Search:    strings.TrimSpace(req.Search),
+		Limit:     limit + 1,
+		Offset:    offset,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list contacts from database")
+		return nil, err
+	}
+
+	hasMore := len(contacts) > limit
+	if hasMore {
+		contacts = contacts[:limit]
+	}
+	if contacts == nil {
+		contacts = []*contact.Contact{}
+	}
+
+	return &contact.ContactListResponse{
+		Contacts:   contacts,
+		TotalCount: len(contacts),
+		HasMore:    hasMore,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow/types"
+
+	"zmeow/internal/domain/contact"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	messageUsecases "zmeow/internal/usecases/message"
+	"zmeow/pkg/logger"
+)
+
+// contactLookup reúne as dependências e etapas comuns das consultas de contatos no WhatsApp:
+// resolver o número ou JID informado e conferir se a sessão existe e está conectada
+type contactLookup struct {
+	sessionRepo     session.SessionRepository
+	whatsappManager whatsapp.WhatsAppManager
+	cache           *services.ContactCache
+	logger          logger.Logger
+	numberValidator *messageUsecases.NumberValidator
+}
+
+func newContactLookup(
+	sessionRepo session.SessionRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	cache *services.ContactCache,
+	logger logger.Logger,
+) contactLookup {
+	return contactLookup{
+		sessionRepo:     sessionRepo,
+		whatsappManager: whatsappManager,
+		cache:           cache,
+		logger:          logger,
+		numberValidator: messageUsecases.NewNumberValidator(),
+	}
+}
+
+// service cria o serviço de contatos da sessão
+func (l *contactLookup) service(sessionID uuid.UUID) *services.ContactService {
+	return services.NewContactService(l.whatsappManager, l.cache, sessionID, l.logger)
+}
+
+// checkSession verifica se a sessão existe e está conectada
+func (l *contactLookup) checkSession(ctx context.Context, sessionID uuid.UUID) error {
+	if _, err := l.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		l.logger.WithError(err).Error().Msg("Failed to get session")
+		return err
+	}
+	if !l.whatsappManager.IsConnected(sessionID) {
+		return session.ErrSessionNotConnected
+	}
+	return nil
+}
+
+// resolve converte o número de telefone ou JID de usuário (@s.whatsapp.net ou @lid) no JID do contato
+func (l *contactLookup) resolve(number string) (types.JID, error) {
+	number = strings.TrimSpace(number)
+	if strings.Contains(number, "@") {
+		jid, err := types.ParseJID(number)
+		if err != nil || (jid.Server != types.DefaultUserServer && jid.Server != types.HiddenUserServer) {
+			return types.EmptyJID, fmt.Errorf("%w: %s", contact.ErrInvalidNumber, number)
+		}
+		return jid.ToNonAD(), nil
+	}
+
+	if !l.numberValidator.IsValidNumber(number) {
+		return types.EmptyJID, fmt.Errorf("%w: %s", contact.ErrInvalidNumber, number)
+	}
+	return types.ParseJID(l.numberValidator.NormalizeNumber(number))
+}
@@ -0,0 +1,122 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/idempotency"
+	"zmeow/pkg/logger"
+)
+
+// purgeInterval é o intervalo mínimo entre duas remoções das chaves vencidas
+const purgeInterval = 10 * time.Minute
+
+// IdempotencyUseCase implementa os casos de uso das chaves de idempotência: reservar a chave de uma requisição,
+// gravar sua resposta e liberar a chave quando a requisição puder ser refeita
+type IdempotencyUseCase struct {
+	repo       idempotency.Repository
+	ttl        time.Duration
+	lastPurge  time.Time
+	purgeMutex sync.Mutex
+	logger     logger.Logger
+}
+
+// NewIdempotencyUseCase cria uma nova instância do caso de uso; ttl é a janela em que as respostas são repetidas
+func NewIdempotencyUseCase(repo idempotency.Repository, ttl time.Duration, logger logger.Logger) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		repo:   repo,
+		ttl:    ttl,
+		logger: logger.WithComponent("idempotency-usecase"),
+	}
+}
+
+// Enabled informa se as chaves de idempotência estão habilitadas (janela maior que zero)
+func (uc *IdempotencyUseCase) Enabled() bool {
+	return uc.ttl > 0
+}
+
+// Begin reserva a chave para a requisição. Retorna nil se a requisição deve ser executada, o registro com a resposta
+// gravada se for uma repetição, ErrKeyReused se a chave já foi usada com outra requisição ou ErrRequestInProgress se a
+// requisição original ainda não terminou.
+func (uc *IdempotencyUseCase) Begin(ctx context.Context, sessionID uuid.UUID, key, requestHash, method, path string) (*idempotency.Record, error) {
+	if key == "" || len(key) > idempotency.MaxKeyLength {
+		return nil, idempotency.ErrInvalidKey
+	}
+
+	uc.purgeExpired(ctx)
+
+	now := time.Now()
+	existing, err := uc.repo.Reserve(ctx, &idempotency.Record{
+		SessionID:   sessionID,
+		Key:         key,
+		RequestHash: requestHash,
+		Method:      method,
+		Path:        path,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(uc.ttl),
+	})
+	if err != nil {
+		if err != idempotency.ErrRequestInProgress {
+			uc.logger.WithError(err).Error().Msg("Failed to reserve idempotency key")
+		}
+		return nil, err
+	}
+	if existing == nil {
+		return nil, nil
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, idempotency.ErrKeyReused
+	}
+	if existing.Status != idempotency.StatusCompleted {
+		return nil, idempotency.ErrRequestInProgress
+	}
+	return existing, nil
+}
+
+// Complete grava a resposta da requisição, que passa a ser repetida para a mesma chave até o fim da janela
+func (uc *IdempotencyUseCase) Complete(ctx context.Context, sessionID uuid.UUID, key string, statusCode int, contentType string, body []byte) error {
+	err := uc.repo.Complete(ctx, &idempotency.Record{
+		SessionID:   sessionID,
+		Key:         key,
+		StatusCode:  statusCode,
+		ContentType: contentType,
+		Response:    body,
+	})
+	if err != nil {
+		uc.logger.WithError(err).WithField("sessionId", sessionID).Error().Msg("Failed to store idempotent response")
+	}
+	return err
+}
+
+// Release libera a chave para que a requisição possa ser refeita, usado quando a requisição falha no servidor
+func (uc *IdempotencyUseCase) Release(ctx context.Context, sessionID uuid.UUID, key string) error {
+	if err := uc.repo.Release(ctx, sessionID, key); err != nil {
+		uc.logger.WithError(err).WithField("sessionId", sessionID).Error().Msg("Failed to release idempotency key")
+		return err
+	}
+	return nil
+}
+
+// purgeExpired remove as chaves vencidas, no máximo uma vez a cada purgeInterval
+func (uc *IdempotencyUseCase) purgeExpired(ctx context.Context) {
+	uc.purgeMutex.Lock()
+	if time.Since(uc.lastPurge) < purgeInterval {
+		uc.purgeMutex.Unlock()
+		return
+	}
+	uc.lastPurge = time.Now()
+	uc.purgeMutex.Unlock()
+
+	count, err := uc.repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Failed to delete expired idempotency keys")
+		return
+	}
+	if count > 0 {
+		uc.logger.WithField("count", count).Debug().Msg("Expired idempotency keys deleted")
+	}
+}
@@ -0,0 +1,61 @@
+package media
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/media"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// SetAutoDownloadUseCase implementa o caso de uso para habilitar ou desabilitar o download automático de mídias
+type SetAutoDownloadUseCase struct {
+	sessionRepo session.SessionRepository
+	storage     media.Storage
+	logger      logger.Logger
+}
+
+// NewSetAutoDownloadUseCase cria uma nova instância do caso de uso.
+// storage é nil quando o armazenamento de mídias está desabilitado.
+func NewSetAutoDownloadUseCase(
+	sessionRepo session.SessionRepository,
+	storage media.Storage,
+	logger logger.Logger,
+) *SetAutoDownloadUseCase {
+	return &SetAutoDownloadUseCase{
+		sessionRepo: sessionRepo,
+		storage:     storage,
+		logger:      logger.WithComponent("set-auto-download-usecase"),
+	}
+}
+
+// Execute persiste a configuração de download automático da sessão
+func (uc *SetAutoDownloadUseCase) Execute(ctx context.Context, sessionID uuid.UUID, enabled bool) (*media.AutoDownloadResponse, error) {
+	if enabled && uc.storage == nil {
+		return nil, media.ErrStorageDisabled
+	}
+
+	sess, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sess.SetAutoDownloadMedia(enabled)
+	if err := uc.sessionRepo.Update(ctx, sess); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to update session auto download setting")
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"enabled":   enabled,
+	}).Info().Msg("Media auto download updated")
+
+	return &media.AutoDownloadResponse{
+		SessionID:    sessionID,
+		AutoDownload: enabled,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package media
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/media"
+	"zmeow/pkg/logger"
+)
+
+// GetStoredMediaUseCase implementa o caso de uso para ler uma mídia do armazenamento
+type GetStoredMediaUseCase struct {
+	storage media.Storage
+	logger  logger.Logger
+}
+
+// NewGetStoredMediaUseCase cria uma nova instância do caso de uso.
+// storage é nil quando o armazenamento de mídias está desabilitado.
+func NewGetStoredMediaUseCase(storage media.Storage, logger logger.Logger) *GetStoredMediaUseCase {
+	return &GetStoredMediaUseCase{
+		storage: storage,
+		logger:  logger.WithComponent("get-stored-media-usecase"),
+	}
+}
+
+// Execute abre o objeto da sessão; o chamador deve fechar o reader retornado
+func (uc *GetStoredMediaUseCase) Execute(ctx context.Context, sessionID uuid.UUID, objectName string) (io.ReadCloser, *media.ObjectInfo, error) {
+	if uc.storage == nil {
+		return nil, nil, media.ErrStorageDisabled
+	}
+
+	key, err := media.ParseObjectKey(sessionID, objectName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, info, err := uc.storage.Get(ctx, key)
+	if err != nil {
+		if err != media.ErrObjectNotFound {
+			uc.logger.WithError(err).WithField("key", key).Error().Msg("Failed to read stored media")
+		}
+		return nil, nil, err
+	}
+
+	return data, info, nil
+}
@@ -0,0 +1,80 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// SendChatPresenceUseCase implementa o caso de uso para enviar o indicador de digitação ou gravação
+type SendChatPresenceUseCase struct {
+	sessionRepo     session.SessionRepository
+	whatsappManager whatsapp.WhatsAppManager
+	logger          logger.Logger
+	numberValidator *NumberValidator
+}
+
+// NewSendChatPresenceUseCase cria uma nova instância do caso de uso
+func NewSendChatPresenceUseCase(
+	sessionRepo session.SessionRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *SendChatPresenceUseCase {
+	return &SendChatPresenceUseCase{
+		sessionRepo:     sessionRepo,
+		whatsappManager: whatsappManager,
+		logger:          logger,
+		numberValidator: NewNumberValidator(),
+	}
+}
+
+// Execute executa o caso de uso para enviar presença no chat
+func (uc *SendChatPresenceUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.ChatPresenceRequest) (*message.ChatPresenceResponse, error) {
+	// Validar entrada
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return nil, fmt.Errorf("%w: %v", message.ErrInvalidDestination, err)
+	}
+	if !req.State.IsValid() {
+		return nil, message.ErrInvalidPresenceState
+	}
+
+	destination := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Verificar se a sessão existe
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to get session")
+		return nil, err
+	}
+
+	// Verificar se a sessão está conectada
+	if !uc.whatsappManager.IsConnected(sessionID) {
+		return nil, session.ErrSessionNotConnected
+	}
+
+	client, err := uc.whatsappManager.GetClient(sessionID)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to get WhatsApp client")
+		return nil, fmt.Errorf("failed to get WhatsApp client: %w", err)
+	}
+
+	if err := client.SendChatPresence(ctx, sessionID, destination, req.State); err != nil {
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":   sessionID,
+		"destination": destination,
+		"state":       req.State,
+	}).Debug().Msg("Chat presence sent")
+
+	return &message.ChatPresenceResponse{
+		Chat:  destination,
+		State: req.State,
+	}, nil
+}
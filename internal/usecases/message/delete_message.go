@@ -3,8 +3,6 @@ package message
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
 
 	"github.com/google/uuid"
 
@@ -19,6 +17,7 @@ type DeleteMessageUseCase struct {
 	sessionRepo     session.SessionRepository
 	whatsappManager whatsapp.WhatsAppManager
 	logger          logger.Logger
+	numberValidator *NumberValidator
 }
 
 // NewDeleteMessageUseCase cria uma nova instância do caso de uso
@@ -31,6 +30,7 @@ func NewDeleteMessageUseCase(
 		sessionRepo:     sessionRepo,
 		whatsappManager: whatsappManager,
 		logger:          logger,
+		numberValidator: NewNumberValidator(),
 	}
 }
 
@@ -38,7 +38,8 @@ func NewDeleteMessageUseCase(
 func (uc *DeleteMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.DeleteMessageRequest) (*message.SendMessageResponse, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId": sessionID,
-		"to":        req.To,
+		"number":    req.Number,
+		"groupJid":  req.GroupJid,
 		"messageId": req.ID,
 		"forMe":     req.ForMe,
 	}).Info().Msg("Deleting message")
@@ -62,8 +63,8 @@ func (uc *DeleteMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
-	// Normalizar número de telefone
-	normalizedPhone := uc.normalizePhoneNumber(req.To)
+	// Obter destinatário
+	normalizedPhone := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
 
 	// Obter cliente WhatsApp
 	client, err := uc.whatsappManager.GetClient(sessionID)
@@ -103,61 +104,14 @@ func (uc *DeleteMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID
 
 // validateRequest valida a requisição de deletar mensagem
 func (uc *DeleteMessageUseCase) validateRequest(req message.DeleteMessageRequest) error {
-	if req.To == "" {
-		return fmt.Errorf("to is required")
+	// Validar destinatário (number ou groupJid)
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return err
 	}
 
 	if req.ID == "" {
 		return fmt.Errorf("messageID is required")
 	}
 
-	// Validar formato do telefone
-	if !uc.isValidPhoneNumber(req.To) {
-		return fmt.Errorf("invalid phone number format")
-	}
-
 	return nil
 }
-
-// isValidPhoneNumber valida o formato do número de telefone
-func (uc *DeleteMessageUseCase) isValidPhoneNumber(phone string) bool {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Verificar se tem pelo menos 10 dígitos e no máximo 15
-	if len(cleaned) < 10 || len(cleaned) > 15 {
-		return false
-	}
-
-	// Verificar se começa com código de país válido
-	if len(cleaned) >= 11 && (strings.HasPrefix(cleaned, "55") || strings.HasPrefix(cleaned, "1")) {
-		return true
-	}
-
-	// Aceitar números com 10-11 dígitos (formato nacional)
-	if len(cleaned) >= 10 && len(cleaned) <= 11 {
-		return true
-	}
-
-	return false
-}
-
-// normalizePhoneNumber normaliza o número de telefone para o formato WhatsApp
-func (uc *DeleteMessageUseCase) normalizePhoneNumber(phone string) string {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Se não tem código de país, assumir Brasil (55)
-	if len(cleaned) == 10 || len(cleaned) == 11 {
-		if !strings.HasPrefix(cleaned, "55") {
-			cleaned = "55" + cleaned
-		}
-	}
-
-	// Adicionar sufixo @s.whatsapp.net se não estiver presente
-	if !strings.Contains(phone, "@") {
-		return cleaned + "@s.whatsapp.net"
-	}
-
-	return phone
-}
@@ -0,0 +1,187 @@
+package message
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+)
+
+// SendDispatcher executa um envio descrito por tipo e payload JSON (a mesma requisição dos endpoints de envio)
+// pelo caso de uso correspondente. É usado pelos envios que não vêm diretamente de uma requisição HTTP.
+type SendDispatcher struct {
+	sendText     *SendTextMessageUseCase
+	sendMedia    *SendMediaMessageUseCase
+	sendLocation *SendLocationMessageUseCase
+	sendContact  *SendContactMessageUseCase
+	sendSticker  *SendStickerMessageUseCase
+	sendButtons  *SendButtonsMessageUseCase
+	sendList     *SendListMessageUseCase
+	sendPoll     *SendPollMessageUseCase
+}
+
+// NewSendDispatcher cria uma nova instância do SendDispatcher
+func NewSendDispatcher(
+	sendText *SendTextMessageUseCase,
+	sendMedia *SendMediaMessageUseCase,
+	sendLocation *SendLocationMessageUseCase,
+	sendContact *SendContactMessageUseCase,
+	sendSticker *SendStickerMessageUseCase,
+	sendButtons *SendButtonsMessageUseCase,
+	sendList *SendListMessageUseCase,
+	sendPoll *SendPollMessageUseCase,
+) *SendDispatcher {
+	return &SendDispatcher{
+		sendText:     sendText,
+		sendMedia:    sendMedia,
+		sendLocation: sendLocation,
+		sendContact:  sendContact,
+		sendSticker:  sendSticker,
+		sendButtons:  sendButtons,
+		sendList:     sendList,
+		sendPoll:     sendPoll,
+	}
+}
+
+// Dispatch decodifica o payload conforme o tipo e executa o envio
+func (d *SendDispatcher) Dispatch(ctx context.Context, sessionID uuid.UUID, kind outbound.Kind, payload json.RawMessage) (*message.SendMessageResponse, error) {
+	switch kind {
+	case outbound.KindText:
+		var req message.SendTextMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendText.Execute(ctx, sessionID, req)
+	case outbound.KindMedia:
+		var req message.SendMediaMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendMedia.Execute(ctx, sessionID, req)
+	case outbound.KindLocation:
+		var req message.SendLocationMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendLocation.Execute(ctx, sessionID, req)
+	case outbound.KindContact:
+		var req message.SendContactMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendContact.Execute(ctx, sessionID, req)
+	case outbound.KindSticker:
+		var req message.SendStickerMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendSticker.Execute(ctx, sessionID, req)
+	case outbound.KindButtons:
+		var req message.SendButtonsMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendButtons.Execute(ctx, sessionID, req)
+	case outbound.KindList:
+		var req message.SendListMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendList.Execute(ctx, sessionID, req)
+	case outbound.KindPoll:
+		var req message.SendPollMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return d.sendPoll.Execute(ctx, sessionID, req)
+	default:
+		return nil, fmt.Errorf("%w: %s", outbound.ErrInvalidKind, kind)
+	}
+}
+
+// ValidatePayload verifica se o payload é uma requisição válida do tipo de envio, com as mesmas validações do endpoint
+// (destinatário, campos obrigatórios e limites), sem enviar nada
+func (d *SendDispatcher) ValidatePayload(kind outbound.Kind, payload json.RawMessage) error {
+	if !kind.IsValid() {
+		return fmt.Errorf("%w: %s", outbound.ErrInvalidKind, kind)
+	}
+	if len(payload) == 0 {
+		return fmt.Errorf("%w: payload is required", outbound.ErrInvalidPayload)
+	}
+
+	switch kind {
+	case outbound.KindText:
+		var req message.SendTextMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendText.Validate(req)
+	case outbound.KindMedia:
+		var req message.SendMediaMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendMedia.Validate(req)
+	case outbound.KindLocation:
+		var req message.SendLocationMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendLocation.Validate(req)
+	case outbound.KindContact:
+		var req message.SendContactMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendContact.Validate(req)
+	case outbound.KindSticker:
+		var req message.SendStickerMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendSticker.Validate(req)
+	case outbound.KindButtons:
+		var req message.SendButtonsMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendButtons.Validate(req)
+	case outbound.KindList:
+		var req message.SendListMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendList.Validate(req)
+	case outbound.KindPoll:
+		var req message.SendPollMessageRequest
+		if err := decodePayload(payload, &req); err != nil {
+			return err
+		}
+		return d.sendPoll.Validate(req)
+	default:
+		return fmt.Errorf("%w: %s", outbound.ErrInvalidKind, kind)
+	}
+}
+
+// IsPermanentSendError verifica se a falha de um envio não se resolve com uma nova tentativa
+func IsPermanentSendError(err error) bool {
+	return errors.Is(err, outbound.ErrInvalidPayload) ||
+		errors.Is(err, outbound.ErrInvalidKind) ||
+		errors.Is(err, message.ErrInvalidSendRequest) ||
+		errors.Is(err, message.ErrInvalidDestination) ||
+		errors.Is(err, message.ErrInvalidContextInfo) ||
+		errors.Is(err, message.ErrRecipientNotOnWhatsApp)
+}
+
+// decodePayload decodifica o payload armazenado na requisição do tipo de envio
+func decodePayload(payload json.RawMessage, req interface{}) error {
+	if err := json.Unmarshal(payload, req); err != nil {
+		return fmt.Errorf("%w: %v", outbound.ErrInvalidPayload, err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package message
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	"zmeow/pkg/logger"
+)
+
+// newValidationDispatcher cria um SendDispatcher apenas para validação; nenhum envio chega ao WhatsApp
+func newValidationDispatcher() *SendDispatcher {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+	return NewSendDispatcher(
+		NewSendTextMessageUseCase(nil, nil, nil, log),
+		NewSendMediaMessageUseCase(nil, nil, nil, log),
+		NewSendLocationMessageUseCase(nil, nil, nil, log),
+		NewSendContactMessageUseCase(nil, nil, nil, log),
+		NewSendStickerMessageUseCase(nil, nil, nil, log),
+		NewSendButtonsMessageUseCase(nil, nil, nil, log),
+		NewSendListMessageUseCase(nil, nil, nil, log),
+		NewSendPollMessageUseCase(nil, nil, nil, log),
+	)
+}
+
+func TestSendDispatcherValidatePayload(t *testing.T) {
+	dispatcher := newValidationDispatcher()
+
+	tests := []struct {
+		name    string
+		kind    outbound.Kind
+		payload string
+		wantErr error
+	}{
+		{name: "valid text", kind: outbound.KindText, payload: `{"number": "5511999999999", "text": "Olá"}`},
+		{name: "valid text to a group", kind: outbound.KindText, payload: `{"groupJid": "120363123456789012@g.us", "text": "Olá"}`},
+		{name: "text without text", kind: outbound.KindText, payload: `{"number": "5511999999999"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "text without destination", kind: outbound.KindText, payload: `{"text": "Olá"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "text with an invalid number", kind: outbound.KindText, payload: `{"number": "123", "text": "Olá"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "text with number and group", kind: outbound.KindText, payload: `{"number": "5511999999999", "groupJid": "120363123456789012@g.us", "text": "Olá"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "valid media", kind: outbound.KindMedia, payload: `{"number": "5511999999999", "media": "https://example.com/a.jpg", "mediaType": "image"}`},
+		{name: "document without file name", kind: outbound.KindMedia, payload: `{"number": "5511999999999", "media": "https://example.com/a.pdf", "mediaType": "document"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "location out of range", kind: outbound.KindLocation, payload: `{"number": "5511999999999", "latitude": 91, "longitude": 0}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "poll with one option", kind: outbound.KindPoll, payload: `{"number": "5511999999999", "name": "Vem?", "options": ["Sim"], "selectableOptionsCount": 1}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "buttons without buttons", kind: outbound.KindButtons, payload: `{"number": "5511999999999", "text": "Escolha"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "sticker without data", kind: outbound.KindSticker, payload: `{"number": "5511999999999"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "malformed json", kind: outbound.KindText, payload: `{"number": `, wantErr: outbound.ErrInvalidPayload},
+		{name: "empty payload", kind: outbound.KindText, wantErr: outbound.ErrInvalidPayload},
+		{name: "unknown kind", kind: outbound.Kind("fax"), payload: `{}`, wantErr: outbound.ErrInvalidKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := dispatcher.ValidatePayload(tt.kind, json.RawMessage(tt.payload))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidatePayload() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && !IsPermanentSendError(err) {
+				t.Fatalf("IsPermanentSendError(%v) = false, want true", err)
+			}
+		})
+	}
+}
+
+func TestIsPermanentSendError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "invalid send request", err: fmt.Errorf("%w: text is required", message.ErrInvalidSendRequest), want: true},
+		{name: "invalid destination", err: fmt.Errorf("%w: bad jid", message.ErrInvalidDestination), want: true},
+		{name: "invalid context info", err: message.ErrInvalidContextInfo, want: true},
+		{name: "recipient not on whatsapp", err: message.ErrRecipientNotOnWhatsApp, want: true},
+		{name: "invalid payload", err: outbound.ErrInvalidPayload, want: true},
+		{name: "session not connected", err: errors.New("session is not connected"), want: false},
+		{name: "send failure", err: fmt.Errorf("failed to send message: %w", errors.New("timeout")), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPermanentSendError(tt.err); got != tt.want {
+				t.Fatalf("IsPermanentSendError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
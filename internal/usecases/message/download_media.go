@@ -0,0 +1,99 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// DownloadMediaUseCase implementa o caso de uso para baixar mídias recebidas
+type DownloadMediaUseCase struct {
+	sessionRepo     session.SessionRepository
+	messageRepo     message.MessageRepository
+	whatsappManager whatsapp.WhatsAppManager
+	logger          logger.Logger
+}
+
+// NewDownloadMediaUseCase cria uma nova instância do caso de uso
+func NewDownloadMediaUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *DownloadMediaUseCase {
+	return &DownloadMediaUseCase{
+		sessionRepo:     sessionRepo,
+		messageRepo:     messageRepo,
+		whatsappManager: whatsappManager,
+		logger:          logger,
+	}
+}
+
+// Execute baixa a mídia descrita na requisição. mediaType restringe o tipo aceito
+// (image, video, audio ou document); vazio aceita qualquer mídia de uma mensagem do histórico.
+// Quando directPath não é informado, os metadados são obtidos da mensagem pelo ID.
+func (uc *DownloadMediaUseCase) Execute(ctx context.Context, sessionID uuid.UUID, mediaType message.InboundMessageType, req message.DownloadMediaRequest) (*message.MediaContent, error) {
+	// Validar entrada
+	media := &req.InboundMedia
+	if media.DirectPath == "" && req.MessageID == "" {
+		return nil, fmt.Errorf("%w: messageId or directPath and mediaKey are required", message.ErrInvalidMediaInfo)
+	}
+	if media.DirectPath != "" && media.MediaKey == "" {
+		return nil, fmt.Errorf("%w: mediaKey is required", message.ErrInvalidMediaInfo)
+	}
+
+	// Verificar se a sessão existe
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to get session")
+		return nil, err
+	}
+
+	// Verificar se a sessão está conectada
+	if !uc.whatsappManager.IsConnected(sessionID) {
+		return nil, session.ErrSessionNotConnected
+	}
+
+	// Sem directPath, usar os metadados da mensagem gravada no histórico
+	resolvedType := mediaType
+	if media.DirectPath == "" {
+		msg, err := uc.messageRepo.GetByMessageID(ctx, sessionID, req.MessageID)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Content == nil || msg.Content.Media == nil || msg.Type.MediaKind() == "" {
+			return nil, message.ErrMessageHasNoMedia
+		}
+		if mediaType != "" && msg.Type.MediaKind() != mediaType.MediaKind() {
+			return nil, fmt.Errorf("%w: message is %s", message.ErrMediaTypeMismatch, msg.Type)
+		}
+
+		media = msg.Content.Media
+		resolvedType = msg.Type
+	}
+
+	client, err := uc.whatsappManager.GetClient(sessionID)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to get WhatsApp client")
+		return nil, fmt.Errorf("failed to get WhatsApp client: %w", err)
+	}
+
+	content, err := client.DownloadMedia(ctx, sessionID, resolvedType, media)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"messageId": req.MessageID,
+		"mediaType": resolvedType,
+		"size":      content.Size,
+	}).Info().Msg("Media downloaded successfully")
+
+	return content, nil
+}
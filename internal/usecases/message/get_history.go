@@ -0,0 +1,136 @@
+package message
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 200
+)
+
+// GetMessageHistoryUseCase implementa o caso de uso para consultar o histórico de mensagens
+type GetMessageHistoryUseCase struct {
+	messageRepo message.MessageRepository
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewGetMessageHistoryUseCase cria uma nova instância do caso de uso
+func NewGetMessageHistoryUseCase(
+	messageRepo message.MessageRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *GetMessageHistoryUseCase {
+	return &GetMessageHistoryUseCase{
+		messageRepo: messageRepo,
+		sessionRepo: sessionRepo,
+		logger:      logger,
+	}
+}
+
+// Execute executa o caso de uso para consultar o histórico de mensagens
+func (uc *GetMessageHistoryUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.MessageHistoryRequest) (*message.MessageHistoryResponse, error) {
+	filter, err := uc.buildFilter(sessionID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	// Resolver os cursores para a posição da mensagem de referência
+	if req.Before != "" {
+		if filter.Before, err = uc.messageRepo.GetByMessageID(ctx, sessionID, req.Before); err != nil {
+			return nil, err
+		}
+	}
+	if req.After != "" {
+		if filter.After, err = uc.messageRepo.GetByMessageID(ctx, sessionID, req.After); err != nil {
+			return nil, err
+		}
+	}
+
+	// Buscar um item a mais para saber se há próxima página
+	limit := filter.Limit
+	filter.Limit = limit + 1
+
+	messages, err := uc.messageRepo.List(ctx, filter)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list message history from database")
+		return nil, err
+	}
+
+	hasMore := len(messages) > limit
+	if hasMore {
+		// Com after a lista vem invertida: o item excedente é o mais recente, no início
+		if filter.After != nil {
+			messages = messages[1:]
+		} else {
+			messages = messages[:limit]
+		}
+	}
+
+	infos := make([]message.MessageInfo, 0, len(messages))
+	for _, msg := range messages {
+		infos = append(infos, msg.ToInfo())
+	}
+
+	return &message.MessageHistoryResponse{
+		Messages:   infos,
+		TotalCount: len(infos),
+		HasMore:    hasMore,
+	}, nil
+}
+
+// buildFilter valida a requisição e monta o filtro de consulta
+func (uc *GetMessageHistoryUseCase) buildFilter(sessionID uuid.UUID, req message.MessageHistoryRequest) (message.MessageFilter, error) {
+	if req.Before != "" && req.After != "" {
+		return message.MessageFilter{}, message.ErrConflictingCursors
+	}
+
+	direction := message.Direction(req.Direction)
+	if direction != "" && !direction.IsValid() {
+		return message.MessageFilter{}, message.ErrInvalidDirection
+	}
+
+	msgType := message.InboundMessageType(req.Type)
+	if msgType != "" && !msgType.IsValid() {
+		return message.MessageFilter{}, message.ErrInvalidMessageType
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Aceitar tanto JIDs (contatos e grupos) quanto números de telefone
+	chatJID := req.ChatID
+	if chatJID != "" && !strings.Contains(chatJID, "@") {
+		chatJID = NewNumberValidator().NormalizeNumber(chatJID)
+	}
+
+	return message.MessageFilter{
+		SessionID: sessionID,
+		ChatJID:   chatJID,
+		Type:      msgType,
+		Direction: direction,
+		Limit:     limit,
+		Offset:    offset,
+	}, nil
+}
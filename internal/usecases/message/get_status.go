@@ -0,0 +1,61 @@
+package message
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// GetMessageStatusUseCase implementa o caso de uso para consultar o status de entrega de uma mensagem
+type GetMessageStatusUseCase struct {
+	messageRepo message.MessageRepository
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewGetMessageStatusUseCase cria uma nova instância do caso de uso
+func NewGetMessageStatusUseCase(
+	messageRepo message.MessageRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *GetMessageStatusUseCase {
+	return &GetMessageStatusUseCase{
+		messageRepo: messageRepo,
+		sessionRepo: sessionRepo,
+		logger:      logger,
+	}
+}
+
+// Execute executa o caso de uso para consultar o status de entrega de uma mensagem
+func (uc *GetMessageStatusUseCase) Execute(ctx context.Context, sessionID uuid.UUID, messageID string) (*message.MessageStatusResponse, error) {
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	msg, err := uc.messageRepo.GetByMessageID(ctx, sessionID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := uc.messageRepo.ListReceipts(ctx, sessionID, messageID)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list message receipts from database")
+		return nil, err
+	}
+	if receipts == nil {
+		receipts = []*message.MessageReceipt{}
+	}
+
+	return &message.MessageStatusResponse{
+		MessageID:  msg.MessageID,
+		Chat:       msg.ChatJID,
+		Direction:  msg.Direction,
+		Status:     msg.Status,
+		UpdatedAt:  msg.StatusUpdatedAt,
+		Recipients: receipts,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// MarkReadUseCase implementa o caso de uso para marcar mensagens como lidas
+type MarkReadUseCase struct {
+	sessionRepo     session.SessionRepository
+	messageRepo     message.MessageRepository
+	whatsappManager whatsapp.WhatsAppManager
+	logger          logger.Logger
+	numberValidator *NumberValidator
+}
+
+// NewMarkReadUseCase cria uma nova instância do caso de uso
+func NewMarkReadUseCase(
+	sessionRepo session.SessionRepository,
+	messageRepo message.MessageRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	logger logger.Logger,
+) *MarkReadUseCase {
+	return &MarkReadUseCase{
+		sessionRepo:     sessionRepo,
+		messageRepo:     messageRepo,
+		whatsappManager: whatsappManager,
+		logger:          logger,
+		numberValidator: NewNumberValidator(),
+	}
+}
+
+// Execute executa o caso de uso para marcar mensagens como lidas.
+// Em grupos o recibo de leitura precisa do remetente de cada mensagem: quando sender não é
+// informado, ele é obtido do histórico e as mensagens são agrupadas por remetente.
+func (uc *MarkReadUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.MarkReadRequest) (*message.MarkReadResponse, error) {
+	// Validar entrada
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return nil, fmt.Errorf("%w: %v", message.ErrInvalidDestination, err)
+	}
+	if len(req.MessageIDs) == 0 {
+		return nil, message.ErrMessageIDsRequired
+	}
+
+	chat := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Verificar se a sessão existe
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to get session")
+		return nil, err
+	}
+
+	// Verificar se a sessão está conectada
+	if !uc.whatsappManager.IsConnected(sessionID) {
+		return nil, session.ErrSessionNotConnected
+	}
+
+	bySender, err := uc.groupBySender(ctx, sessionID, chat, req)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uc.whatsappManager.GetClient(sessionID)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to get WhatsApp client")
+		return nil, fmt.Errorf("failed to get WhatsApp client: %w", err)
+	}
+
+	readAt := time.Now()
+	for sender, ids := range bySender {
+		if err := client.MarkRead(ctx, sessionID, chat, sender, ids, readAt); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"chat":      chat,
+		"count":     len(req.MessageIDs),
+	}).Info().Msg("Messages marked as read")
+
+	return &message.MarkReadResponse{
+		Chat:       chat,
+		MessageIDs: req.MessageIDs,
+		ReadAt:     readAt,
+	}, nil
+}
+
+// groupBySender agrupa os IDs por remetente; fora de grupos o remetente não é necessário
+func (uc *MarkReadUseCase) groupBySender(ctx context.Context, sessionID uuid.UUID, chat string, req message.MarkReadRequest) (map[string][]string, error) {
+	if !strings.HasSuffix(chat, "@g.us") || req.Sender != "" {
+		return map[string][]string{req.Sender: req.MessageIDs}, nil
+	}
+
+	bySender := make(map[string][]string)
+	for _, id := range req.MessageIDs {
+		msg, err := uc.messageRepo.GetByMessageID(ctx, sessionID, id)
+		if err != nil {
+			if errors.Is(err, message.ErrMessageNotFound) {
+				return nil, fmt.Errorf("%w: %s", message.ErrSenderRequired, id)
+			}
+			return nil, err
+		}
+		bySender[msg.SenderJID] = append(bySender[msg.SenderJID], id)
+	}
+	return bySender, nil
+}
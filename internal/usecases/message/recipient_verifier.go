@@ -0,0 +1,88 @@
+package message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.mau.fi/whatsmeow/types"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/infra/whatsapp/services"
+	"zmeow/pkg/logger"
+)
+
+// RecipientVerifier confirma antes do envio que o número de destino tem conta no WhatsApp e o substitui
+// pelo JID canônico da conta. As consultas usam o mesmo cache da API de contatos.
+type RecipientVerifier struct {
+	whatsappManager whatsapp.WhatsAppManager
+	cache           *services.ContactCache
+	logger          logger.Logger
+}
+
+// NewRecipientVerifier cria uma nova instância do RecipientVerifier
+func NewRecipientVerifier(whatsappManager whatsapp.WhatsAppManager, cache *services.ContactCache, logger logger.Logger) *RecipientVerifier {
+	return &RecipientVerifier{
+		whatsappManager: whatsappManager,
+		cache:           cache,
+		logger:          logger,
+	}
+}
+
+// Resolve retorna o destino a usar no envio. Sem verify, ou para destinos que não são números de telefone
+// (grupos, @lid, newsletters), o destino é retornado sem alteração. Números sem conta retornam
+// message.ErrRecipientNotOnWhatsApp.
+func (v *RecipientVerifier) Resolve(ctx context.Context, sessionID uuid.UUID, destination string, verify bool) (string, error) {
+	if v == nil || !verify {
+		return destination, nil
+	}
+
+	jid, err := types.ParseJID(destination)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", message.ErrInvalidDestination, err)
+	}
+	if jid.Server != types.DefaultUserServer {
+		return destination, nil
+	}
+
+	candidates := recipientCandidates(jid.User)
+	results, err := services.NewContactService(v.whatsappManager, v.cache, sessionID, v.logger).Check(ctx, candidates)
+	if err != nil {
+		return "", fmt.Errorf("failed to verify recipient: %w", err)
+	}
+
+	for _, result := range results {
+		if result.IsOnWhatsApp && result.JID != "" {
+			if result.JID != destination {
+				v.logger.WithFields(map[string]interface{}{
+					"sessionId":   sessionID,
+					"destination": destination,
+					"resolved":    result.JID,
+				}).Info().Msg("Recipient resolved to a different JID")
+			}
+			return result.JID, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", message.ErrRecipientNotOnWhatsApp, jid.User)
+}
+
+// recipientCandidates retorna as variantes do número a verificar, na ordem de preferência.
+// Celulares brasileiros podem estar registrados com ou sem o nono dígito, conforme a época do cadastro:
+// 55 + DDD + 9XXXXXXXX também é consultado sem o 9, e 55 + DDD + XXXXXXXX de celular (6 a 9) com o 9.
+func recipientCandidates(number string) []string {
+	candidates := []string{number}
+	if len(number) < 4 || number[:2] != "55" {
+		return candidates
+	}
+
+	prefix, local := number[:4], number[4:]
+	switch {
+	case len(local) == 9 && local[0] == '9':
+		candidates = append(candidates, prefix+local[1:])
+	case len(local) == 8 && local[0] >= '6' && local[0] <= '9':
+		candidates = append(candidates, prefix+"9"+local)
+	}
+	return candidates
+}
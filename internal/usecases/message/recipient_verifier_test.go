@@ -0,0 +1,76 @@
+package message
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/message"
+	"zmeow/pkg/logger"
+)
+
+func TestRecipientCandidates(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   []string
+	}{
+		{name: "brazilian mobile with ninth digit", number: "5511987654321", want: []string{"5511987654321", "551187654321"}},
+		{name: "brazilian mobile without ninth digit", number: "551187654321", want: []string{"551187654321", "5511987654321"}},
+		{name: "brazilian mobile starting with 6", number: "552167654321", want: []string{"552167654321", "5521967654321"}},
+		{name: "brazilian landline", number: "551133334444", want: []string{"551133334444"}},
+		{name: "brazilian landline starting with 5", number: "551153334444", want: []string{"551153334444"}},
+		{name: "nine digits not starting with 9", number: "5511887654321", want: []string{"5511887654321"}},
+		{name: "too short local number", number: "55119876543", want: []string{"55119876543"}},
+		{name: "too long local number", number: "55119876543210", want: []string{"55119876543210"}},
+		{name: "other country", number: "14155552671", want: []string{"14155552671"}},
+		{name: "other country with 55 inside", number: "4455987654321", want: []string{"4455987654321"}},
+		{name: "shorter than prefix", number: "551", want: []string{"551"}},
+		{name: "empty", number: "", want: []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := recipientCandidates(tt.number); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("recipientCandidates(%q) = %v, want %v", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecipientVerifierResolveWithoutLookup(t *testing.T) {
+	nop := zerolog.Nop()
+	verifier := NewRecipientVerifier(nil, nil, logger.NewZerologLogger(&nop))
+
+	tests := []struct {
+		name        string
+		verifier    *RecipientVerifier
+		destination string
+		verify      bool
+		want        string
+		wantErr     error
+	}{
+		{name: "verification disabled", verifier: verifier, destination: "5511987654321@s.whatsapp.net", want: "5511987654321@s.whatsapp.net"},
+		{name: "nil verifier", destination: "5511987654321@s.whatsapp.net", verify: true, want: "5511987654321@s.whatsapp.net"},
+		{name: "group", verifier: verifier, destination: "120363025246125486@g.us", verify: true, want: "120363025246125486@g.us"},
+		{name: "lid", verifier: verifier, destination: "123456789012345@lid", verify: true, want: "123456789012345@lid"},
+		{name: "newsletter", verifier: verifier, destination: "120363144038483540@newsletter", verify: true, want: "120363144038483540@newsletter"},
+		{name: "invalid destination", verifier: verifier, destination: "5511987654321:x@s.whatsapp.net", verify: true, wantErr: message.ErrInvalidDestination},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.verifier.Resolve(context.Background(), uuid.New(), tt.destination, tt.verify)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,8 +3,6 @@ package message
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
 
 	"github.com/google/uuid"
 
@@ -16,21 +14,26 @@ import (
 
 // SendButtonsMessageUseCase implementa o caso de uso para envio de mensagem com botões
 type SendButtonsMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendButtonsMessageUseCase cria uma nova instância do caso de uso
 func NewSendButtonsMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendButtonsMessageUseCase {
 	return &SendButtonsMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -38,14 +41,15 @@ func NewSendButtonsMessageUseCase(
 func (uc *SendButtonsMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.SendButtonsMessageRequest) (*message.SendMessageResponse, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId":   sessionID,
-		"to":          req.To,
+		"number":      req.Number,
+		"groupJid":    req.GroupJid,
 		"text":        req.Text,
 		"footer":      req.Footer,
 		"buttonCount": len(req.Buttons),
 	}).Info().Msg("Sending buttons message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -63,8 +67,15 @@ func (uc *SendButtonsMessageUseCase) Execute(ctx context.Context, sessionID uuid
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
-	// Normalizar número de telefone
-	normalizedPhone := uc.normalizePhoneNumber(req.To)
+	// Obter destinatário
+	normalizedPhone := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	normalizedPhone, err = uc.recipientVerifier.Resolve(ctx, sessionID, normalizedPhone, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
 
 	// Obter cliente WhatsApp
 	client, err := uc.whatsappManager.GetClient(sessionID)
@@ -74,7 +85,7 @@ func (uc *SendButtonsMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	}
 
 	// Enviar mensagem com botões
-	messageID, err := client.SendButtonsMessage(ctx, sessionID, normalizedPhone, req.Text, req.Footer, req.Buttons)
+	messageID, err := client.SendButtonsMessage(ctx, sessionID, normalizedPhone, req.Text, req.Footer, req.Buttons, req.ContextInfo)
 	if err != nil {
 		uc.logger.WithError(err).Error().Msg("Failed to send buttons message")
 		return nil, fmt.Errorf("failed to send buttons message: %w", err)
@@ -89,7 +100,7 @@ func (uc *SendButtonsMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"to":          normalizedPhone,
 			"sessionId":   sessionID,
@@ -101,10 +112,19 @@ func (uc *SendButtonsMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendButtonsMessageUseCase) Validate(req message.SendButtonsMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de mensagem com botões
 func (uc *SendButtonsMessageUseCase) validateRequest(req message.SendButtonsMessageRequest) error {
-	if req.To == "" {
-		return fmt.Errorf("to is required")
+	// Validar destinatário (number ou groupJid)
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return err
 	}
 
 	if req.Text == "" {
@@ -159,53 +179,5 @@ func (uc *SendButtonsMessageUseCase) validateRequest(req message.SendButtonsMess
 		}
 	}
 
-	// Validar formato do telefone
-	if !uc.isValidPhoneNumber(req.To) {
-		return fmt.Errorf("invalid phone number format")
-	}
-
 	return nil
 }
-
-// isValidPhoneNumber valida o formato do número de telefone
-func (uc *SendButtonsMessageUseCase) isValidPhoneNumber(phone string) bool {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Verificar se tem pelo menos 10 dígitos e no máximo 15
-	if len(cleaned) < 10 || len(cleaned) > 15 {
-		return false
-	}
-
-	// Verificar se começa com código de país válido
-	if len(cleaned) >= 11 && (strings.HasPrefix(cleaned, "55") || strings.HasPrefix(cleaned, "1")) {
-		return true
-	}
-
-	// Aceitar números com 10-11 dígitos (formato nacional)
-	if len(cleaned) >= 10 && len(cleaned) <= 11 {
-		return true
-	}
-
-	return false
-}
-
-// normalizePhoneNumber normaliza o número de telefone para o formato WhatsApp
-func (uc *SendButtonsMessageUseCase) normalizePhoneNumber(phone string) string {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Se não tem código de país, assumir Brasil (55)
-	if len(cleaned) == 10 || len(cleaned) == 11 {
-		if !strings.HasPrefix(cleaned, "55") {
-			cleaned = "55" + cleaned
-		}
-	}
-
-	// Adicionar sufixo @s.whatsapp.net se não estiver presente
-	if !strings.Contains(phone, "@") {
-		return cleaned + "@s.whatsapp.net"
-	}
-
-	return phone
-}
@@ -16,21 +16,26 @@ import (
 
 // SendContactMessageUseCase implementa o caso de uso para envio de contato
 type SendContactMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendContactMessageUseCase cria uma nova instância do caso de uso
 func NewSendContactMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendContactMessageUseCase {
 	return &SendContactMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -38,13 +43,14 @@ func NewSendContactMessageUseCase(
 func (uc *SendContactMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.SendContactMessageRequest) (*message.SendMessageResponse, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId":   sessionID,
-		"to":          req.To,
+		"number":      req.Number,
+		"groupJid":    req.GroupJid,
 		"contactName": req.ContactName,
 		"contactJID":  req.ContactJID,
 	}).Info().Msg("Sending contact message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -62,8 +68,15 @@ func (uc *SendContactMessageUseCase) Execute(ctx context.Context, sessionID uuid
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
-	// Normalizar número de telefone
-	normalizedPhone := uc.normalizePhoneNumber(req.To)
+	// Obter destinatário
+	normalizedPhone := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	normalizedPhone, err = uc.recipientVerifier.Resolve(ctx, sessionID, normalizedPhone, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
 	normalizedContactJID := uc.normalizePhoneNumber(req.ContactJID)
 
 	// Obter cliente WhatsApp
@@ -74,7 +87,7 @@ func (uc *SendContactMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	}
 
 	// Enviar contato
-	messageID, err := client.SendContactMessage(ctx, sessionID, normalizedPhone, req.ContactName, normalizedContactJID)
+	messageID, err := client.SendContactMessage(ctx, sessionID, normalizedPhone, req.ContactName, normalizedContactJID, req.ContextInfo)
 	if err != nil {
 		uc.logger.WithError(err).Error().Msg("Failed to send contact message")
 		return nil, fmt.Errorf("failed to send contact: %w", err)
@@ -89,7 +102,7 @@ func (uc *SendContactMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"to":          normalizedPhone,
 			"sessionId":   sessionID,
@@ -102,10 +115,19 @@ func (uc *SendContactMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendContactMessageUseCase) Validate(req message.SendContactMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de contato
 func (uc *SendContactMessageUseCase) validateRequest(req message.SendContactMessageRequest) error {
-	if req.To == "" {
-		return fmt.Errorf("to is required")
+	// Validar destinatário (number ou groupJid)
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return err
 	}
 
 	if req.ContactName == "" {
@@ -116,11 +138,6 @@ func (uc *SendContactMessageUseCase) validateRequest(req message.SendContactMess
 		return fmt.Errorf("contact JID is required")
 	}
 
-	// Validar formato do telefone
-	if !uc.isValidPhoneNumber(req.To) {
-		return fmt.Errorf("invalid phone number format")
-	}
-
 	// Validar formato do JID do contato
 	if !uc.isValidPhoneNumber(req.ContactJID) {
 		return fmt.Errorf("invalid contact JID format")
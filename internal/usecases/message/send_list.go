@@ -3,8 +3,6 @@ package message
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
 
 	"github.com/google/uuid"
 
@@ -16,21 +14,26 @@ import (
 
 // SendListMessageUseCase implementa o caso de uso para envio de mensagem com lista
 type SendListMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendListMessageUseCase cria uma nova instância do caso de uso
 func NewSendListMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendListMessageUseCase {
 	return &SendListMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -38,7 +41,8 @@ func NewSendListMessageUseCase(
 func (uc *SendListMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.SendListMessageRequest) (*message.SendMessageResponse, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId":    sessionID,
-		"to":           req.To,
+		"number":       req.Number,
+		"groupJid":     req.GroupJid,
 		"text":         req.Text,
 		"footer":       req.Footer,
 		"title":        req.Title,
@@ -47,7 +51,7 @@ func (uc *SendListMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	}).Info().Msg("Sending list message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -65,8 +69,15 @@ func (uc *SendListMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
-	// Normalizar número de telefone
-	normalizedPhone := uc.normalizePhoneNumber(req.To)
+	// Obter destinatário
+	normalizedPhone := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	normalizedPhone, err = uc.recipientVerifier.Resolve(ctx, sessionID, normalizedPhone, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
 
 	// Obter cliente WhatsApp
 	client, err := uc.whatsappManager.GetClient(sessionID)
@@ -76,7 +87,7 @@ func (uc *SendListMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	}
 
 	// Enviar mensagem com lista
-	messageID, err := client.SendListMessage(ctx, sessionID, normalizedPhone, req.Text, req.Footer, req.Title, req.ButtonText, req.Sections)
+	messageID, err := client.SendListMessage(ctx, sessionID, normalizedPhone, req.Text, req.Footer, req.Title, req.ButtonText, req.Sections, req.ContextInfo)
 	if err != nil {
 		uc.logger.WithError(err).Error().Msg("Failed to send list message")
 		return nil, fmt.Errorf("failed to send list message: %w", err)
@@ -97,7 +108,7 @@ func (uc *SendListMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"to":           normalizedPhone,
 			"sessionId":    sessionID,
@@ -110,10 +121,19 @@ func (uc *SendListMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendListMessageUseCase) Validate(req message.SendListMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de mensagem com lista
 func (uc *SendListMessageUseCase) validateRequest(req message.SendListMessageRequest) error {
-	if req.To == "" {
-		return fmt.Errorf("to is required")
+	// Validar destinatário (number ou groupJid)
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return err
 	}
 
 	if req.Text == "" {
@@ -202,53 +222,5 @@ func (uc *SendListMessageUseCase) validateRequest(req message.SendListMessageReq
 		}
 	}
 
-	// Validar formato do telefone
-	if !uc.isValidPhoneNumber(req.To) {
-		return fmt.Errorf("invalid phone number format")
-	}
-
 	return nil
 }
-
-// isValidPhoneNumber valida o formato do número de telefone
-func (uc *SendListMessageUseCase) isValidPhoneNumber(phone string) bool {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Verificar se tem pelo menos 10 dígitos e no máximo 15
-	if len(cleaned) < 10 || len(cleaned) > 15 {
-		return false
-	}
-
-	// Verificar se começa com código de país válido
-	if len(cleaned) >= 11 && (strings.HasPrefix(cleaned, "55") || strings.HasPrefix(cleaned, "1")) {
-		return true
-	}
-
-	// Aceitar números com 10-11 dígitos (formato nacional)
-	if len(cleaned) >= 10 && len(cleaned) <= 11 {
-		return true
-	}
-
-	return false
-}
-
-// normalizePhoneNumber normaliza o número de telefone para o formato WhatsApp
-func (uc *SendListMessageUseCase) normalizePhoneNumber(phone string) string {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Se não tem código de país, assumir Brasil (55)
-	if len(cleaned) == 10 || len(cleaned) == 11 {
-		if !strings.HasPrefix(cleaned, "55") {
-			cleaned = "55" + cleaned
-		}
-	}
-
-	// Adicionar sufixo @s.whatsapp.net se não estiver presente
-	if !strings.Contains(phone, "@") {
-		return cleaned + "@s.whatsapp.net"
-	}
-
-	return phone
-}
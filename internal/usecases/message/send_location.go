@@ -3,8 +3,6 @@ package message
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
 
 	"github.com/google/uuid"
 
@@ -16,21 +14,26 @@ import (
 
 // SendLocationMessageUseCase implementa o caso de uso para envio de localização
 type SendLocationMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendLocationMessageUseCase cria uma nova instância do caso de uso
 func NewSendLocationMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendLocationMessageUseCase {
 	return &SendLocationMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -38,7 +41,8 @@ func NewSendLocationMessageUseCase(
 func (uc *SendLocationMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.SendLocationMessageRequest) (*message.SendMessageResponse, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId": sessionID,
-		"to":        req.To,
+		"number":    req.Number,
+		"groupJid":  req.GroupJid,
 		"latitude":  req.Latitude,
 		"longitude": req.Longitude,
 		"name":      req.Name,
@@ -46,7 +50,7 @@ func (uc *SendLocationMessageUseCase) Execute(ctx context.Context, sessionID uui
 	}).Info().Msg("Sending location message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -64,8 +68,15 @@ func (uc *SendLocationMessageUseCase) Execute(ctx context.Context, sessionID uui
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
-	// Normalizar número de telefone
-	normalizedPhone := uc.normalizePhoneNumber(req.To)
+	// Obter destinatário
+	normalizedPhone := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	normalizedPhone, err = uc.recipientVerifier.Resolve(ctx, sessionID, normalizedPhone, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
 
 	// Obter cliente WhatsApp
 	client, err := uc.whatsappManager.GetClient(sessionID)
@@ -75,7 +86,7 @@ func (uc *SendLocationMessageUseCase) Execute(ctx context.Context, sessionID uui
 	}
 
 	// Enviar localização
-	messageID, err := client.SendLocationMessage(ctx, sessionID, normalizedPhone, req.Latitude, req.Longitude, req.Name, req.Address)
+	messageID, err := client.SendLocationMessage(ctx, sessionID, normalizedPhone, req.Latitude, req.Longitude, req.Name, req.Address, req.ContextInfo)
 	if err != nil {
 		uc.logger.WithError(err).Error().Msg("Failed to send location message")
 		return nil, fmt.Errorf("failed to send location: %w", err)
@@ -90,7 +101,7 @@ func (uc *SendLocationMessageUseCase) Execute(ctx context.Context, sessionID uui
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"to":        normalizedPhone,
 			"sessionId": sessionID,
@@ -105,10 +116,19 @@ func (uc *SendLocationMessageUseCase) Execute(ctx context.Context, sessionID uui
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendLocationMessageUseCase) Validate(req message.SendLocationMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de localização
 func (uc *SendLocationMessageUseCase) validateRequest(req message.SendLocationMessageRequest) error {
-	if req.To == "" {
-		return fmt.Errorf("to is required")
+	// Validar destinatário (number ou groupJid)
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return err
 	}
 
 	if req.Latitude < -90 || req.Latitude > 90 {
@@ -119,43 +139,5 @@ func (uc *SendLocationMessageUseCase) validateRequest(req message.SendLocationMe
 		return fmt.Errorf("invalid longitude: must be between -180 and 180")
 	}
 
-	// Validar formato do telefone
-	if !uc.isValidPhoneNumber(req.To) {
-		return fmt.Errorf("invalid phone number format")
-	}
-
 	return nil
 }
-
-// isValidPhoneNumber valida o formato do número de telefone
-func (uc *SendLocationMessageUseCase) isValidPhoneNumber(phone string) bool {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Verificar se tem pelo menos 10 dígitos e no máximo 15
-	if len(cleaned) < 10 || len(cleaned) > 15 {
-		return false
-	}
-
-	return true
-}
-
-// normalizePhoneNumber normaliza o número de telefone para o formato WhatsApp
-func (uc *SendLocationMessageUseCase) normalizePhoneNumber(phone string) string {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Se não tem código de país, assumir Brasil (55)
-	if len(cleaned) == 10 || len(cleaned) == 11 {
-		if !strings.HasPrefix(cleaned, "55") {
-			cleaned = "55" + cleaned
-		}
-	}
-
-	// Adicionar sufixo @s.whatsapp.net se não estiver presente
-	if !strings.Contains(phone, "@") {
-		return cleaned + "@s.whatsapp.net"
-	}
-
-	return phone
-}
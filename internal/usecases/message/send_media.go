@@ -18,23 +18,26 @@ import (
 
 // SendMediaMessageUseCase implementa o caso de uso para envio de mídia
 type SendMediaMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
-	numberValidator *NumberValidator
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendMediaMessageUseCase cria uma nova instância do caso de uso
 func NewSendMediaMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendMediaMessageUseCase {
 	return &SendMediaMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
-		numberValidator: NewNumberValidator(),
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -55,7 +58,7 @@ func (uc *SendMediaMessageUseCase) Execute(ctx context.Context, sessionID uuid.U
 	}).Info().Msg("Sending media message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -73,6 +76,13 @@ func (uc *SendMediaMessageUseCase) Execute(ctx context.Context, sessionID uuid.U
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	destination, err = uc.recipientVerifier.Resolve(ctx, sessionID, destination, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
+
 	// Verificar se é URL ou dados Base64
 	var mediaData []byte
 	isURL := strings.HasPrefix(req.Media, "http://") || strings.HasPrefix(req.Media, "https://")
@@ -104,10 +114,10 @@ func (uc *SendMediaMessageUseCase) Execute(ctx context.Context, sessionID uuid.U
 	var messageID string
 	if isURL {
 		// Para URLs, usar método específico que baixa e envia
-		messageID, err = client.SendMediaFromURL(ctx, sessionID, destination, req.MediaType, req.Media, req.Caption, req.FileName, mimeType)
+		messageID, err = client.SendMediaFromURL(ctx, sessionID, destination, req.MediaType, req.Media, req.Caption, req.FileName, mimeType, req.ContextInfo)
 	} else {
 		// Para dados Base64, usar método tradicional
-		messageID, err = client.SendMediaMessage(ctx, sessionID, destination, req.MediaType, mediaData, req.Caption, req.FileName, mimeType)
+		messageID, err = client.SendMediaMessage(ctx, sessionID, destination, req.MediaType, mediaData, req.Caption, req.FileName, mimeType, req.ContextInfo)
 	}
 
 	if err != nil {
@@ -125,7 +135,7 @@ func (uc *SendMediaMessageUseCase) Execute(ctx context.Context, sessionID uuid.U
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"number":      req.Number,
 			"groupJid":    req.GroupJid,
@@ -141,6 +151,14 @@ func (uc *SendMediaMessageUseCase) Execute(ctx context.Context, sessionID uuid.U
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendMediaMessageUseCase) Validate(req message.SendMediaMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de mídia
 func (uc *SendMediaMessageUseCase) validateRequest(req message.SendMediaMessageRequest) error {
 	// Validar destinatário (number ou groupJid)
@@ -3,8 +3,6 @@ package message
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
 
 	"github.com/google/uuid"
 
@@ -16,21 +14,26 @@ import (
 
 // SendPollMessageUseCase implementa o caso de uso para envio de enquete
 type SendPollMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendPollMessageUseCase cria uma nova instância do caso de uso
 func NewSendPollMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendPollMessageUseCase {
 	return &SendPollMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -38,14 +41,15 @@ func NewSendPollMessageUseCase(
 func (uc *SendPollMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.SendPollMessageRequest) (*message.SendMessageResponse, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId":       sessionID,
-		"to":              req.To,
+		"number":          req.Number,
+		"groupJid":        req.GroupJid,
 		"name":            req.Name,
 		"optionCount":     len(req.Options),
 		"selectableCount": req.SelectableOptionsCount,
 	}).Info().Msg("Sending poll message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -63,8 +67,15 @@ func (uc *SendPollMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
-	// Normalizar número de telefone
-	normalizedPhone := uc.normalizePhoneNumber(req.To)
+	// Obter destinatário
+	normalizedPhone := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	normalizedPhone, err = uc.recipientVerifier.Resolve(ctx, sessionID, normalizedPhone, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
 
 	// Obter cliente WhatsApp
 	client, err := uc.whatsappManager.GetClient(sessionID)
@@ -74,7 +85,7 @@ func (uc *SendPollMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	}
 
 	// Enviar enquete
-	messageID, err := client.SendPollMessage(ctx, sessionID, normalizedPhone, req.Name, req.Options, req.SelectableOptionsCount)
+	messageID, err := client.SendPollMessage(ctx, sessionID, normalizedPhone, req.Name, req.Options, req.SelectableOptionsCount, req.ContextInfo)
 	if err != nil {
 		uc.logger.WithError(err).Error().Msg("Failed to send poll message")
 		return nil, fmt.Errorf("failed to send poll: %w", err)
@@ -89,7 +100,7 @@ func (uc *SendPollMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"to":              normalizedPhone,
 			"sessionId":       sessionID,
@@ -102,10 +113,19 @@ func (uc *SendPollMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendPollMessageUseCase) Validate(req message.SendPollMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de enquete
 func (uc *SendPollMessageUseCase) validateRequest(req message.SendPollMessageRequest) error {
-	if req.To == "" {
-		return fmt.Errorf("to is required")
+	// Validar destinatário (number ou groupJid)
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return err
 	}
 
 	if req.Name == "" {
@@ -152,94 +172,5 @@ func (uc *SendPollMessageUseCase) validateRequest(req message.SendPollMessageReq
 		optionTexts[option] = true
 	}
 
-	// Validar formato do telefone ou JID de grupo
-	if !uc.isValidPhoneNumberOrGroupJID(req.To) {
-		return fmt.Errorf("invalid phone number or group JID format")
-	}
-
 	return nil
 }
-
-// isValidPhoneNumberOrGroupJID valida o formato do número de telefone ou JID de grupo
-func (uc *SendPollMessageUseCase) isValidPhoneNumberOrGroupJID(input string) bool {
-	// Se contém @, é um JID - validar como JID
-	if strings.Contains(input, "@") {
-		return uc.isValidJID(input)
-	}
-
-	// Se não contém @, é um número - validar como número
-	return uc.isValidPhoneNumber(input)
-}
-
-// isValidJID valida o formato de um JID (tanto individual quanto grupo)
-func (uc *SendPollMessageUseCase) isValidJID(jid string) bool {
-	// Verificar formato básico
-	if !strings.Contains(jid, "@") {
-		return false
-	}
-
-	// Aceitar tanto @s.whatsapp.net (individual) quanto @g.us (grupo)
-	if strings.HasSuffix(jid, "@s.whatsapp.net") || strings.HasSuffix(jid, "@g.us") {
-		// Extrair a parte antes do @
-		parts := strings.Split(jid, "@")
-		if len(parts) != 2 {
-			return false
-		}
-
-		numberPart := parts[0]
-
-		// Para grupos, aceitar números longos
-		if strings.HasSuffix(jid, "@g.us") {
-			// Grupos podem ter números mais longos
-			return len(numberPart) >= 10 && len(numberPart) <= 25
-		}
-
-		// Para indivíduos, usar validação normal
-		return uc.isValidPhoneNumber(numberPart)
-	}
-
-	return false
-}
-
-// isValidPhoneNumber valida o formato do número de telefone
-func (uc *SendPollMessageUseCase) isValidPhoneNumber(phone string) bool {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Verificar se tem pelo menos 10 dígitos e no máximo 15
-	if len(cleaned) < 10 || len(cleaned) > 15 {
-		return false
-	}
-
-	// Verificar se começa com código de país válido
-	if len(cleaned) >= 11 && (strings.HasPrefix(cleaned, "55") || strings.HasPrefix(cleaned, "1")) {
-		return true
-	}
-
-	// Aceitar números com 10-11 dígitos (formato nacional)
-	if len(cleaned) >= 10 && len(cleaned) <= 11 {
-		return true
-	}
-
-	return false
-}
-
-// normalizePhoneNumber normaliza o número de telefone para o formato WhatsApp
-func (uc *SendPollMessageUseCase) normalizePhoneNumber(phone string) string {
-	// Remover caracteres não numéricos
-	cleaned := regexp.MustCompile(`[^\d]`).ReplaceAllString(phone, "")
-
-	// Se não tem código de país, assumir Brasil (55)
-	if len(cleaned) == 10 || len(cleaned) == 11 {
-		if !strings.HasPrefix(cleaned, "55") {
-			cleaned = "55" + cleaned
-		}
-	}
-
-	// Adicionar sufixo @s.whatsapp.net se não estiver presente
-	if !strings.Contains(phone, "@") {
-		return cleaned + "@s.whatsapp.net"
-	}
-
-	return phone
-}
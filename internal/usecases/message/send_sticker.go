@@ -25,23 +25,26 @@ import (
 
 // SendStickerMessageUseCase implementa o caso de uso para envio de sticker
 type SendStickerMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
-	numberValidator *NumberValidator
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendStickerMessageUseCase cria uma nova instância do caso de uso
 func NewSendStickerMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendStickerMessageUseCase {
 	return &SendStickerMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
-		numberValidator: NewNumberValidator(),
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -49,12 +52,13 @@ func NewSendStickerMessageUseCase(
 func (uc *SendStickerMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req message.SendStickerMessageRequest) (*message.SendMessageResponse, error) {
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId": sessionID,
-		"to":        req.To,
+		"number":    req.Number,
+		"groupJid":  req.GroupJid,
 		"mimeType":  req.MimeType,
 	}).Info().Msg("Sending sticker message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -72,8 +76,15 @@ func (uc *SendStickerMessageUseCase) Execute(ctx context.Context, sessionID uuid
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
-	// Normalizar destinatário
-	normalizedTo := uc.toValidator.NormalizeTo(req.To)
+	// Obter destinatário
+	normalizedTo := uc.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	normalizedTo, err = uc.recipientVerifier.Resolve(ctx, sessionID, normalizedTo, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
 
 	// Obter dados do sticker (URL ou base64)
 	stickerData, err := uc.getStickerData(ctx, req.Sticker)
@@ -96,7 +107,7 @@ func (uc *SendStickerMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	}
 
 	// Enviar sticker
-	messageID, err := client.SendStickerMessage(ctx, sessionID, normalizedTo, stickerData, mimeType)
+	messageID, err := client.SendStickerMessage(ctx, sessionID, normalizedTo, stickerData, mimeType, req.ContextInfo)
 	if err != nil {
 		uc.logger.WithError(err).Error().Msg("Failed to send sticker message")
 		return nil, fmt.Errorf("failed to send sticker: %w", err)
@@ -111,7 +122,7 @@ func (uc *SendStickerMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"to":        normalizedTo,
 			"sessionId": sessionID,
@@ -123,21 +134,25 @@ func (uc *SendStickerMessageUseCase) Execute(ctx context.Context, sessionID uuid
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendStickerMessageUseCase) Validate(req message.SendStickerMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de sticker
 func (uc *SendStickerMessageUseCase) validateRequest(req message.SendStickerMessageRequest) error {
-	if req.To == "" {
-		return fmt.Errorf("to is required")
+	// Validar destinatário (number ou groupJid)
+	if err := uc.numberValidator.ValidateDestination(req.Number, req.GroupJid); err != nil {
+		return err
 	}
 
 	if req.Sticker == "" {
 		return fmt.Errorf("sticker data is required")
 	}
 
-	// Validar formato do destinatário
-	if !uc.toValidator.IsValidTo(req.To) {
-		return fmt.Errorf("invalid to format")
-	}
-
 	// Validar formato do sticker (base64 ou URL)
 	if !uc.isValidStickerData(req.Sticker) {
 		return fmt.Errorf("invalid sticker data format (must be base64, data URL, or HTTP URL)")
@@ -14,23 +14,26 @@ import (
 
 // SendTextMessageUseCase implementa o caso de uso para envio de mensagem de texto
 type SendTextMessageUseCase struct {
-	sessionRepo     session.SessionRepository
-	whatsappManager whatsapp.WhatsAppManager
-	logger          logger.Logger
-	numberValidator *NumberValidator
+	sessionRepo       session.SessionRepository
+	whatsappManager   whatsapp.WhatsAppManager
+	recipientVerifier *RecipientVerifier
+	logger            logger.Logger
+	numberValidator   *NumberValidator
 }
 
 // NewSendTextMessageUseCase cria uma nova instância do caso de uso
 func NewSendTextMessageUseCase(
 	sessionRepo session.SessionRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	recipientVerifier *RecipientVerifier,
 	logger logger.Logger,
 ) *SendTextMessageUseCase {
 	return &SendTextMessageUseCase{
-		sessionRepo:     sessionRepo,
-		whatsappManager: whatsappManager,
-		logger:          logger,
-		numberValidator: NewNumberValidator(),
+		sessionRepo:       sessionRepo,
+		whatsappManager:   whatsappManager,
+		recipientVerifier: recipientVerifier,
+		logger:            logger,
+		numberValidator:   NewNumberValidator(),
 	}
 }
 
@@ -48,7 +51,7 @@ func (uc *SendTextMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	}).Info().Msg("Sending text message")
 
 	// Validar entrada
-	if err := uc.validateRequest(req); err != nil {
+	if err := uc.Validate(req); err != nil {
 		uc.logger.WithError(err).Error().Msg("Invalid request")
 		return nil, err
 	}
@@ -66,6 +69,13 @@ func (uc *SendTextMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 		return nil, fmt.Errorf("session %s is not connected", sessionID)
 	}
 
+	// Confirmar que o destinatário tem conta no WhatsApp (opcional, verifyRecipient)
+	destination, err = uc.recipientVerifier.Resolve(ctx, sessionID, destination, req.VerifyRecipient)
+	if err != nil {
+		uc.logger.WithError(err).Warn().Msg("Recipient verification failed")
+		return nil, err
+	}
+
 	// Obter cliente WhatsApp
 	client, err := uc.whatsappManager.GetClient(sessionID)
 	if err != nil {
@@ -74,7 +84,7 @@ func (uc *SendTextMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	}
 
 	// Enviar mensagem
-	messageID, err := client.SendTextMessage(ctx, sessionID, destination, req.Text)
+	messageID, err := client.SendTextMessage(ctx, sessionID, destination, req.Text, req.ContextInfo)
 	if err != nil {
 		uc.logger.WithError(err).Error().Msg("Failed to send text message")
 		return nil, fmt.Errorf("failed to send message: %w", err)
@@ -89,7 +99,7 @@ func (uc *SendTextMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	// Criar resposta
 	response := &message.SendMessageResponse{
 		ID:     messageID,
-		Status: "sent",
+		Status: string(message.StatusSent),
 		Details: map[string]interface{}{
 			"number":      req.Number,
 			"groupJid":    req.GroupJid,
@@ -102,6 +112,14 @@ func (uc *SendTextMessageUseCase) Execute(ctx context.Context, sessionID uuid.UU
 	return response, nil
 }
 
+// Validate valida a requisição sem enviar nada; os erros envolvem message.ErrInvalidSendRequest
+func (uc *SendTextMessageUseCase) Validate(req message.SendTextMessageRequest) error {
+	if err := uc.validateRequest(req); err != nil {
+		return fmt.Errorf("%w: %v", message.ErrInvalidSendRequest, err)
+	}
+	return nil
+}
+
 // validateRequest valida a requisição de envio de mensagem
 func (uc *SendTextMessageUseCase) validateRequest(req message.SendTextMessageRequest) error {
 	// Validar destinatário (number ou groupJid)
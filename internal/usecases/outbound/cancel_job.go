@@ -0,0 +1,42 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/outbound"
+	"zmeow/pkg/logger"
+)
+
+// CancelJobUseCase implementa o caso de uso para cancelar um envio que ainda está na fila
+type CancelJobUseCase struct {
+	jobRepo outbound.JobRepository
+	logger  logger.Logger
+}
+
+// NewCancelJobUseCase cria uma nova instância do caso de uso
+func NewCancelJobUseCase(jobRepo outbound.JobRepository, logger logger.Logger) *CancelJobUseCase {
+	return &CancelJobUseCase{
+		jobRepo: jobRepo,
+		logger:  logger.WithComponent("cancel-outbound-job-usecase"),
+	}
+}
+
+// Execute cancela o job; jobs já enviados, falhos ou em envio retornam ErrJobNotCancelable
+func (uc *CancelJobUseCase) Execute(ctx context.Context, sessionID, jobID uuid.UUID) (*outbound.Job, error) {
+	job, err := uc.jobRepo.Cancel(ctx, sessionID, jobID)
+	if err != nil {
+		if err != outbound.ErrJobNotFound && err != outbound.ErrJobNotCancelable {
+			uc.logger.WithError(err).Error().Msg("Failed to cancel outbound job")
+		}
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"jobId":     jobID,
+	}).Info().Msg("Outbound job canceled")
+
+	return job, nil
+}
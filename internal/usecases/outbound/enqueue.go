@@ -0,0 +1,87 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/session"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/pkg/logger"
+)
+
+// EnqueueMessageUseCase implementa o caso de uso para colocar um envio na fila assíncrona da sessão
+type EnqueueMessageUseCase struct {
+	jobRepo     outbound.JobRepository
+	sessionRepo session.SessionRepository
+	queue       *Queue
+	dispatcher  *messageUseCases.SendDispatcher
+	logger      logger.Logger
+}
+
+// NewEnqueueMessageUseCase cria uma nova instância do caso de uso
+func NewEnqueueMessageUseCase(
+	jobRepo outbound.JobRepository,
+	sessionRepo session.SessionRepository,
+	queue *Queue,
+	dispatcher *messageUseCases.SendDispatcher,
+	logger logger.Logger,
+) *EnqueueMessageUseCase {
+	return &EnqueueMessageUseCase{
+		jobRepo:     jobRepo,
+		sessionRepo: sessionRepo,
+		queue:       queue,
+		dispatcher:  dispatcher,
+		logger:      logger.WithComponent("enqueue-message-usecase"),
+	}
+}
+
+// Execute valida a requisição, persiste o envio na fila e retorna o ID do job; o resultado chega pelo evento message.queue
+func (uc *EnqueueMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, kind outbound.Kind, req interface{}) (*outbound.EnqueueResponse, error) {
+	if !kind.IsValid() {
+		return nil, fmt.Errorf("%w: %s", outbound.ErrInvalidKind, kind)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", outbound.ErrInvalidPayload, err)
+	}
+
+	// Uma requisição inválida é recusada agora, e não aceita para falhar depois das novas tentativas da fila
+	if err := uc.dispatcher.ValidatePayload(kind, payload); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	job := &outbound.Job{
+		SessionID:   sessionID,
+		Kind:        kind,
+		Payload:     payload,
+		MaxAttempts: uc.queue.MaxAttempts(),
+	}
+	if err := uc.jobRepo.Enqueue(ctx, job); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to enqueue outbound message")
+		return nil, err
+	}
+
+	uc.queue.Notify()
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"jobId":     job.ID,
+		"kind":      kind,
+	}).Info().Msg("Message enqueued")
+
+	return &outbound.EnqueueResponse{
+		JobID:     job.ID,
+		SessionID: sessionID,
+		Kind:      kind,
+		Status:    job.Status,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/outbound"
+	"zmeow/pkg/logger"
+)
+
+// GetJobUseCase implementa o caso de uso para consultar um job da fila de envio
+type GetJobUseCase struct {
+	jobRepo outbound.JobRepository
+	logger  logger.Logger
+}
+
+// NewGetJobUseCase cria uma nova instância do caso de uso
+func NewGetJobUseCase(jobRepo outbound.JobRepository, logger logger.Logger) *GetJobUseCase {
+	return &GetJobUseCase{
+		jobRepo: jobRepo,
+		logger:  logger.WithComponent("get-outbound-job-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para obter o job com payload, tentativas e resultado
+func (uc *GetJobUseCase) Execute(ctx context.Context, sessionID, jobID uuid.UUID) (*outbound.Job, error) {
+	job, err := uc.jobRepo.GetByID(ctx, sessionID, jobID)
+	if err != nil {
+		if err != outbound.ErrJobNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get outbound job from database")
+		}
+		return nil, err
+	}
+
+	return job, nil
+}
@@ -0,0 +1,87 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+const (
+	defaultJobsLimit = 50
+	maxJobsLimit     = 200
+)
+
+// ListJobsUseCase implementa o caso de uso para listar a fila de envio de uma sessão
+type ListJobsUseCase struct {
+	jobRepo     outbound.JobRepository
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewListJobsUseCase cria uma nova instância do caso de uso
+func NewListJobsUseCase(
+	jobRepo outbound.JobRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *ListJobsUseCase {
+	return &ListJobsUseCase{
+		jobRepo:     jobRepo,
+		sessionRepo: sessionRepo,
+		logger:      logger.WithComponent("list-outbound-jobs-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para listar os jobs, do mais recente ao mais antigo
+func (uc *ListJobsUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req outbound.ListJobsRequest) (*outbound.JobListResponse, error) {
+	status := outbound.JobStatus(req.Status)
+	if status != "" && !status.IsValid() {
+		return nil, outbound.ErrInvalidStatus
+	}
+	kind := outbound.Kind(req.Kind)
+	if kind != "" && !kind.IsValid() {
+		return nil, outbound.ErrInvalidKind
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultJobsLimit
+	}
+	if limit > maxJobsLimit {
+		limit = maxJobsLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	jobs, total, err := uc.jobRepo.List(ctx, outbound.JobFilter{
+		SessionID: sessionID,
+		Status:    status,
+		Kind:      kind,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list outbound jobs from database")
+		return nil, err
+	}
+
+	if jobs == nil {
+		jobs = []*outbound.Job{}
+	}
+
+	return &outbound.JobListResponse{
+		Jobs:   jobs,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
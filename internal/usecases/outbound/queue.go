@@ -0,0 +1,295 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/whatsapp"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/internal/usecases/worker"
+	"zmeow/pkg/logger"
+)
+
+// QueueOptions define as tentativas e a simulação de digitação da fila de envio.
+// O ritmo de envio de cada sessão é o ritmo padrão do worker.Pacer.
+type QueueOptions struct {
+	// TypingSimulation envia "digitando..." antes das mensagens de texto, por um tempo proporcional ao tamanho do texto
+	TypingSimulation     bool
+	TypingCharsPerSecond int
+	MaxTypingDelay       time.Duration
+	MaxAttempts          int
+	RetryBackoff         time.Duration
+	PollInterval         time.Duration
+}
+
+// applyDefaults preenche as opções não informadas com valores padrão
+func (o *QueueOptions) applyDefaults() {
+	if o.TypingCharsPerSecond <= 0 {
+		o.TypingCharsPerSecond = 15
+	}
+	if o.MaxTypingDelay <= 0 {
+		o.MaxTypingDelay = 8 * time.Second
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 3
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = 30 * time.Second
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = time.Second
+	}
+}
+
+// Queue processa a fila de envio assíncrono com um worker por sessão, respeitando o ritmo configurado.
+// Os jobs de uma sessão saem em ordem de chegada; sessões desconectadas ficam com seus jobs na fila até reconectarem.
+type Queue struct {
+	repo            outbound.JobRepository
+	whatsappManager whatsapp.WhatsAppManager
+	dispatcher      *messageUseCases.SendDispatcher
+	publisher       whatsapp.EventPublisher
+	numberValidator *messageUseCases.NumberValidator
+	pacer           *worker.Pacer
+	options         QueueOptions
+	loop            *worker.Loop
+	logger          logger.Logger
+}
+
+// NewQueue cria uma nova instância da fila de envio
+func NewQueue(
+	repo outbound.JobRepository,
+	whatsappManager whatsapp.WhatsAppManager,
+	dispatcher *messageUseCases.SendDispatcher,
+	publisher whatsapp.EventPublisher,
+	pacer *worker.Pacer,
+	options QueueOptions,
+	log logger.Logger,
+) *Queue {
+	options.applyDefaults()
+	q := &Queue{
+		repo:            repo,
+		whatsappManager: whatsappManager,
+		dispatcher:      dispatcher,
+		publisher:       publisher,
+		numberValidator: messageUseCases.NewNumberValidator(),
+		pacer:           pacer,
+		options:         options,
+		logger:          log.WithComponent("outbound-queue"),
+	}
+	q.loop = worker.NewLoop(options.PollInterval, q.startDueSessions)
+	return q
+}
+
+// MaxAttempts retorna o número de tentativas atribuído aos novos jobs
+func (q *Queue) MaxAttempts() int {
+	return q.options.MaxAttempts
+}
+
+// Start marca como falhos os jobs interrompidos na última execução e inicia o loop da fila
+func (q *Queue) Start() {
+	worker.FailInterrupted(q.repo.FailInterrupted, q.logger)
+	q.loop.Start()
+
+	pace := q.pacer.DefaultPace()
+	q.logger.WithFields(map[string]interface{}{
+		"interval":         pace.Interval.String(),
+		"jitter":           pace.Jitter.String(),
+		"typingSimulation": q.options.TypingSimulation,
+	}).Info().Msg("Outbound queue started")
+}
+
+// Stop encerra a fila aguardando os envios em andamento
+func (q *Queue) Stop() {
+	if q.loop.Stop() {
+		q.logger.Info().Msg("Outbound queue stopped")
+	}
+}
+
+// Notify acorda o loop da fila sem bloquear
+func (q *Queue) Notify() {
+	q.loop.Notify()
+}
+
+// startDueSessions inicia um worker para cada sessão conectada com jobs vencidos e sem worker ativo
+func (q *Queue) startDueSessions() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	sessionIDs, err := q.repo.ListDueSessions(ctx, time.Now())
+	if err != nil {
+		q.logger.WithError(err).Error().Msg("Failed to load sessions with queued messages")
+		return
+	}
+
+	for _, sessionID := range sessionIDs {
+		if !q.whatsappManager.IsConnected(sessionID) {
+			continue
+		}
+
+		sessionID := sessionID
+		q.loop.GoSession(sessionID, func() { q.worker(sessionID) })
+	}
+}
+
+// worker envia os jobs vencidos da sessão, um por vez, até a fila esvaziar ou a sessão desconectar
+func (q *Queue) worker(sessionID uuid.UUID) {
+	for {
+		// O horário da sessão só é reservado quando há um job a enviar; uma reserva sem envio atrasaria
+		// os agendamentos e campanhas da sessão
+		if job := q.nextDue(sessionID); job == nil {
+			return
+		}
+		if !q.pacer.Wait(sessionID, worker.Pace{}, q.loop.Stopping()) {
+			return
+		}
+		if !q.whatsappManager.IsConnected(sessionID) {
+			return
+		}
+
+		// O job da frente pode ter sido cancelado durante a espera
+		job := q.nextDue(sessionID)
+		if job == nil {
+			return
+		}
+
+		q.process(job)
+	}
+}
+
+// nextDue retorna o job mais antigo da fila da sessão cujo horário de envio já chegou, ou nil
+func (q *Queue) nextDue(sessionID uuid.UUID) *outbound.Job {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	job, err := q.repo.NextDue(ctx, sessionID, time.Now())
+	if err != nil {
+		q.logger.WithError(err).WithField("sessionId", sessionID).Error().Msg("Failed to load next queued message")
+		return nil
+	}
+	return job
+}
+
+// process executa uma tentativa de envio do job e persiste o resultado
+func (q *Queue) process(job *outbound.Job) {
+	log := q.logger.WithFields(map[string]interface{}{
+		"jobId":     job.ID,
+		"sessionId": job.SessionID,
+		"kind":      job.Kind,
+		"attempt":   job.Attempts + 1,
+	})
+
+	job.MarkSending()
+	if !q.persist(job, log) {
+		return
+	}
+
+	q.simulateTyping(job)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	response, err := q.dispatcher.Dispatch(ctx, job.SessionID, job.Kind, job.Payload)
+	cancel()
+
+	q.pacer.Sent(job.SessionID)
+
+	switch {
+	case err == nil:
+		job.MarkSent(response.ID)
+		log.WithField("messageId", response.ID).Info().Msg("Queued message sent")
+	case messageUseCases.IsPermanentSendError(err):
+		job.MarkFailed(err.Error())
+		log.WithError(err).Warn().Msg("Queued message rejected")
+	default:
+		job.ScheduleRetry(err.Error(), q.options.RetryBackoff*time.Duration(job.Attempts))
+		if job.Status == outbound.JobStatusFailed {
+			log.WithError(err).Error().Msg("Queued message failed after all attempts")
+		} else {
+			log.WithError(err).WithField("nextAttemptAt", job.NextAttemptAt).Warn().Msg("Queued message failed, retry scheduled")
+		}
+	}
+
+	if q.persist(job, log) {
+		q.publish(job)
+	}
+}
+
+// persist grava o estado do job, registrando a falha no log
+func (q *Queue) persist(job *outbound.Job, log logger.Logger) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := q.repo.Update(ctx, job); err != nil {
+		log.WithError(err).Error().Msg("Failed to persist outbound job")
+		return false
+	}
+	return true
+}
+
+// simulateTyping envia "digitando..." antes de uma mensagem de texto e aguarda um tempo proporcional ao texto
+func (q *Queue) simulateTyping(job *outbound.Job) {
+	if !q.options.TypingSimulation || job.Kind != outbound.KindText {
+		return
+	}
+
+	var req message.SendTextMessageRequest
+	if err := json.Unmarshal(job.Payload, &req); err != nil || req.Text == "" {
+		return
+	}
+
+	client, err := q.whatsappManager.GetClient(job.SessionID)
+	if err != nil {
+		return
+	}
+
+	destination := q.numberValidator.GetDestination(req.Number, req.GroupJid)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := client.SendChatPresence(ctx, job.SessionID, destination, message.ChatPresenceComposing); err != nil {
+		q.logger.WithError(err).WithField("jobId", job.ID).Debug().Msg("Failed to send typing presence")
+		return
+	}
+
+	delay := time.Duration(utf8.RuneCountInString(req.Text)) * time.Second / time.Duration(q.options.TypingCharsPerSecond)
+	if delay > q.options.MaxTypingDelay {
+		delay = q.options.MaxTypingDelay
+	}
+	q.loop.Sleep(delay)
+}
+
+// publish emite o evento message.queue com o resultado da tentativa
+func (q *Queue) publish(job *outbound.Job) {
+	if q.publisher == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"jobId":       job.ID.String(),
+		"kind":        string(job.Kind),
+		"status":      string(job.Status),
+		"attempts":    job.Attempts,
+		"maxAttempts": job.MaxAttempts,
+	}
+	if job.MessageID != "" {
+		data["messageId"] = job.MessageID
+	}
+	if job.LastError != "" {
+		data["error"] = job.LastError
+	}
+	if job.Status == outbound.JobStatusQueued {
+		data["nextAttemptAt"] = job.NextAttemptAt
+	}
+
+	q.publisher.PublishEvent(whatsapp.Event{
+		Type:      whatsapp.EventMessageQueue,
+		SessionID: job.SessionID,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
@@ -0,0 +1,54 @@
+package outbound
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/internal/usecases/worker"
+	"zmeow/pkg/logger"
+)
+
+// emptyJobRepository é uma fila sem jobs vencidos
+type emptyJobRepository struct {
+	outbound.JobRepository
+}
+
+func (emptyJobRepository) NextDue(ctx context.Context, sessionID uuid.UUID, now time.Time) (*outbound.Job, error) {
+	return nil, nil
+}
+
+// connectedManager considera todas as sessões conectadas
+type connectedManager struct {
+	whatsapp.WhatsAppManager
+}
+
+func (connectedManager) IsConnected(sessionID uuid.UUID) bool {
+	return true
+}
+
+func TestQueueWorkerWithoutJobsKeepsThePace(t *testing.T) {
+	const interval = time.Hour
+	nop := zerolog.Nop()
+	pacer := worker.NewPacer(worker.Pace{Interval: interval})
+	queue := NewQueue(emptyJobRepository{}, connectedManager{}, nil, nil, pacer, QueueOptions{}, logger.NewZerologLogger(&nop))
+	sessionID := uuid.New()
+
+	queue.worker(sessionID)
+
+	// Sem job para enviar, o worker não reserva horário e o próximo envio da sessão sai na hora
+	done := make(chan bool)
+	go func() { done <- pacer.Wait(sessionID, worker.Pace{}, queue.loop.Stopping()) }()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		queue.Stop()
+		t.Fatal("Wait() after a worker without jobs waited for an unused slot")
+	}
+}
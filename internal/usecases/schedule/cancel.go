@@ -0,0 +1,42 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/schedule"
+	"zmeow/pkg/logger"
+)
+
+// CancelScheduleUseCase implementa o caso de uso para cancelar um agendamento que ainda não foi enviado
+type CancelScheduleUseCase struct {
+	scheduleRepo schedule.Repository
+	logger       logger.Logger
+}
+
+// NewCancelScheduleUseCase cria uma nova instância do caso de uso
+func NewCancelScheduleUseCase(scheduleRepo schedule.Repository, logger logger.Logger) *CancelScheduleUseCase {
+	return &CancelScheduleUseCase{
+		scheduleRepo: scheduleRepo,
+		logger:       logger.WithComponent("cancel-schedule-usecase"),
+	}
+}
+
+// Execute cancela o agendamento; agendamentos já processados retornam ErrScheduleNotEditable
+func (uc *CancelScheduleUseCase) Execute(ctx context.Context, sessionID, scheduleID uuid.UUID) (*schedule.ScheduledMessage, error) {
+	msg, err := uc.scheduleRepo.Cancel(ctx, sessionID, scheduleID)
+	if err != nil {
+		if err != schedule.ErrScheduleNotFound && err != schedule.ErrScheduleNotEditable {
+			uc.logger.WithError(err).Error().Msg("Failed to cancel scheduled message")
+		}
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"scheduleId": scheduleID,
+	}).Info().Msg("Scheduled message canceled")
+
+	return msg, nil
+}
@@ -0,0 +1,94 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/schedule"
+	"zmeow/internal/domain/session"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/pkg/logger"
+)
+
+// ScheduleMessageUseCase implementa o caso de uso para agendar um envio
+type ScheduleMessageUseCase struct {
+	scheduleRepo schedule.Repository
+	sessionRepo  session.SessionRepository
+	dispatcher   *messageUseCases.SendDispatcher
+	scheduler    *Scheduler
+	logger       logger.Logger
+}
+
+// NewScheduleMessageUseCase cria uma nova instância do caso de uso
+func NewScheduleMessageUseCase(
+	scheduleRepo schedule.Repository,
+	sessionRepo session.SessionRepository,
+	dispatcher *messageUseCases.SendDispatcher,
+	scheduler *Scheduler,
+	logger logger.Logger,
+) *ScheduleMessageUseCase {
+	return &ScheduleMessageUseCase{
+		scheduleRepo: scheduleRepo,
+		sessionRepo:  sessionRepo,
+		dispatcher:   dispatcher,
+		scheduler:    scheduler,
+		logger:       logger.WithComponent("schedule-message-usecase"),
+	}
+}
+
+// Execute valida o payload com as mesmas regras do endpoint de envio do tipo e grava o agendamento; o resultado
+// chega pelo evento message.scheduled
+func (uc *ScheduleMessageUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req schedule.ScheduleMessageRequest) (*schedule.ScheduledMessage, error) {
+	if !req.Kind.IsValid() {
+		return nil, fmt.Errorf("%w: %s", outbound.ErrInvalidKind, req.Kind)
+	}
+	if err := uc.dispatcher.ValidatePayload(req.Kind, req.Payload); err != nil {
+		return nil, err
+	}
+
+	policy := req.IfDisconnected
+	if policy == "" {
+		policy = schedule.DisconnectedRetry
+	}
+	if !policy.IsValid() {
+		return nil, schedule.ErrInvalidPolicy
+	}
+
+	sendAt, timezone, err := resolveSendAt(req.SendAt, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	msg := &schedule.ScheduledMessage{
+		SessionID:      sessionID,
+		Kind:           req.Kind,
+		Payload:        req.Payload,
+		SendAt:         sendAt,
+		Timezone:       timezone,
+		IfDisconnected: policy,
+		MaxAttempts:    uc.scheduler.MaxAttempts(),
+	}
+	if err := uc.scheduleRepo.Create(ctx, msg); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to create scheduled message")
+		return nil, err
+	}
+
+	uc.scheduler.Notify()
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"scheduleId": msg.ID,
+		"kind":       msg.Kind,
+		"sendAt":     msg.SendAt,
+		"timezone":   msg.Timezone,
+	}).Info().Msg("Message scheduled")
+
+	return msg, nil
+}
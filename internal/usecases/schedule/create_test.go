@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/internal/domain/message"
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/schedule"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/pkg/logger"
+)
+
+func TestScheduleMessageValidatesPayload(t *testing.T) {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+	dispatcher := messageUseCases.NewSendDispatcher(
+		messageUseCases.NewSendTextMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendMediaMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendLocationMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendContactMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendStickerMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendButtonsMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendListMessageUseCase(nil, nil, nil, log),
+		messageUseCases.NewSendPollMessageUseCase(nil, nil, nil, log),
+	)
+	// Os casos falham na validação, antes de qualquer acesso ao banco
+	uc := NewScheduleMessageUseCase(nil, nil, dispatcher, nil, log)
+
+	tests := []struct {
+		name    string
+		kind    outbound.Kind
+		payload string
+		sendAt  string
+		wantErr error
+	}{
+		{name: "text without text", kind: outbound.KindText, payload: `{"number": "5511999999999"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "invalid recipient", kind: outbound.KindText, payload: `{"number": "abc", "text": "Olá"}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "missing recipient", kind: outbound.KindLocation, payload: `{"latitude": 1, "longitude": 1}`, wantErr: message.ErrInvalidSendRequest},
+		{name: "malformed payload", kind: outbound.KindText, payload: `[]`, wantErr: outbound.ErrInvalidPayload},
+		{name: "unknown kind", kind: outbound.Kind("fax"), payload: `{}`, wantErr: outbound.ErrInvalidKind},
+		{name: "valid payload with invalid send at", kind: outbound.KindText, payload: `{"number": "5511999999999", "text": "Olá"}`, sendAt: "amanhã", wantErr: schedule.ErrInvalidSendAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), uuid.New(), schedule.ScheduleMessageRequest{
+				Kind:    tt.kind,
+				Payload: json.RawMessage(tt.payload),
+				SendAt:  tt.sendAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/schedule"
+	"zmeow/pkg/logger"
+)
+
+// GetScheduleUseCase implementa o caso de uso para consultar um agendamento
+type GetScheduleUseCase struct {
+	scheduleRepo schedule.Repository
+	logger       logger.Logger
+}
+
+// NewGetScheduleUseCase cria uma nova instância do caso de uso
+func NewGetScheduleUseCase(scheduleRepo schedule.Repository, logger logger.Logger) *GetScheduleUseCase {
+	return &GetScheduleUseCase{
+		scheduleRepo: scheduleRepo,
+		logger:       logger.WithComponent("get-schedule-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para obter o agendamento com payload, tentativas e resultado
+func (uc *GetScheduleUseCase) Execute(ctx context.Context, sessionID, scheduleID uuid.UUID) (*schedule.ScheduledMessage, error) {
+	msg, err := uc.scheduleRepo.GetByID(ctx, sessionID, scheduleID)
+	if err != nil {
+		if err != schedule.ErrScheduleNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get scheduled message from database")
+		}
+		return nil, err
+	}
+
+	return msg, nil
+}
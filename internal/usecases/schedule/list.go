@@ -0,0 +1,82 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/schedule"
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+const (
+	defaultScheduleLimit = 50
+	maxScheduleLimit     = 200
+)
+
+// ListSchedulesUseCase implementa o caso de uso para listar os agendamentos de uma sessão
+type ListSchedulesUseCase struct {
+	scheduleRepo schedule.Repository
+	sessionRepo  session.SessionRepository
+	logger       logger.Logger
+}
+
+// NewListSchedulesUseCase cria uma nova instância do caso de uso
+func NewListSchedulesUseCase(
+	scheduleRepo schedule.Repository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *ListSchedulesUseCase {
+	return &ListSchedulesUseCase{
+		scheduleRepo: scheduleRepo,
+		sessionRepo:  sessionRepo,
+		logger:       logger.WithComponent("list-schedules-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para listar os agendamentos pelo horário de envio
+func (uc *ListSchedulesUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req schedule.ListScheduleRequest) (*schedule.ScheduleListResponse, error) {
+	status := schedule.Status(req.Status)
+	if status != "" && !status.IsValid() {
+		return nil, schedule.ErrInvalidStatus
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultScheduleLimit
+	}
+	if limit > maxScheduleLimit {
+		limit = maxScheduleLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	messages, total, err := uc.scheduleRepo.List(ctx, schedule.Filter{
+		SessionID: sessionID,
+		Status:    status,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list scheduled messages from database")
+		return nil, err
+	}
+
+	if messages == nil {
+		messages = []*schedule.ScheduledMessage{}
+	}
+
+	return &schedule.ScheduleListResponse{
+		Messages: messages,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
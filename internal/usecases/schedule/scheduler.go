@@ -0,0 +1,312 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/schedule"
+	"zmeow/internal/domain/whatsapp"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/internal/usecases/worker"
+	"zmeow/pkg/logger"
+)
+
+// shutdownReason é registrado nos agendamentos reservados que não chegaram a ser enviados antes do encerramento
+const shutdownReason = "not sent before shutdown"
+
+// SchedulerOptions define os parâmetros do loop de envios agendados
+type SchedulerOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	// MaxDelay é o atraso máximo após o horário agendado antes de desistir do envio
+	MaxDelay time.Duration
+}
+
+// applyDefaults preenche as opções não informadas com valores padrão
+func (o *SchedulerOptions) applyDefaults() {
+	if o.PollInterval <= 0 {
+		o.PollInterval = 5 * time.Second
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 50
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 3
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = time.Minute
+	}
+	if o.MaxDelay <= 0 {
+		o.MaxDelay = 24 * time.Hour
+	}
+}
+
+// Scheduler envia os agendamentos vencidos pelos casos de uso de envio. O estado fica no Postgres,
+// então agendamentos vencidos durante uma parada são enviados na inicialização, dentro do atraso máximo.
+// Os envios seguem o ritmo de cada sessão no worker.Pacer compartilhado com a fila de envio e as campanhas.
+type Scheduler struct {
+	repo            schedule.Repository
+	whatsappManager whatsapp.WhatsAppManager
+	dispatcher      *messageUseCases.SendDispatcher
+	publisher       whatsapp.EventPublisher
+	pacer           *worker.Pacer
+	options         SchedulerOptions
+	loop            *worker.Loop
+	// pending guarda os agendamentos reservados de cada sessão; a presença da sessão indica um worker ativo
+	pending map[uuid.UUID][]*schedule.ScheduledMessage
+	mutex   sync.Mutex
+	logger  logger.Logger
+}
+
+// NewScheduler cria uma nova instância do scheduler
+func NewScheduler(
+	repo schedule.Repository,
+	whatsappManager whatsapp.WhatsAppManager,
+	dispatcher *messageUseCases.SendDispatcher,
+	publisher whatsapp.EventPublisher,
+	pacer *worker.Pacer,
+	options SchedulerOptions,
+	log logger.Logger,
+) *Scheduler {
+	options.applyDefaults()
+	s := &Scheduler{
+		repo:            repo,
+		whatsappManager: whatsappManager,
+		dispatcher:      dispatcher,
+		publisher:       publisher,
+		pacer:           pacer,
+		options:         options,
+		pending:         make(map[uuid.UUID][]*schedule.ScheduledMessage),
+		logger:          log.WithComponent("message-scheduler"),
+	}
+	s.loop = worker.NewLoop(options.PollInterval, s.dispatchDue)
+	return s
+}
+
+// MaxAttempts retorna o número de tentativas atribuído aos novos agendamentos
+func (s *Scheduler) MaxAttempts() int {
+	return s.options.MaxAttempts
+}
+
+// Start marca como falhos os agendamentos interrompidos na última execução e inicia o loop
+func (s *Scheduler) Start() {
+	worker.FailInterrupted(s.repo.FailInterrupted, s.logger)
+	s.loop.Start()
+
+	s.logger.WithFields(map[string]interface{}{
+		"pollInterval": s.options.PollInterval.String(),
+		"maxDelay":     s.options.MaxDelay.String(),
+	}).Info().Msg("Message scheduler started")
+}
+
+// Stop encerra o scheduler aguardando os envios em andamento
+func (s *Scheduler) Stop() {
+	if s.loop.Stop() {
+		s.logger.Info().Msg("Message scheduler stopped")
+	}
+}
+
+// Notify acorda o loop sem bloquear, para agendamentos com horário próximo
+func (s *Scheduler) Notify() {
+	s.loop.Notify()
+}
+
+// dispatchDue reserva os agendamentos vencidos e os entrega aos workers das sessões, sem aguardar os envios.
+// Cada sessão envia em ordem de horário; as reservas em memória são limitadas a BatchSize.
+func (s *Scheduler) dispatchDue() {
+	limit := s.options.BatchSize - s.pendingCount()
+	if limit <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	messages, err := s.repo.ClaimDue(ctx, time.Now(), limit)
+	cancel()
+	if err != nil {
+		s.logger.WithError(err).Error().Msg("Failed to load due scheduled messages")
+		return
+	}
+
+	s.mutex.Lock()
+	for _, msg := range messages {
+		sessionID := msg.SessionID
+		_, running := s.pending[sessionID]
+		s.pending[sessionID] = append(s.pending[sessionID], msg)
+		if !running {
+			s.loop.Go(func() { s.worker(sessionID) })
+		}
+	}
+	s.mutex.Unlock()
+
+	// Um lote cheio indica que pode haver mais agendamentos vencidos
+	if len(messages) == limit {
+		s.Notify()
+	}
+}
+
+// pendingCount retorna quantos agendamentos reservados aguardam envio
+func (s *Scheduler) pendingCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	count := 0
+	for _, messages := range s.pending {
+		count += len(messages)
+	}
+	return count
+}
+
+// next retira o próximo agendamento da sessão; sem pendências, encerra o worker da sessão
+func (s *Scheduler) next(sessionID uuid.UUID) *schedule.ScheduledMessage {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	messages := s.pending[sessionID]
+	if len(messages) == 0 {
+		delete(s.pending, sessionID)
+		return nil
+	}
+	s.pending[sessionID] = messages[1:]
+	return messages[0]
+}
+
+// worker envia os agendamentos reservados da sessão, um por vez, no ritmo da sessão. No encerramento,
+// os que não chegaram a ser enviados voltam a aguardar, para serem enviados na próxima execução.
+func (s *Scheduler) worker(sessionID uuid.UUID) {
+	for msg := s.next(sessionID); msg != nil; msg = s.next(sessionID) {
+		// Agendamentos que não serão enviados agora não aguardam o ritmo da sessão
+		if s.sendable(msg) && !s.pacer.Wait(sessionID, worker.Pace{}, s.loop.Stopping()) {
+			s.release(msg)
+			for msg := s.next(sessionID); msg != nil; msg = s.next(sessionID) {
+				s.release(msg)
+			}
+			return
+		}
+
+		s.process(msg)
+	}
+}
+
+// sendable indica se o agendamento será enviado, e não expirado ou adiado por desconexão
+func (s *Scheduler) sendable(msg *schedule.ScheduledMessage) bool {
+	return time.Since(msg.SendAt) <= s.options.MaxDelay && s.whatsappManager.IsConnected(msg.SessionID)
+}
+
+// release devolve à espera um agendamento reservado que não foi enviado
+func (s *Scheduler) release(msg *schedule.ScheduledMessage) {
+	msg.Postpone(shutdownReason, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := s.repo.Update(ctx, msg); err != nil {
+		s.logger.WithError(err).WithField("scheduleId", msg.ID).Error().Msg("Failed to release scheduled message on shutdown")
+	}
+}
+
+// process executa o envio de um agendamento e persiste o resultado
+func (s *Scheduler) process(msg *schedule.ScheduledMessage) {
+	log := s.logger.WithFields(map[string]interface{}{
+		"scheduleId": msg.ID,
+		"sessionId":  msg.SessionID,
+		"kind":       msg.Kind,
+		"sendAt":     msg.SendAt,
+	})
+
+	// Adiamentos por desconexão não geram evento, apenas os resultados e as novas tentativas
+	notify := true
+
+	switch {
+	case time.Since(msg.SendAt) > s.options.MaxDelay:
+		msg.MarkFailed(fmt.Sprintf("not sent within %s of the scheduled time", s.options.MaxDelay))
+		log.Warn().Msg("Scheduled message expired before it could be sent")
+	case !s.whatsappManager.IsConnected(msg.SessionID):
+		if msg.IfDisconnected == schedule.DisconnectedSkip {
+			msg.MarkSkipped("session not connected at the scheduled time")
+			log.Info().Msg("Scheduled message skipped, session not connected")
+		} else {
+			msg.Postpone("session not connected", s.options.RetryBackoff)
+			notify = false
+			log.WithField("nextAttemptAt", msg.NextAttemptAt).Debug().Msg("Scheduled message postponed, session not connected")
+		}
+	default:
+		s.send(msg, log)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := s.repo.Update(ctx, msg); err != nil {
+		log.WithError(err).Error().Msg("Failed to persist scheduled message result")
+		return
+	}
+
+	if notify {
+		s.publish(msg)
+	}
+}
+
+// send executa uma tentativa de envio pelo caso de uso do tipo
+func (s *Scheduler) send(msg *schedule.ScheduledMessage, log logger.Logger) {
+	msg.Attempts++
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	response, err := s.dispatcher.Dispatch(ctx, msg.SessionID, msg.Kind, msg.Payload)
+	cancel()
+	s.pacer.Sent(msg.SessionID)
+
+	switch {
+	case err == nil:
+		msg.MarkSent(response.ID)
+		log.WithField("messageId", response.ID).Info().Msg("Scheduled message sent")
+	case messageUseCases.IsPermanentSendError(err):
+		msg.MarkFailed(err.Error())
+		log.WithError(err).Warn().Msg("Scheduled message rejected")
+	default:
+		msg.ScheduleRetry(err.Error(), s.options.RetryBackoff*time.Duration(msg.Attempts))
+		if msg.Status == schedule.StatusFailed {
+			log.WithError(err).Error().Msg("Scheduled message failed after all attempts")
+		} else {
+			log.WithError(err).WithField("nextAttemptAt", msg.NextAttemptAt).Warn().Msg("Scheduled message failed, retry scheduled")
+		}
+	}
+}
+
+// publish emite o evento message.scheduled com o resultado
+func (s *Scheduler) publish(msg *schedule.ScheduledMessage) {
+	if s.publisher == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"scheduleId":  msg.ID.String(),
+		"kind":        string(msg.Kind),
+		"status":      string(msg.Status),
+		"sendAt":      msg.SendAt,
+		"timezone":    msg.Timezone,
+		"attempts":    msg.Attempts,
+		"maxAttempts": msg.MaxAttempts,
+	}
+	if msg.MessageID != "" {
+		data["messageId"] = msg.MessageID
+	}
+	if msg.LastError != "" {
+		data["error"] = msg.LastError
+	}
+	if msg.Status == schedule.StatusScheduled {
+		data["nextAttemptAt"] = msg.NextAttemptAt
+	}
+
+	s.publisher.PublishEvent(whatsapp.Event{
+		Type:      whatsapp.EventMessageScheduled,
+		SessionID: msg.SessionID,
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
@@ -0,0 +1,53 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // Fusos IANA embutidos, para imagens sem zoneinfo
+
+	"zmeow/internal/domain/schedule"
+)
+
+// localLayouts são os formatos aceitos para sendAt sem offset, interpretados no timezone do agendamento
+var localLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// resolveSendAt interpreta sendAt no fuso informado (padrão UTC) e retorna o horário em UTC e o nome do fuso.
+// Horários com offset (RFC3339) são respeitados como informados.
+func resolveSendAt(sendAt, timezone string) (time.Time, string, error) {
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("%w: %s", schedule.ErrInvalidTimezone, timezone)
+	}
+
+	if sendAt == "" {
+		return time.Time{}, "", fmt.Errorf("%w: sendAt is required", schedule.ErrInvalidSendAt)
+	}
+
+	at, err := time.Parse(time.RFC3339, sendAt)
+	if err != nil {
+		parsed := false
+		for _, layout := range localLayouts {
+			if at, err = time.ParseInLocation(layout, sendAt, location); err == nil {
+				parsed = true
+				break
+			}
+		}
+		if !parsed {
+			return time.Time{}, "", fmt.Errorf("%w: use RFC3339 or 2006-01-02T15:04:05", schedule.ErrInvalidSendAt)
+		}
+	}
+
+	if !at.After(time.Now()) {
+		return time.Time{}, "", fmt.Errorf("%w: must be in the future", schedule.ErrInvalidSendAt)
+	}
+
+	return at.UTC(), timezone, nil
+}
@@ -0,0 +1,119 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"zmeow/internal/domain/schedule"
+)
+
+func TestResolveSendAt(t *testing.T) {
+	// Um horário futuro fixo no calendário evita depender do relógio além da validação de "no futuro"
+	year := time.Now().Year() + 1
+	at := func(layout string) string {
+		return time.Date(year, 8, 1, 9, 30, 0, 0, time.UTC).Format(layout)
+	}
+	utc := func(hour, minute int) time.Time {
+		return time.Date(year, 8, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		sendAt       string
+		timezone     string
+		want         time.Time
+		wantTimezone string
+		wantErr      error
+	}{
+		{
+			name:         "local time defaults to utc",
+			sendAt:       at("2006-01-02T15:04:05"),
+			want:         utc(9, 30),
+			wantTimezone: "UTC",
+		},
+		{
+			name:         "local time in sao paulo",
+			sendAt:       at("2006-01-02T15:04:05"),
+			timezone:     "America/Sao_Paulo",
+			want:         utc(12, 30),
+			wantTimezone: "America/Sao_Paulo",
+		},
+		{
+			name:         "local time without seconds",
+			sendAt:       at("2006-01-02T15:04"),
+			timezone:     "America/Sao_Paulo",
+			want:         utc(12, 30),
+			wantTimezone: "America/Sao_Paulo",
+		},
+		{
+			name:         "local time with a space separator",
+			sendAt:       at("2006-01-02 15:04:05"),
+			timezone:     "Europe/Lisbon",
+			want:         utc(8, 30),
+			wantTimezone: "Europe/Lisbon",
+		},
+		{
+			name:         "local time with a space and without seconds",
+			sendAt:       at("2006-01-02 15:04"),
+			want:         utc(9, 30),
+			wantTimezone: "UTC",
+		},
+		{
+			name:         "rfc3339 offset wins over the timezone",
+			sendAt:       at("2006-01-02T15:04:05") + "-05:00",
+			timezone:     "America/Sao_Paulo",
+			want:         utc(14, 30),
+			wantTimezone: "America/Sao_Paulo",
+		},
+		{
+			name:         "rfc3339 in utc",
+			sendAt:       at(time.RFC3339),
+			want:         utc(9, 30),
+			wantTimezone: "UTC",
+		},
+		{
+			name:     "unknown timezone",
+			sendAt:   at("2006-01-02T15:04:05"),
+			timezone: "Mars/Olympus",
+			wantErr:  schedule.ErrInvalidTimezone,
+		},
+		{
+			name:    "empty send at",
+			wantErr: schedule.ErrInvalidSendAt,
+		},
+		{
+			name:    "date only",
+			sendAt:  at("2006-01-02"),
+			wantErr: schedule.ErrInvalidSendAt,
+		},
+		{
+			name:    "unsupported format",
+			sendAt:  at("02/01/2006 15:04"),
+			wantErr: schedule.ErrInvalidSendAt,
+		},
+		{
+			name:    "past time",
+			sendAt:  time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+			wantErr: schedule.ErrInvalidSendAt,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, timezone, err := resolveSendAt(tt.sendAt, tt.timezone)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveSendAt(%q, %q) error = %v, want %v", tt.sendAt, tt.timezone, err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Fatalf("resolveSendAt(%q, %q) = %s, want %s", tt.sendAt, tt.timezone, got, tt.want)
+			}
+			if timezone != tt.wantTimezone {
+				t.Fatalf("resolveSendAt(%q, %q) timezone = %q, want %q", tt.sendAt, tt.timezone, timezone, tt.wantTimezone)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/outbound"
+	"zmeow/internal/domain/schedule"
+	messageUseCases "zmeow/internal/usecases/message"
+	"zmeow/pkg/logger"
+)
+
+// UpdateScheduleUseCase implementa o caso de uso para alterar um agendamento que ainda não foi enviado
+type UpdateScheduleUseCase struct {
+	scheduleRepo schedule.Repository
+	dispatcher   *messageUseCases.SendDispatcher
+	scheduler    *Scheduler
+	logger       logger.Logger
+}
+
+// NewUpdateScheduleUseCase cria uma nova instância do caso de uso
+func NewUpdateScheduleUseCase(
+	scheduleRepo schedule.Repository,
+	dispatcher *messageUseCases.SendDispatcher,
+	scheduler *Scheduler,
+	logger logger.Logger,
+) *UpdateScheduleUseCase {
+	return &UpdateScheduleUseCase{
+		scheduleRepo: scheduleRepo,
+		dispatcher:   dispatcher,
+		scheduler:    scheduler,
+		logger:       logger.WithComponent("update-schedule-usecase"),
+	}
+}
+
+// Execute aplica os campos informados; trocar o timezone exige informar também o sendAt
+func (uc *UpdateScheduleUseCase) Execute(ctx context.Context, sessionID, scheduleID uuid.UUID, req schedule.UpdateScheduleRequest) (*schedule.ScheduledMessage, error) {
+	msg, err := uc.scheduleRepo.GetByID(ctx, sessionID, scheduleID)
+	if err != nil {
+		if err != schedule.ErrScheduleNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get scheduled message from database")
+		}
+		return nil, err
+	}
+	if !msg.IsEditable() {
+		return nil, schedule.ErrScheduleNotEditable
+	}
+
+	if req.Kind != "" {
+		if !req.Kind.IsValid() {
+			return nil, fmt.Errorf("%w: %s", outbound.ErrInvalidKind, req.Kind)
+		}
+		msg.Kind = req.Kind
+	}
+	if len(req.Payload) > 0 {
+		msg.Payload = req.Payload
+	}
+	if req.Kind != "" || len(req.Payload) > 0 {
+		if err := uc.dispatcher.ValidatePayload(msg.Kind, msg.Payload); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.IfDisconnected != "" {
+		if !req.IfDisconnected.IsValid() {
+			return nil, schedule.ErrInvalidPolicy
+		}
+		msg.IfDisconnected = req.IfDisconnected
+	}
+
+	if req.Timezone != "" && req.SendAt == "" {
+		return nil, fmt.Errorf("%w: sendAt is required when changing the timezone", schedule.ErrInvalidSendAt)
+	}
+	if req.SendAt != "" {
+		timezone := req.Timezone
+		if timezone == "" {
+			timezone = msg.Timezone
+		}
+		if msg.SendAt, msg.Timezone, err = resolveSendAt(req.SendAt, timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.scheduleRepo.UpdatePending(ctx, msg); err != nil {
+		if err != schedule.ErrScheduleNotEditable {
+			uc.logger.WithError(err).Error().Msg("Failed to update scheduled message")
+		}
+		return nil, err
+	}
+
+	uc.scheduler.Notify()
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"scheduleId": scheduleID,
+		"sendAt":     msg.SendAt,
+	}).Info().Msg("Scheduled message updated")
+
+	return msg, nil
+}
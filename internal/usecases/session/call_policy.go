@@ -0,0 +1,105 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// CallPolicyRequest representa os dados para configurar a política de chamadas
+type CallPolicyRequest struct {
+	// Policy: ignore, reject ou reject_reply
+	Policy session.CallPolicy `json:"policy" example:"reject_reply"`
+	// ReplyMessage é o texto enviado a quem ligou; obrigatório em reject_reply
+	ReplyMessage string `json:"replyMessage,omitempty" example:"Não atendemos ligações. Envie sua dúvida por mensagem."`
+}
+
+// CallPolicyResponse representa a política de chamadas de uma sessão
+type CallPolicyResponse struct {
+	SessionID    uuid.UUID          `json:"sessionId"`
+	Policy       session.CallPolicy `json:"policy"`
+	ReplyMessage string             `json:"replyMessage,omitempty"`
+}
+
+// SetCallPolicyUseCase implementa o caso de uso para configurar o tratamento das chamadas recebidas
+type SetCallPolicyUseCase struct {
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewSetCallPolicyUseCase cria uma nova instância do caso de uso
+func NewSetCallPolicyUseCase(
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *SetCallPolicyUseCase {
+	return &SetCallPolicyUseCase{
+		sessionRepo: sessionRepo,
+		logger:      logger.WithComponent("set-call-policy-usecase"),
+	}
+}
+
+// Execute valida e persiste a política de chamadas da sessão
+func (uc *SetCallPolicyUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req CallPolicyRequest) (*CallPolicyResponse, error) {
+	if !req.Policy.IsValid() {
+		return nil, session.ErrInvalidCallPolicy
+	}
+
+	replyMessage := strings.TrimSpace(req.ReplyMessage)
+	if req.Policy == session.CallPolicyRejectAndReply && replyMessage == "" {
+		return nil, session.ErrCallReplyRequired
+	}
+
+	sess, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sess.SetCallPolicy(req.Policy, replyMessage)
+	if err := uc.sessionRepo.Update(ctx, sess); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to update session call policy")
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"policy":    req.Policy,
+	}).Info().Msg("Call policy updated")
+
+	return toCallPolicyResponse(sess), nil
+}
+
+// GetCallPolicyUseCase implementa o caso de uso para obter a política de chamadas de uma sessão
+type GetCallPolicyUseCase struct {
+	sessionRepo session.SessionRepository
+}
+
+// NewGetCallPolicyUseCase cria uma nova instância do caso de uso
+func NewGetCallPolicyUseCase(sessionRepo session.SessionRepository) *GetCallPolicyUseCase {
+	return &GetCallPolicyUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute retorna a política de chamadas da sessão
+func (uc *GetCallPolicyUseCase) Execute(ctx context.Context, sessionID uuid.UUID) (*CallPolicyResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return toCallPolicyResponse(sess), nil
+}
+
+func toCallPolicyResponse(sess *session.Session) *CallPolicyResponse {
+	policy := sess.CallPolicy
+	if policy == "" {
+		policy = session.CallPolicyIgnore
+	}
+	return &CallPolicyResponse{
+		SessionID:    sess.ID,
+		Policy:       policy,
+		ReplyMessage: sess.CallReplyMessage,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
 	"zmeow/internal/domain/whatsapp"
 	"zmeow/pkg/logger"
 )
@@ -14,19 +15,25 @@ import (
 // CreateSessionUseCase implementa o caso de uso para criar uma nova sessão
 type CreateSessionUseCase struct {
 	sessionRepo     session.SessionRepository
+	webhookRepo     webhook.WebhookRepository
 	whatsappManager whatsapp.WhatsAppManager
+	webhookService  whatsapp.WebhookService
 	logger          logger.Logger
 }
 
 // NewCreateSessionUseCase cria uma nova instância do caso de uso
 func NewCreateSessionUseCase(
 	sessionRepo session.SessionRepository,
+	webhookRepo webhook.WebhookRepository,
 	whatsappManager whatsapp.WhatsAppManager,
+	webhookService whatsapp.WebhookService,
 	logger logger.Logger,
 ) *CreateSessionUseCase {
 	return &CreateSessionUseCase{
 		sessionRepo:     sessionRepo,
+		webhookRepo:     webhookRepo,
 		whatsappManager: whatsappManager,
+		webhookService:  webhookService,
 		logger:          logger,
 	}
 }
@@ -46,6 +53,13 @@ func (uc *CreateSessionUseCase) Execute(ctx context.Context, req CreateSessionRe
 		"proxyUrl": req.ProxyURL,
 	}).Info().Msg("Creating new session")
 
+	// Validar webhook antes de criar a sessão
+	if req.Webhook != "" {
+		if err := webhook.ValidateURL(req.Webhook); err != nil {
+			return nil, session.NewValidationError("webhook", req.Webhook, err.Error())
+		}
+	}
+
 	// Verificar se uma sessão com esse nome já existe
 	exists, err := uc.sessionRepo.ExistsByName(ctx, req.Name)
 	if err != nil {
@@ -86,10 +100,43 @@ func (uc *CreateSessionUseCase) Execute(ctx context.Context, req CreateSessionRe
 		return nil, err
 	}
 
+	// Persistir e aplicar o webhook informado na criação
+	if req.Webhook != "" {
+		newSession.WebhookSecret = uc.saveWebhook(ctx, newSession.ID, req.Webhook)
+	}
+
 	uc.logger.WithFields(map[string]interface{}{
 		"sessionId": newSession.ID,
 		"name":      newSession.Name,
 	}).Info().Msg("Session created successfully")
 
 	return newSession, nil
-}
\ No newline at end of file
+}
+
+// saveWebhook persiste o webhook da sessão com um segredo de assinatura gerado, registra-o no WebhookService
+// e retorna o segredo (vazio se o webhook não pôde ser salvo)
+func (uc *CreateSessionUseCase) saveWebhook(ctx context.Context, sessionID uuid.UUID, url string) string {
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to generate session webhook secret")
+		return ""
+	}
+
+	wh := &webhook.Webhook{
+		SessionID: sessionID,
+		URL:       url,
+		Secret:    secret,
+		Enabled:   true,
+	}
+	wh.ApplyDefaults()
+
+	if err := uc.webhookRepo.Save(ctx, wh); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to save session webhook in database")
+		return ""
+	}
+
+	if err := uc.webhookService.SetWebhookConfig(wh.ToConfig()); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to apply session webhook configuration")
+	}
+	return secret
+}
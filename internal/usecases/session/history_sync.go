@@ -0,0 +1,94 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/pkg/logger"
+)
+
+// HistorySyncRequest representa os dados para configurar a importação do histórico
+type HistorySyncRequest struct {
+	// Days é a janela, em dias, das mensagens importadas no pareamento (0 desabilita a importação)
+	Days *int `json:"days" example:"30"`
+}
+
+// HistorySyncResponse representa a configuração de importação do histórico de uma sessão
+type HistorySyncResponse struct {
+	SessionID uuid.UUID `json:"sessionId"`
+	Days      int       `json:"days"`
+	Enabled   bool      `json:"enabled"`
+}
+
+// SetHistorySyncUseCase implementa o caso de uso para configurar a importação do histórico
+type SetHistorySyncUseCase struct {
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewSetHistorySyncUseCase cria uma nova instância do caso de uso
+func NewSetHistorySyncUseCase(
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *SetHistorySyncUseCase {
+	return &SetHistorySyncUseCase{
+		sessionRepo: sessionRepo,
+		logger:      logger.WithComponent("set-history-sync-usecase"),
+	}
+}
+
+// Execute valida e persiste a janela de histórico da sessão.
+// A configuração vale para as próximas sincronizações; o histórico já importado é mantido.
+func (uc *SetHistorySyncUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req HistorySyncRequest) (*HistorySyncResponse, error) {
+	if req.Days == nil || *req.Days < 0 || *req.Days > session.MaxHistorySyncDays {
+		return nil, session.ErrInvalidHistorySyncDays
+	}
+
+	sess, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sess.SetHistorySyncDays(*req.Days)
+	if err := uc.sessionRepo.Update(ctx, sess); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to update session history sync settings")
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"days":      sess.HistorySyncDays,
+	}).Info().Msg("History sync settings updated")
+
+	return toHistorySyncResponse(sess), nil
+}
+
+// GetHistorySyncUseCase implementa o caso de uso para obter a configuração de importação do histórico
+type GetHistorySyncUseCase struct {
+	sessionRepo session.SessionRepository
+}
+
+// NewGetHistorySyncUseCase cria uma nova instância do caso de uso
+func NewGetHistorySyncUseCase(sessionRepo session.SessionRepository) *GetHistorySyncUseCase {
+	return &GetHistorySyncUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute retorna a configuração de importação do histórico da sessão
+func (uc *GetHistorySyncUseCase) Execute(ctx context.Context, sessionID uuid.UUID) (*HistorySyncResponse, error) {
+	sess, err := uc.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return toHistorySyncResponse(sess), nil
+}
+
+func toHistorySyncResponse(sess *session.Session) *HistorySyncResponse {
+	return &HistorySyncResponse{
+		SessionID: sess.ID,
+		Days:      sess.HistorySyncDays,
+		Enabled:   sess.HistorySyncDays > 0,
+	}
+}
@@ -0,0 +1,45 @@
+package session
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// GetStatsUseCase implementa o caso de uso para obter as estatísticas de eventos de uma sessão
+type GetStatsUseCase struct {
+	sessionRepo session.SessionRepository
+	metrics     whatsapp.MetricsService
+	logger      logger.Logger
+}
+
+// NewGetStatsUseCase cria uma nova instância do caso de uso
+func NewGetStatsUseCase(
+	sessionRepo session.SessionRepository,
+	metrics whatsapp.MetricsService,
+	logger logger.Logger,
+) *GetStatsUseCase {
+	return &GetStatsUseCase{
+		sessionRepo: sessionRepo,
+		metrics:     metrics,
+		logger:      logger.WithComponent("get-stats-usecase"),
+	}
+}
+
+// Execute retorna os contadores da sessão desde o início do processo
+func (uc *GetStatsUseCase) Execute(ctx context.Context, sessionID uuid.UUID) (*whatsapp.SessionStats, error) {
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	stats, err := uc.metrics.GetSessionStats(sessionID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("sessionId", sessionID).Error().Msg("Failed to get session stats")
+		return nil, err
+	}
+	return stats, nil
+}
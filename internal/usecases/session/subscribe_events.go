@@ -0,0 +1,143 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// subscriptionBufferSize é a quantidade de eventos pendentes por assinante antes de ser desconectado
+const subscriptionBufferSize = 256
+
+// SubscribeEventsRequest representa os filtros de um stream de eventos
+type SubscribeEventsRequest struct {
+	// Types restringe os tipos de evento entregues; vazio entrega todos
+	Types []whatsapp.EventType
+	// LastEventID, quando informado, entrega antes os eventos posteriores ainda mantidos em memória
+	LastEventID *uint64
+}
+
+// EventSubscription representa uma inscrição ativa nos eventos de uma sessão.
+// Backlog contém os eventos a retomar; Events os eventos ao vivo, sem repetir os do Backlog.
+// Lagged é fechado quando o assinante não consome os eventos a tempo e deve reconectar.
+type EventSubscription struct {
+	Backlog []whatsapp.Event
+	Events  <-chan whatsapp.Event
+	Lagged  <-chan struct{}
+
+	bus        whatsapp.EventBus
+	subscriber *sessionSubscriber
+	closeOnce  sync.Once
+}
+
+// Close cancela a inscrição no EventBus
+func (s *EventSubscription) Close() {
+	s.closeOnce.Do(func() {
+		s.bus.Unsubscribe(s.subscriber)
+	})
+}
+
+// SubscribeEventsUseCase implementa o caso de uso para acompanhar os eventos de uma sessão ao vivo
+type SubscribeEventsUseCase struct {
+	sessionRepo session.SessionRepository
+	eventBus    whatsapp.EventStream
+	logger      logger.Logger
+}
+
+// NewSubscribeEventsUseCase cria uma nova instância do caso de uso
+func NewSubscribeEventsUseCase(
+	sessionRepo session.SessionRepository,
+	eventBus whatsapp.EventStream,
+	logger logger.Logger,
+) *SubscribeEventsUseCase {
+	return &SubscribeEventsUseCase{
+		sessionRepo: sessionRepo,
+		eventBus:    eventBus,
+		logger:      logger.WithComponent("subscribe-events-usecase"),
+	}
+}
+
+// Execute inscreve o chamador nos eventos da sessão; a inscrição deve ser encerrada com Close
+func (uc *SubscribeEventsUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req SubscribeEventsRequest) (*EventSubscription, error) {
+	types := make(map[whatsapp.EventType]bool, len(req.Types))
+	for _, t := range req.Types {
+		if !t.IsSessionEvent() {
+			return nil, fmt.Errorf("%w: %s", whatsapp.ErrInvalidEventType, t)
+		}
+		types[t] = true
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	subscriber := &sessionSubscriber{
+		sessionID: sessionID,
+		types:     types,
+		events:    make(chan whatsapp.Event, subscriptionBufferSize),
+		lagged:    make(chan struct{}),
+	}
+
+	// Inscrever antes do replay: eventos publicados entre os dois passos chegam pelo canal
+	// e os repetidos são descartados pelo ID
+	uc.eventBus.Subscribe(subscriber)
+
+	subscription := &EventSubscription{
+		Events:     subscriber.events,
+		Lagged:     subscriber.lagged,
+		bus:        uc.eventBus,
+		subscriber: subscriber,
+	}
+
+	if req.LastEventID != nil {
+		for _, event := range uc.eventBus.Replay(sessionID, *req.LastEventID) {
+			if subscriber.accepts(event) {
+				subscription.Backlog = append(subscription.Backlog, event)
+			}
+		}
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"types":     req.Types,
+		"backlog":   len(subscription.Backlog),
+	}).Debug().Msg("Event stream subscribed")
+
+	return subscription, nil
+}
+
+// sessionSubscriber recebe do EventBus os eventos de uma sessão sem bloquear a publicação
+type sessionSubscriber struct {
+	sessionID  uuid.UUID
+	types      map[whatsapp.EventType]bool
+	events     chan whatsapp.Event
+	lagged     chan struct{}
+	laggedOnce sync.Once
+}
+
+// HandleEvent implementa whatsapp.EventHandler
+func (s *sessionSubscriber) HandleEvent(event whatsapp.Event) {
+	if !s.accepts(event) {
+		return
+	}
+
+	select {
+	case s.events <- event:
+	default:
+		s.laggedOnce.Do(func() { close(s.lagged) })
+	}
+}
+
+// accepts verifica se o evento pertence à sessão e passa pelo filtro de tipos
+func (s *sessionSubscriber) accepts(event whatsapp.Event) bool {
+	if event.SessionID != s.sessionID {
+		return false
+	}
+	return len(s.types) == 0 || s.types[event.Type]
+}
@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// CreateWebhookUseCase implementa o caso de uso para adicionar um webhook a uma sessão
+type CreateWebhookUseCase struct {
+	webhookRepo    webhook.WebhookRepository
+	sessionRepo    session.SessionRepository
+	webhookService whatsapp.WebhookService
+	logger         logger.Logger
+}
+
+// NewCreateWebhookUseCase cria uma nova instância do caso de uso
+func NewCreateWebhookUseCase(
+	webhookRepo webhook.WebhookRepository,
+	sessionRepo session.SessionRepository,
+	webhookService whatsapp.WebhookService,
+	logger logger.Logger,
+) *CreateWebhookUseCase {
+	return &CreateWebhookUseCase{
+		webhookRepo:    webhookRepo,
+		sessionRepo:    sessionRepo,
+		webhookService: webhookService,
+		logger:         logger.WithComponent("create-webhook-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para criar o webhook, respeitando o limite por sessão.
+// Sem secret na requisição, um segredo é gerado e retornado apenas nesta resposta.
+func (uc *CreateWebhookUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req SetWebhookRequest) (*WebhookResponse, error) {
+	wh, err := buildWebhook(sessionID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.webhookRepo.ListBySession(ctx, sessionID)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list webhooks from database")
+		return nil, err
+	}
+	if len(existing) >= webhook.MaxWebhooksPerSession {
+		return nil, webhook.ErrWebhookLimitReached
+	}
+
+	secret, err := ensureSecret(wh)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to generate webhook secret")
+		return nil, err
+	}
+
+	return &WebhookResponse{Webhook: wh, Secret: secret}, saveWebhook(ctx, uc.webhookRepo, uc.webhookService, uc.logger, wh)
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// DeleteWebhookUseCase implementa o caso de uso para remover um webhook de uma sessão
+type DeleteWebhookUseCase struct {
+	webhookRepo    webhook.WebhookRepository
+	webhookService whatsapp.WebhookService
+	logger         logger.Logger
+}
+
+// NewDeleteWebhookUseCase cria uma nova instância do caso de uso
+func NewDeleteWebhookUseCase(
+	webhookRepo webhook.WebhookRepository,
+	webhookService whatsapp.WebhookService,
+	logger logger.Logger,
+) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{
+		webhookRepo:    webhookRepo,
+		webhookService: webhookService,
+		logger:         logger.WithComponent("delete-webhook-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para remover o webhook; uuid.Nil seleciona o webhook principal
+func (uc *DeleteWebhookUseCase) Execute(ctx context.Context, sessionID, webhookID uuid.UUID) error {
+	wh, err := findWebhook(ctx, uc.webhookRepo, sessionID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.webhookRepo.Delete(ctx, sessionID, wh.ID); err != nil {
+		if err != webhook.ErrWebhookNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to delete webhook from database")
+		}
+		return err
+	}
+
+	if err := uc.webhookService.RemoveWebhookConfig(sessionID, wh.ID); err != nil {
+		uc.logger.WithError(err).Warn().Msg("Failed to remove webhook configuration from service")
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"webhookId": wh.ID,
+	}).Info().Msg("Webhook deleted successfully")
+	return nil
+}
@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// DisableWebhookUseCase implementa o caso de uso para desabilitar um webhook de uma sessão
+type DisableWebhookUseCase struct {
+	webhookRepo    webhook.WebhookRepository
+	webhookService whatsapp.WebhookService
+	logger         logger.Logger
+}
+
+// NewDisableWebhookUseCase cria uma nova instância do caso de uso
+func NewDisableWebhookUseCase(
+	webhookRepo webhook.WebhookRepository,
+	webhookService whatsapp.WebhookService,
+	logger logger.Logger,
+) *DisableWebhookUseCase {
+	return &DisableWebhookUseCase{
+		webhookRepo:    webhookRepo,
+		webhookService: webhookService,
+		logger:         logger.WithComponent("disable-webhook-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para desabilitar o webhook
+func (uc *DisableWebhookUseCase) Execute(ctx context.Context, sessionID, webhookID uuid.UUID) error {
+	return setWebhookEnabled(ctx, uc.webhookRepo, uc.webhookService, uc.logger, sessionID, webhookID, false)
+}
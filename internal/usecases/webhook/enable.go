@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// EnableWebhookUseCase implementa o caso de uso para habilitar um webhook de uma sessão
+type EnableWebhookUseCase struct {
+	webhookRepo    webhook.WebhookRepository
+	webhookService whatsapp.WebhookService
+	logger         logger.Logger
+}
+
+// NewEnableWebhookUseCase cria uma nova instância do caso de uso
+func NewEnableWebhookUseCase(
+	webhookRepo webhook.WebhookRepository,
+	webhookService whatsapp.WebhookService,
+	logger logger.Logger,
+) *EnableWebhookUseCase {
+	return &EnableWebhookUseCase{
+		webhookRepo:    webhookRepo,
+		webhookService: webhookService,
+		logger:         logger.WithComponent("enable-webhook-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para habilitar o webhook
+func (uc *EnableWebhookUseCase) Execute(ctx context.Context, sessionID, webhookID uuid.UUID) error {
+	return setWebhookEnabled(ctx, uc.webhookRepo, uc.webhookService, uc.logger, sessionID, webhookID, true)
+}
+
+// setWebhookEnabled persiste o novo estado e o aplica ao WebhookService
+func setWebhookEnabled(
+	ctx context.Context,
+	webhookRepo webhook.WebhookRepository,
+	webhookService whatsapp.WebhookService,
+	log logger.Logger,
+	sessionID uuid.UUID,
+	webhookID uuid.UUID,
+	enabled bool,
+) error {
+	wh, err := findWebhook(ctx, webhookRepo, sessionID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if err := webhookRepo.SetEnabled(ctx, sessionID, wh.ID, enabled); err != nil {
+		if err != webhook.ErrWebhookNotFound {
+			log.WithError(err).Error().Msg("Failed to update webhook state in database")
+		}
+		return err
+	}
+
+	if enabled {
+		err = webhookService.EnableWebhook(sessionID, wh.ID)
+	} else {
+		err = webhookService.DisableWebhook(sessionID, wh.ID)
+	}
+
+	// Se o serviço não tinha a configuração em memória, aplicar a do banco
+	if err != nil {
+		wh.Enabled = enabled
+		if err = webhookService.SetWebhookConfig(wh.ToConfig()); err != nil {
+			return err
+		}
+	}
+
+	log.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"webhookId": wh.ID,
+		"enabled":   enabled,
+	}).Info().Msg("Webhook state updated")
+
+	return nil
+}
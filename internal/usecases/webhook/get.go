@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// GetWebhookUseCase implementa o caso de uso para obter um webhook de uma sessão
+type GetWebhookUseCase struct {
+	webhookRepo webhook.WebhookRepository
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewGetWebhookUseCase cria uma nova instância do caso de uso
+func NewGetWebhookUseCase(
+	webhookRepo webhook.WebhookRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *GetWebhookUseCase {
+	return &GetWebhookUseCase{
+		webhookRepo: webhookRepo,
+		sessionRepo: sessionRepo,
+		logger:      logger.WithComponent("get-webhook-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para obter o webhook; uuid.Nil seleciona o webhook principal
+func (uc *GetWebhookUseCase) Execute(ctx context.Context, sessionID, webhookID uuid.UUID) (*webhook.Webhook, error) {
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	wh, err := findWebhook(ctx, uc.webhookRepo, sessionID, webhookID)
+	if err != nil {
+		if err != webhook.ErrWebhookNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get webhook from database")
+		}
+		return nil, err
+	}
+
+	return wh, nil
+}
+
+// findWebhook busca um webhook da sessão pelo ID ou, com uuid.Nil, o webhook principal
+func findWebhook(ctx context.Context, webhookRepo webhook.WebhookRepository, sessionID, webhookID uuid.UUID) (*webhook.Webhook, error) {
+	if webhookID == uuid.Nil {
+		return webhookRepo.GetPrimary(ctx, sessionID)
+	}
+	return webhookRepo.GetByID(ctx, sessionID, webhookID)
+}
@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// GetDeliveryUseCase implementa o caso de uso para inspecionar uma entrega de webhook
+type GetDeliveryUseCase struct {
+	deliveryRepo webhook.DeliveryRepository
+	logger       logger.Logger
+}
+
+// NewGetDeliveryUseCase cria uma nova instância do caso de uso
+func NewGetDeliveryUseCase(deliveryRepo webhook.DeliveryRepository, logger logger.Logger) *GetDeliveryUseCase {
+	return &GetDeliveryUseCase{
+		deliveryRepo: deliveryRepo,
+		logger:       logger.WithComponent("get-webhook-delivery-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para obter a entrega com payload e histórico de tentativas
+func (uc *GetDeliveryUseCase) Execute(ctx context.Context, sessionID, deliveryID uuid.UUID) (*webhook.Delivery, error) {
+	delivery, err := uc.deliveryRepo.GetByID(ctx, sessionID, deliveryID)
+	if err != nil {
+		if err != webhook.ErrDeliveryNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get webhook delivery from database")
+		}
+		return nil, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// ListWebhooksUseCase implementa o caso de uso para listar os webhooks de uma sessão
+type ListWebhooksUseCase struct {
+	webhookRepo webhook.WebhookRepository
+	sessionRepo session.SessionRepository
+	logger      logger.Logger
+}
+
+// NewListWebhooksUseCase cria uma nova instância do caso de uso
+func NewListWebhooksUseCase(
+	webhookRepo webhook.WebhookRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{
+		webhookRepo: webhookRepo,
+		sessionRepo: sessionRepo,
+		logger:      logger.WithComponent("list-webhooks-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para listar os webhooks, do principal ao mais recente
+func (uc *ListWebhooksUseCase) Execute(ctx context.Context, sessionID uuid.UUID) ([]*webhook.Webhook, error) {
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.webhookRepo.ListBySession(ctx, sessionID)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list webhooks from database")
+		return nil, err
+	}
+
+	if webhooks == nil {
+		webhooks = []*webhook.Webhook{}
+	}
+
+	return webhooks, nil
+}
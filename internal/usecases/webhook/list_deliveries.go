@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 200
+)
+
+// ListDeliveriesRequest representa os filtros para listar entregas de webhook
+type ListDeliveriesRequest struct {
+	WebhookID uuid.UUID `json:"webhookId,omitempty"`
+	Status    string    `json:"status,omitempty" example:"dead"`
+	Event     string    `json:"event,omitempty" example:"message"`
+	Limit     int       `json:"limit,omitempty" example:"50"`
+	Offset    int       `json:"offset,omitempty" example:"0"`
+}
+
+// ListDeliveriesResponse representa uma página de entregas de webhook
+type ListDeliveriesResponse struct {
+	Deliveries []*webhook.Delivery `json:"deliveries"`
+	Total      int                 `json:"total"`
+	Limit      int                 `json:"limit"`
+	Offset     int                 `json:"offset"`
+}
+
+// ListDeliveriesUseCase implementa o caso de uso para listar as entregas do outbox de uma sessão
+type ListDeliveriesUseCase struct {
+	deliveryRepo webhook.DeliveryRepository
+	sessionRepo  session.SessionRepository
+	logger       logger.Logger
+}
+
+// NewListDeliveriesUseCase cria uma nova instância do caso de uso
+func NewListDeliveriesUseCase(
+	deliveryRepo webhook.DeliveryRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{
+		deliveryRepo: deliveryRepo,
+		sessionRepo:  sessionRepo,
+		logger:       logger.WithComponent("list-webhook-deliveries-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para listar as entregas
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	status := webhook.DeliveryStatus(req.Status)
+	if status != "" && !status.IsValid() {
+		return nil, webhook.ErrInvalidDeliveryStatus
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	if limit > maxDeliveriesLimit {
+		limit = maxDeliveriesLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	deliveries, total, err := uc.deliveryRepo.List(ctx, webhook.DeliveryFilter{
+		SessionID: sessionID,
+		WebhookID: req.WebhookID,
+		Status:    status,
+		Event:     req.Event,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to list webhook deliveries from database")
+		return nil, err
+	}
+
+	if deliveries == nil {
+		deliveries = []*webhook.Delivery{}
+	}
+
+	return &ListDeliveriesResponse{
+		Deliveries: deliveries,
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// ReplayDeliveriesRequest representa os dados para reenviar entregas em lote.
+// Sem IDs, todas as entregas da sessão com o status informado (padrão: dead) são reenviadas.
+type ReplayDeliveriesRequest struct {
+	IDs    []uuid.UUID `json:"ids,omitempty"`
+	Status string      `json:"status,omitempty" example:"dead"`
+}
+
+// ReplayDeliveriesResponse representa o resultado do reenvio em lote
+type ReplayDeliveriesResponse struct {
+	Requeued int `json:"requeued"`
+}
+
+// ReplayDeliveriesUseCase implementa o caso de uso para reenviar entregas de webhook em lote
+type ReplayDeliveriesUseCase struct {
+	deliveryRepo webhook.DeliveryRepository
+	sessionRepo  session.SessionRepository
+	logger       logger.Logger
+}
+
+// NewReplayDeliveriesUseCase cria uma nova instância do caso de uso
+func NewReplayDeliveriesUseCase(
+	deliveryRepo webhook.DeliveryRepository,
+	sessionRepo session.SessionRepository,
+	logger logger.Logger,
+) *ReplayDeliveriesUseCase {
+	return &ReplayDeliveriesUseCase{
+		deliveryRepo: deliveryRepo,
+		sessionRepo:  sessionRepo,
+		logger:       logger.WithComponent("replay-webhook-deliveries-usecase"),
+	}
+}
+
+// Execute recoloca as entregas selecionadas na fila do outbox
+func (uc *ReplayDeliveriesUseCase) Execute(ctx context.Context, sessionID uuid.UUID, req ReplayDeliveriesRequest) (*ReplayDeliveriesResponse, error) {
+	status := webhook.DeliveryStatus(req.Status)
+	if status == "" && len(req.IDs) == 0 {
+		status = webhook.DeliveryStatusDead
+	}
+	if status != "" && (!status.IsValid() || status == webhook.DeliveryStatusPending) {
+		return nil, webhook.ErrInvalidDeliveryStatus
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	requeued, err := uc.deliveryRepo.Requeue(ctx, sessionID, req.IDs, status)
+	if err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to requeue webhook deliveries")
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"status":    status,
+		"ids":       len(req.IDs),
+		"requeued":  requeued,
+	}).Info().Msg("Webhook deliveries requeued")
+
+	return &ReplayDeliveriesResponse{Requeued: requeued}, nil
+}
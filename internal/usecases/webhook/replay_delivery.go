@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/pkg/logger"
+)
+
+// ReplayDeliveryUseCase implementa o caso de uso para reenviar uma entrega de webhook
+type ReplayDeliveryUseCase struct {
+	deliveryRepo webhook.DeliveryRepository
+	logger       logger.Logger
+}
+
+// NewReplayDeliveryUseCase cria uma nova instância do caso de uso
+func NewReplayDeliveryUseCase(deliveryRepo webhook.DeliveryRepository, logger logger.Logger) *ReplayDeliveryUseCase {
+	return &ReplayDeliveryUseCase{
+		deliveryRepo: deliveryRepo,
+		logger:       logger.WithComponent("replay-webhook-delivery-usecase"),
+	}
+}
+
+// Execute recoloca a entrega na fila do outbox com as tentativas zeradas
+func (uc *ReplayDeliveryUseCase) Execute(ctx context.Context, sessionID, deliveryID uuid.UUID) (*webhook.Delivery, error) {
+	delivery, err := uc.deliveryRepo.GetByID(ctx, sessionID, deliveryID)
+	if err != nil {
+		if err != webhook.ErrDeliveryNotFound {
+			uc.logger.WithError(err).Error().Msg("Failed to get webhook delivery from database")
+		}
+		return nil, err
+	}
+
+	if !delivery.CanReplay() {
+		return nil, webhook.ErrDeliveryNotReplayable
+	}
+
+	if _, err := uc.deliveryRepo.Requeue(ctx, sessionID, []uuid.UUID{deliveryID}, ""); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to requeue webhook delivery")
+		return nil, err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":  sessionID,
+		"deliveryId": deliveryID,
+	}).Info().Msg("Webhook delivery requeued")
+
+	return uc.deliveryRepo.GetByID(ctx, sessionID, deliveryID)
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// RotateWebhookSecretUseCase implementa o caso de uso para rotacionar o segredo de assinatura do webhook
+type RotateWebhookSecretUseCase struct {
+	webhookRepo    webhook.WebhookRepository
+	webhookService whatsapp.WebhookService
+	logger         logger.Logger
+}
+
+// NewRotateWebhookSecretUseCase cria uma nova instância do caso de uso
+func NewRotateWebhookSecretUseCase(
+	webhookRepo webhook.WebhookRepository,
+	webhookService whatsapp.WebhookService,
+	logger logger.Logger,
+) *RotateWebhookSecretUseCase {
+	return &RotateWebhookSecretUseCase{
+		webhookRepo:    webhookRepo,
+		webhookService: webhookService,
+		logger:         logger.WithComponent("rotate-webhook-secret-usecase"),
+	}
+}
+
+// RotateWebhookSecretRequest representa os dados para rotacionar o segredo
+type RotateWebhookSecretRequest struct {
+	Secret         string `json:"secret,omitempty" example:"whsec_novo-segredo"`
+	OverlapSeconds *int   `json:"overlapSeconds,omitempty" example:"86400"`
+}
+
+// RotateWebhookSecretResponse representa o resultado da rotação; o novo segredo só é exibido aqui
+type RotateWebhookSecretResponse struct {
+	*webhook.Webhook
+	Secret string `json:"secret"`
+}
+
+// Execute define um novo segredo, mantendo o anterior válido durante a janela de sobreposição.
+// uuid.Nil seleciona o webhook principal.
+func (uc *RotateWebhookSecretUseCase) Execute(ctx context.Context, sessionID, webhookID uuid.UUID, req RotateWebhookSecretRequest) (*RotateWebhookSecretResponse, error) {
+	wh, err := findWebhook(ctx, uc.webhookRepo, sessionID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	newSecret := req.Secret
+	if newSecret == "" {
+		if newSecret, err = webhook.GenerateSecret(); err != nil {
+			uc.logger.WithError(err).Error().Msg("Failed to generate webhook secret")
+			return nil, err
+		}
+	}
+
+	overlap := webhook.DefaultSecretOverlap
+	if req.OverlapSeconds != nil {
+		if *req.OverlapSeconds < 0 {
+			return nil, fmt.Errorf("%w: overlapSeconds must not be negative", webhook.ErrInvalidSecretOverlap)
+		}
+		overlap = time.Duration(*req.OverlapSeconds) * time.Second
+	}
+
+	wh.RotateSecret(newSecret, overlap)
+
+	if err := uc.webhookRepo.Save(ctx, wh); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to save rotated webhook secret")
+		return nil, fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	if err := uc.webhookService.SetWebhookConfig(wh.ToConfig()); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to apply rotated webhook secret")
+		return nil, fmt.Errorf("failed to apply webhook configuration: %w", err)
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId":               sessionID,
+		"webhookId":               wh.ID,
+		"previousSecretExpiresAt": wh.PreviousSecretExpiresAt,
+	}).Info().Msg("Webhook secret rotated successfully")
+
+	return &RotateWebhookSecretResponse{Webhook: wh, Secret: newSecret}, nil
+}
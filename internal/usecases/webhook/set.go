@@ -0,0 +1,174 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/session"
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// SetWebhookUseCase implementa o caso de uso para atualizar um webhook de uma sessão
+type SetWebhookUseCase struct {
+	webhookRepo    webhook.WebhookRepository
+	sessionRepo    session.SessionRepository
+	webhookService whatsapp.WebhookService
+	logger         logger.Logger
+}
+
+// NewSetWebhookUseCase cria uma nova instância do caso de uso
+func NewSetWebhookUseCase(
+	webhookRepo webhook.WebhookRepository,
+	sessionRepo session.SessionRepository,
+	webhookService whatsapp.WebhookService,
+	logger logger.Logger,
+) *SetWebhookUseCase {
+	return &SetWebhookUseCase{
+		webhookRepo:    webhookRepo,
+		sessionRepo:    sessionRepo,
+		webhookService: webhookService,
+		logger:         logger.WithComponent("set-webhook-usecase"),
+	}
+}
+
+// SetWebhookRequest representa os dados para configurar um webhook de uma sessão
+type SetWebhookRequest struct {
+	URL string `json:"url" validate:"required,url" example:"https://example.com/webhook"`
+	// Secret substitui o segredo de assinatura; omitido, o segredo atual é mantido
+	Secret  string `json:"secret,omitempty" example:"meu-segredo"`
+	Enabled *bool  `json:"enabled,omitempty" example:"true"`
+	Retries int    `json:"retries,omitempty" example:"3"`
+	Timeout int    `json:"timeout,omitempty" example:"30"`
+	// Events restringe os eventos entregues por padrões ("message", "message.*", "*"); vazio entrega todos
+	Events []string `json:"events,omitempty" example:"message.*,connection.*"`
+	// Headers são enviados em cada entrega, além dos headers de assinatura
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// WebhookResponse representa o webhook criado ou configurado. Secret só é preenchido quando o segredo de assinatura
+// foi gerado pelo zmeow, única vez em que ele é exibido.
+type WebhookResponse struct {
+	*webhook.Webhook
+	Secret string `json:"secret,omitempty" example:"whsec_3f1c0a..."`
+}
+
+// Execute executa o caso de uso para configurar o webhook.
+// Com uuid.Nil atualiza o webhook principal, criando-o se a sessão ainda não tiver webhooks.
+func (uc *SetWebhookUseCase) Execute(ctx context.Context, sessionID, webhookID uuid.UUID, req SetWebhookRequest) (*WebhookResponse, error) {
+	wh, err := buildWebhook(sessionID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	response := &WebhookResponse{Webhook: wh}
+	existing, err := findWebhook(ctx, uc.webhookRepo, sessionID, webhookID)
+	switch {
+	case err == nil:
+		wh.ID = existing.ID
+		wh.CreatedAt = existing.CreatedAt
+		// Sem secret na requisição o segredo atual é mantido; preservar também uma rotação em andamento
+		// enquanto o segredo não muda
+		if req.Secret == "" {
+			wh.Secret = existing.Secret
+		}
+		if existing.Secret == wh.Secret {
+			wh.PreviousSecret = existing.PreviousSecret
+			wh.PreviousSecretExpiresAt = existing.PreviousSecretExpiresAt
+		}
+	case err != webhook.ErrWebhookNotFound || webhookID != uuid.Nil:
+		return nil, err
+	default:
+		if response.Secret, err = ensureSecret(wh); err != nil {
+			uc.logger.WithError(err).Error().Msg("Failed to generate webhook secret")
+			return nil, err
+		}
+	}
+
+	return response, saveWebhook(ctx, uc.webhookRepo, uc.webhookService, uc.logger, wh)
+}
+
+// ensureSecret gera um segredo de assinatura para o novo webhook que não informou um, retornando o segredo gerado
+func ensureSecret(wh *webhook.Webhook) (string, error) {
+	if wh.HasSecret() {
+		return "", nil
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return "", err
+	}
+	wh.Secret = secret
+	return secret, nil
+}
+
+// buildWebhook valida a requisição e monta o webhook com os valores padrão aplicados
+func buildWebhook(sessionID uuid.UUID, req SetWebhookRequest) (*webhook.Webhook, error) {
+	if err := webhook.ValidateURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	events, err := webhook.ValidateEvents(req.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := webhook.ValidateHeaders(req.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	wh := &webhook.Webhook{
+		SessionID: sessionID,
+		URL:       req.URL,
+		Secret:    req.Secret,
+		Enabled:   enabled,
+		Retries:   req.Retries,
+		Timeout:   req.Timeout,
+		Events:    events,
+		Headers:   headers,
+	}
+	wh.ApplyDefaults()
+
+	return wh, nil
+}
+
+// saveWebhook persiste o webhook e aplica a configuração ao WebhookService
+func saveWebhook(
+	ctx context.Context,
+	webhookRepo webhook.WebhookRepository,
+	webhookService whatsapp.WebhookService,
+	log logger.Logger,
+	wh *webhook.Webhook,
+) error {
+	if err := webhookRepo.Save(ctx, wh); err != nil {
+		log.WithError(err).Error().Msg("Failed to save webhook in database")
+		return fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	if err := webhookService.SetWebhookConfig(wh.ToConfig()); err != nil {
+		log.WithError(err).Error().Msg("Failed to apply webhook configuration")
+		return fmt.Errorf("failed to apply webhook configuration: %w", err)
+	}
+
+	log.WithFields(map[string]interface{}{
+		"sessionId": wh.SessionID,
+		"webhookId": wh.ID,
+		"url":       wh.URL,
+		"enabled":   wh.Enabled,
+	}).Info().Msg("Webhook configured successfully")
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"zmeow/internal/domain/webhook"
+	"zmeow/internal/domain/whatsapp"
+	"zmeow/pkg/logger"
+)
+
+// TestWebhookUseCase implementa o caso de uso para enviar um webhook de teste
+type TestWebhookUseCase struct {
+	webhookRepo    webhook.WebhookRepository
+	webhookService whatsapp.WebhookService
+	logger         logger.Logger
+}
+
+// NewTestWebhookUseCase cria uma nova instância do caso de uso
+func NewTestWebhookUseCase(
+	webhookRepo webhook.WebhookRepository,
+	webhookService whatsapp.WebhookService,
+	logger logger.Logger,
+) *TestWebhookUseCase {
+	return &TestWebhookUseCase{
+		webhookRepo:    webhookRepo,
+		webhookService: webhookService,
+		logger:         logger.WithComponent("test-webhook-usecase"),
+	}
+}
+
+// Execute executa o caso de uso para enviar um webhook de teste; uuid.Nil seleciona o webhook principal
+func (uc *TestWebhookUseCase) Execute(ctx context.Context, sessionID, webhookID uuid.UUID) error {
+	wh, err := findWebhook(ctx, uc.webhookRepo, sessionID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if !wh.Enabled {
+		return webhook.ErrWebhookDisabled
+	}
+
+	if err := uc.webhookService.SendTestWebhook(sessionID, wh.ID); err != nil {
+		uc.logger.WithError(err).Error().Msg("Failed to send test webhook")
+		return err
+	}
+
+	uc.logger.WithFields(map[string]interface{}{
+		"sessionId": sessionID,
+		"webhookId": wh.ID,
+	}).Info().Msg("Test webhook dispatched")
+	return nil
+}
@@ -0,0 +1,158 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"zmeow/pkg/logger"
+)
+
+// InterruptedReason é o erro registrado nos envios que estavam em andamento quando o processo foi encerrado
+const InterruptedReason = "interrupted by shutdown while sending; not retried to avoid duplicate delivery"
+
+// Loop é o ciclo de vida comum dos workers de envio (fila, agendamentos e campanhas): executa tick a cada
+// pollInterval ou quando acordado por Notify, e acompanha os goroutines iniciados por Go para que Stop
+// aguarde os envios em andamento.
+type Loop struct {
+	pollInterval time.Duration
+	tick         func()
+	wake         chan struct{}
+	stop         chan struct{}
+	active       map[uuid.UUID]struct{}
+	mutex        sync.Mutex
+	wg           sync.WaitGroup
+	once         sync.Once
+}
+
+// NewLoop cria um Loop que executa tick periodicamente
+func NewLoop(pollInterval time.Duration, tick func()) *Loop {
+	return &Loop{
+		pollInterval: pollInterval,
+		tick:         tick,
+		wake:         make(chan struct{}, 1),
+		stop:         make(chan struct{}),
+		active:       make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Start inicia o loop
+func (l *Loop) Start() {
+	l.wg.Add(1)
+	go l.run()
+}
+
+// Stop encerra o loop aguardando os goroutines em andamento; retorna true apenas na primeira chamada
+func (l *Loop) Stop() bool {
+	stopped := false
+	l.once.Do(func() {
+		close(l.stop)
+		l.wg.Wait()
+		stopped = true
+	})
+	return stopped
+}
+
+// Notify acorda o loop sem bloquear
+func (l *Loop) Notify() {
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Stopping é fechado quando o loop começa a ser encerrado
+func (l *Loop) Stopping() <-chan struct{} {
+	return l.stop
+}
+
+// run executa tick a cada pollInterval ou Notify até o encerramento
+func (l *Loop) run() {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(l.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		case <-l.wake:
+		}
+
+		l.tick()
+	}
+}
+
+// Go executa fn em um goroutine acompanhado por Stop
+func (l *Loop) Go(fn func()) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		fn()
+	}()
+}
+
+// GoSession executa fn para a sessão se ela ainda não tiver um goroutine ativo, retornando false caso tenha
+func (l *Loop) GoSession(sessionID uuid.UUID, fn func()) bool {
+	l.mutex.Lock()
+	if _, busy := l.active[sessionID]; busy {
+		l.mutex.Unlock()
+		return false
+	}
+	l.active[sessionID] = struct{}{}
+	l.mutex.Unlock()
+
+	l.Go(func() {
+		defer func() {
+			l.mutex.Lock()
+			delete(l.active, sessionID)
+			l.mutex.Unlock()
+		}()
+		fn()
+	})
+	return true
+}
+
+// Sleep aguarda o tempo informado, retornando false se o loop for encerrado antes
+func (l *Loop) Sleep(d time.Duration) bool {
+	return sleep(d, l.stop)
+}
+
+// FailInterrupted marca como falhos os envios interrompidos pelo último encerramento, já que não é possível
+// saber se chegaram ao WhatsApp, e registra o resultado no log
+func FailInterrupted(fail func(ctx context.Context, reason string) (int, error), log logger.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if count, err := fail(ctx, InterruptedReason); err != nil {
+		log.WithError(err).Error().Msg("Failed to mark sends interrupted by the last shutdown as failed")
+	} else if count > 0 {
+		log.WithField("count", count).Warn().Msg("Sends interrupted by the last shutdown marked as failed")
+	}
+}
+
+// sleep aguarda d, retornando false se stop for fechado antes
+func sleep(d time.Duration, stop <-chan struct{}) bool {
+	if d <= 0 {
+		select {
+		case <-stop:
+			return false
+		default:
+			return true
+		}
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
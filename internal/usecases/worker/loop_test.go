@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"zmeow/pkg/logger"
+)
+
+func TestLoopNotifyAndStop(t *testing.T) {
+	ticks := make(chan struct{}, 10)
+	loop := NewLoop(time.Hour, func() { ticks <- struct{}{} })
+	loop.Start()
+
+	loop.Notify()
+	select {
+	case <-ticks:
+	case <-time.After(time.Second):
+		t.Fatal("Notify() did not run the tick")
+	}
+
+	var finished atomic.Bool
+	loop.Go(func() {
+		<-loop.Stopping()
+		time.Sleep(20 * time.Millisecond)
+		finished.Store(true)
+	})
+
+	if !loop.Stop() {
+		t.Fatal("first Stop() = false, want true")
+	}
+	if !finished.Load() {
+		t.Fatal("Stop() returned before the running goroutine finished")
+	}
+	if loop.Stop() {
+		t.Fatal("second Stop() = true, want false")
+	}
+	if loop.Sleep(time.Hour) {
+		t.Fatal("Sleep() after Stop() = true, want false")
+	}
+}
+
+func TestLoopGoSession(t *testing.T) {
+	loop := NewLoop(time.Hour, func() {})
+	sessionID := uuid.New()
+	release := make(chan struct{})
+	done := make(chan struct{})
+
+	if !loop.GoSession(sessionID, func() { <-release; close(done) }) {
+		t.Fatal("GoSession() = false, want true")
+	}
+	if loop.GoSession(sessionID, func() {}) {
+		t.Fatal("GoSession() with a running goroutine = true, want false")
+	}
+	if !loop.GoSession(uuid.New(), func() {}) {
+		t.Fatal("GoSession() for another session = false, want true")
+	}
+
+	close(release)
+	<-done
+	loop.Stop()
+
+	if !loop.GoSession(sessionID, func() {}) {
+		t.Fatal("GoSession() after the goroutine finished = false, want true")
+	}
+}
+
+func TestFailInterrupted(t *testing.T) {
+	nop := zerolog.Nop()
+	log := logger.NewZerologLogger(&nop)
+
+	tests := []struct {
+		name  string
+		count int
+		err   error
+	}{
+		{name: "none interrupted"},
+		{name: "interrupted sends", count: 3},
+		{name: "repository error", err: errors.New("database down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reason string
+			FailInterrupted(func(ctx context.Context, r string) (int, error) {
+				if _, ok := ctx.Deadline(); !ok {
+					t.Fatal("FailInterrupted() context without deadline")
+				}
+				reason = r
+				return tt.count, tt.err
+			}, log)
+
+			if reason != InterruptedReason {
+				t.Fatalf("reason = %q, want %q", reason, InterruptedReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Pace é o ritmo de envio: o intervalo mínimo entre dois envios da sessão e o atraso aleatório máximo somado a ele
+type Pace struct {
+	Interval time.Duration
+	Jitter   time.Duration
+}
+
+// PerMinute retorna o ritmo de ratePerMinute envios por minuto (no mínimo 1)
+func PerMinute(ratePerMinute int, jitter time.Duration) Pace {
+	if ratePerMinute <= 0 {
+		ratePerMinute = 1
+	}
+	if jitter < 0 {
+		jitter = 0
+	}
+	return Pace{Interval: time.Minute / time.Duration(ratePerMinute), Jitter: jitter}
+}
+
+// Pacer espaça os envios automáticos de cada sessão. Uma única instância é compartilhada pela fila de envio,
+// pelos agendamentos e pelas campanhas, para que o ritmo valha para a soma dos envios da sessão.
+type Pacer struct {
+	defaultPace Pace
+	next        map[uuid.UUID]time.Time
+	mutex       sync.Mutex
+}
+
+// NewPacer cria o Pacer; defaultPace é usado pelos envios que não definem um ritmo próprio
+func NewPacer(defaultPace Pace) *Pacer {
+	return &Pacer{
+		defaultPace: defaultPace,
+		next:        make(map[uuid.UUID]time.Time),
+	}
+}
+
+// DefaultPace retorna o ritmo padrão dos envios
+func (p *Pacer) DefaultPace() Pace {
+	return p.defaultPace
+}
+
+// Wait reserva o próximo horário de envio da sessão e aguarda até ele, retornando false se stop for fechado antes.
+// O horário fica pace.Interval (mais um jitter aleatório) depois do envio anterior da sessão, de qualquer origem;
+// um Pace vazio usa o ritmo padrão.
+func (p *Pacer) Wait(sessionID uuid.UUID, pace Pace, stop <-chan struct{}) bool {
+	if pace == (Pace{}) {
+		pace = p.defaultPace
+	}
+
+	p.mutex.Lock()
+	now := time.Now()
+	slot := now
+	if last, ok := p.next[sessionID]; ok {
+		if earliest := last.Add(pace.Interval); earliest.After(slot) {
+			slot = earliest
+		}
+		if pace.Jitter > 0 {
+			slot = slot.Add(time.Duration(rand.Int63n(int64(pace.Jitter) + 1)))
+		}
+	}
+	p.next[sessionID] = slot
+	p.mutex.Unlock()
+
+	return sleep(slot.Sub(now), stop)
+}
+
+// Sent registra o fim de um envio da sessão, para que o próximo intervalo conte a partir dele
+// mesmo quando o envio demorou (ex.: upload de mídia)
+func (p *Pacer) Sent(sessionID uuid.UUID) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if now := time.Now(); now.After(p.next[sessionID]) {
+		p.next[sessionID] = now
+	}
+}
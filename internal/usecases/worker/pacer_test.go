@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPerMinute(t *testing.T) {
+	tests := []struct {
+		name   string
+		rate   int
+		jitter time.Duration
+		want   Pace
+	}{
+		{name: "twenty per minute", rate: 20, jitter: time.Second, want: Pace{Interval: 3 * time.Second, Jitter: time.Second}},
+		{name: "sixty per minute", rate: 60, want: Pace{Interval: time.Second}},
+		{name: "zero rate", rate: 0, want: Pace{Interval: time.Minute}},
+		{name: "negative jitter", rate: 60, jitter: -time.Second, want: Pace{Interval: time.Second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PerMinute(tt.rate, tt.jitter); got != tt.want {
+				t.Fatalf("PerMinute(%d, %s) = %+v, want %+v", tt.rate, tt.jitter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPacerWait(t *testing.T) {
+	const (
+		interval = 40 * time.Millisecond
+		// slack tolera atrasos do agendador em máquinas carregadas
+		slack = 100 * time.Millisecond
+	)
+
+	tests := []struct {
+		name string
+		pace Pace
+		// sent registra o fim de um envio demorado antes da segunda espera
+		sentAfter time.Duration
+		minWait   time.Duration
+		maxWait   time.Duration
+	}{
+		{name: "default pace", minWait: interval, maxWait: interval + slack},
+		{name: "own pace", pace: Pace{Interval: 2 * interval}, minWait: 2 * interval, maxWait: 2*interval + slack},
+		{name: "with jitter", pace: Pace{Interval: interval, Jitter: interval}, minWait: interval, maxWait: 2*interval + slack},
+		{name: "interval counts from the end of a slow send", sentAfter: 2 * interval, minWait: 3 * interval, maxWait: 3*interval + slack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pacer := NewPacer(Pace{Interval: interval})
+			sessionID := uuid.New()
+			stop := make(chan struct{})
+
+			start := time.Now()
+			if !pacer.Wait(sessionID, tt.pace, stop) {
+				t.Fatal("first Wait() = false, want true")
+			}
+			if first := time.Since(start); first > 20*time.Millisecond {
+				t.Fatalf("first Wait() took %s, want no wait", first)
+			}
+			if tt.sentAfter > 0 {
+				time.Sleep(tt.sentAfter)
+				pacer.Sent(sessionID)
+			}
+
+			if !pacer.Wait(sessionID, tt.pace, stop) {
+				t.Fatal("second Wait() = false, want true")
+			}
+			if elapsed := time.Since(start); elapsed < tt.minWait || elapsed > tt.maxWait {
+				t.Fatalf("second Wait() returned after %s, want between %s and %s", elapsed, tt.minWait, tt.maxWait)
+			}
+		})
+	}
+}
+
+func TestPacerSharedBetweenWorkers(t *testing.T) {
+	const interval = 30 * time.Millisecond
+	pacer := NewPacer(Pace{Interval: interval})
+	sessionID := uuid.New()
+	stop := make(chan struct{})
+
+	// Três workers da mesma sessão recebem horários distintos, espaçados pelo intervalo
+	var mutex sync.Mutex
+	var times []time.Time
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pacer.Wait(sessionID, Pace{}, stop)
+			mutex.Lock()
+			times = append(times, time.Now())
+			mutex.Unlock()
+		}()
+	}
+
+	// Outra sessão não espera pelas demais
+	start := time.Now()
+	pacer.Wait(uuid.New(), Pace{}, stop)
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Fatalf("Wait() for another session took %s, want no wait", elapsed)
+	}
+	wg.Wait()
+
+	first, last := times[0], times[0]
+	for _, at := range times {
+		if at.Before(first) {
+			first = at
+		}
+		if at.After(last) {
+			last = at
+		}
+	}
+	if spread := last.Sub(first); spread < 2*interval {
+		t.Fatalf("sends spread over %s, want at least %s", spread, 2*interval)
+	}
+}
+
+func TestPacerWaitStops(t *testing.T) {
+	pacer := NewPacer(Pace{Interval: time.Hour})
+	sessionID := uuid.New()
+	stop := make(chan struct{})
+
+	pacer.Wait(sessionID, Pace{}, stop)
+	close(stop)
+
+	done := make(chan bool)
+	go func() { done <- pacer.Wait(sessionID, Pace{}, stop) }()
+
+	select {
+	case ok := <-done:
+		if ok {
+			t.Fatal("Wait() after stop = true, want false")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Wait() did not return after stop")
+	}
+}
@@ -0,0 +1,150 @@
+// Package webhookverify assina e valida as entregas de webhook do zmeow.
+//
+// Cada entrega carrega dois headers:
+//
+//	X-Webhook-Timestamp: 1718000000
+//	X-Webhook-Signature: sha256=<hex>[, sha256=<hex>]
+//
+// A assinatura é um HMAC-SHA256 sobre "<timestamp>.<corpo>" usando o segredo
+// do webhook. Durante uma rotação de segredo o zmeow envia uma assinatura para
+// cada segredo válido; basta que uma delas confira.
+//
+// Exemplo de uso em um receptor:
+//
+//	body, err := webhookverify.VerifyRequest(r, webhookverify.DefaultTolerance, os.Getenv("WEBHOOK_SECRET"))
+//	if err != nil {
+//		http.Error(w, "invalid signature", http.StatusUnauthorized)
+//		return
+//	}
+package webhookverify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderSignature é o header com as assinaturas da entrega
+	HeaderSignature = "X-Webhook-Signature"
+	// HeaderTimestamp é o header com o timestamp Unix (segundos) da entrega
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// SignaturePrefix é o prefixo de cada assinatura no header
+	SignaturePrefix = "sha256="
+	// DefaultTolerance é a diferença máxima aceita entre o timestamp e o relógio local
+	DefaultTolerance = 5 * time.Minute
+)
+
+var (
+	// ErrMissingSignature indica que o header de assinatura está ausente
+	ErrMissingSignature = errors.New("webhookverify: missing signature header")
+	// ErrMissingTimestamp indica que o header de timestamp está ausente
+	ErrMissingTimestamp = errors.New("webhookverify: missing timestamp header")
+	// ErrInvalidTimestamp indica que o timestamp não é um inteiro válido
+	ErrInvalidTimestamp = errors.New("webhookverify: invalid timestamp")
+	// ErrTimestampOutOfTolerance indica que a entrega é antiga ou futura demais (possível replay)
+	ErrTimestampOutOfTolerance = errors.New("webhookverify: timestamp outside tolerance")
+	// ErrNoSecrets indica que nenhum segredo foi informado para a validação
+	ErrNoSecrets = errors.New("webhookverify: no secrets provided")
+	// ErrSignatureMismatch indica que nenhuma assinatura confere com os segredos informados
+	ErrSignatureMismatch = errors.New("webhookverify: signature mismatch")
+)
+
+// SignedPayload monta o conteúdo assinado: "<timestamp>.<corpo>"
+func SignedPayload(timestamp int64, body []byte) []byte {
+	ts := strconv.FormatInt(timestamp, 10)
+	payload := make([]byte, 0, len(ts)+1+len(body))
+	payload = append(payload, ts...)
+	payload = append(payload, '.')
+	return append(payload, body...)
+}
+
+// Sign calcula a assinatura de uma entrega no formato "sha256=<hex>"
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(SignedPayload(timestamp, body))
+	return SignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify valida o corpo de uma entrega a partir dos valores dos headers.
+// tolerance <= 0 desabilita a verificação de janela de tempo.
+func Verify(body []byte, signatureHeader, timestampHeader string, tolerance time.Duration, secrets ...string) error {
+	if signatureHeader == "" {
+		return ErrMissingSignature
+	}
+	if timestampHeader == "" {
+		return ErrMissingTimestamp
+	}
+
+	timestamp, err := strconv.ParseInt(strings.TrimSpace(timestampHeader), 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	if tolerance > 0 {
+		diff := time.Since(time.Unix(timestamp, 0))
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > tolerance {
+			return ErrTimestampOutOfTolerance
+		}
+	}
+
+	candidates := parseSignatures(signatureHeader)
+	checked := 0
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		checked++
+
+		expected := []byte(Sign(secret, timestamp, body))
+		for _, candidate := range candidates {
+			if hmac.Equal(expected, []byte(candidate)) {
+				return nil
+			}
+		}
+	}
+
+	if checked == 0 {
+		return ErrNoSecrets
+	}
+	return ErrSignatureMismatch
+}
+
+// VerifyRequest lê o corpo da requisição, valida a assinatura e devolve o corpo.
+// O corpo da requisição é restaurado para que possa ser lido novamente.
+func VerifyRequest(r *http.Request, tolerance time.Duration, secrets ...string) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := Verify(body, r.Header.Get(HeaderSignature), r.Header.Get(HeaderTimestamp), tolerance, secrets...); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// parseSignatures separa as assinaturas do header (separadas por vírgula)
+func parseSignatures(header string) []string {
+	parts := strings.Split(header, ",")
+	signatures := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, SignaturePrefix) {
+			signatures = append(signatures, part)
+		}
+	}
+	return signatures
+}
@@ -0,0 +1,275 @@
+package webhookverify
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      string
+		want      string
+	}{
+		{
+			name:      "json body",
+			secret:    "whsec_test",
+			timestamp: 1718000000,
+			body:      `{"event":"Message"}`,
+			want:      "sha256=c27474ff39ab67df0c34508c64e86aa67bdb03a9682b37065ab7f75bd95ac6fb",
+		},
+		{
+			name:      "empty body",
+			secret:    "whsec_test",
+			timestamp: 1718000000,
+			body:      "",
+			want:      "sha256=8f5a23ebf759f678c004e47bde7470a41bc549007bac2d3fe2f3bc930a025235",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.secret, tt.timestamp, []byte(tt.body)); got != tt.want {
+				t.Fatalf("Sign() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	const (
+		current  = "whsec_current"
+		previous = "whsec_previous"
+	)
+	body := []byte(`{"event":"Message","sessionId":"abc"}`)
+	now := time.Now().Unix()
+	ts := strconv.FormatInt(now, 10)
+	old := strconv.FormatInt(now-int64((10*time.Minute).Seconds()), 10)
+	future := strconv.FormatInt(now+int64((10*time.Minute).Seconds()), 10)
+
+	sign := func(secret string, timestamp string) string {
+		n, _ := strconv.ParseInt(timestamp, 10, 64)
+		return Sign(secret, n, body)
+	}
+
+	tests := []struct {
+		name      string
+		body      []byte
+		signature string
+		timestamp string
+		tolerance time.Duration
+		secrets   []string
+		wantErr   error
+	}{
+		{
+			name:      "valid signature",
+			body:      body,
+			signature: sign(current, ts),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+		},
+		{
+			name:      "tampered body",
+			body:      []byte(`{"event":"Message","sessionId":"xyz"}`),
+			signature: sign(current, ts),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "tampered timestamp",
+			body:      body,
+			signature: sign(current, ts),
+			timestamp: strconv.FormatInt(now+1, 10),
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "wrong secret",
+			body:      body,
+			signature: sign("whsec_other", ts),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "timestamp older than tolerance",
+			body:      body,
+			signature: sign(current, old),
+			timestamp: old,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrTimestampOutOfTolerance,
+		},
+		{
+			name:      "timestamp in the future beyond tolerance",
+			body:      body,
+			signature: sign(current, future),
+			timestamp: future,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrTimestampOutOfTolerance,
+		},
+		{
+			name:      "zero tolerance disables the time window",
+			body:      body,
+			signature: sign(current, old),
+			timestamp: old,
+			tolerance: 0,
+			secrets:   []string{current},
+		},
+		{
+			name:      "rotation with receiver on the new secret",
+			body:      body,
+			signature: sign(current, ts) + ", " + sign(previous, ts),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+		},
+		{
+			name:      "rotation with receiver still on the previous secret",
+			body:      body,
+			signature: sign(current, ts) + "," + sign(previous, ts),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{previous},
+		},
+		{
+			name:      "rotation with receiver holding both secrets",
+			body:      body,
+			signature: sign(previous, ts),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current, previous},
+		},
+		{
+			name:      "wrong prefix",
+			body:      body,
+			signature: "sha1=" + strings.TrimPrefix(sign(current, ts), SignaturePrefix),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "missing prefix",
+			body:      body,
+			signature: strings.TrimPrefix(sign(current, ts), SignaturePrefix),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "missing signature",
+			body:      body,
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrMissingSignature,
+		},
+		{
+			name:      "missing timestamp",
+			body:      body,
+			signature: sign(current, ts),
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrMissingTimestamp,
+		},
+		{
+			name:      "invalid timestamp",
+			body:      body,
+			signature: sign(current, ts),
+			timestamp: "yesterday",
+			tolerance: DefaultTolerance,
+			secrets:   []string{current},
+			wantErr:   ErrInvalidTimestamp,
+		},
+		{
+			name:      "no secrets",
+			body:      body,
+			signature: sign(current, ts),
+			timestamp: ts,
+			tolerance: DefaultTolerance,
+			secrets:   []string{""},
+			wantErr:   ErrNoSecrets,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.body, tt.signature, tt.timestamp, tt.tolerance, tt.secrets...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	const secret = "whsec_current"
+	body := `{"event":"Message"}`
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+		wantErr   error
+	}{
+		{
+			name:      "valid request",
+			body:      body,
+			signature: Sign(secret, now, []byte(body)),
+		},
+		{
+			name:      "tampered body",
+			body:      `{"event":"Receipt"}`,
+			signature: Sign(secret, now, []byte(body)),
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:    "missing signature",
+			body:    body,
+			wantErr: ErrMissingSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/webhook", strings.NewReader(tt.body))
+			r.Header.Set(HeaderTimestamp, strconv.FormatInt(now, 10))
+			if tt.signature != "" {
+				r.Header.Set(HeaderSignature, tt.signature)
+			}
+
+			got, err := VerifyRequest(r, DefaultTolerance, secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyRequest() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && string(got) != tt.body {
+				t.Fatalf("VerifyRequest() body = %q, want %q", got, tt.body)
+			}
+
+			// O corpo deve continuar legível pelo handler, mesmo quando a validação falha
+			rest, readErr := io.ReadAll(r.Body)
+			if readErr != nil {
+				t.Fatalf("reading restored body: %v", readErr)
+			}
+			if string(rest) != tt.body {
+				t.Fatalf("restored body = %q, want %q", rest, tt.body)
+			}
+		})
+	}
+}